@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"net/http"
@@ -33,12 +34,17 @@ import (
 	mdlwr "github.com/go-chi/chi/v5/middleware"
 	"golang.org/x/crypto/acme/autocert"
 
+	"github.com/PoorMercymain/urlshrt/internal/auth"
+	"github.com/PoorMercymain/urlshrt/internal/canonical"
 	"github.com/PoorMercymain/urlshrt/internal/config"
 	"github.com/PoorMercymain/urlshrt/internal/domain"
 	"github.com/PoorMercymain/urlshrt/internal/handler"
+	"github.com/PoorMercymain/urlshrt/internal/keyring"
 	"github.com/PoorMercymain/urlshrt/internal/middleware"
+	"github.com/PoorMercymain/urlshrt/internal/policy"
 	"github.com/PoorMercymain/urlshrt/internal/repository"
 	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
 	"github.com/PoorMercymain/urlshrt/internal/state"
 	"github.com/PoorMercymain/urlshrt/pkg/util"
 )
@@ -47,41 +53,44 @@ var (
 	buildVersion, buildDate, buildCommit string
 )
 
-func router(us *service.URL, ur *repository.URL, jwtKey string, CIDR string, shortURLsChan *domain.MutexChanString, wg *sync.WaitGroup, once *sync.Once) chi.Router {
+func router(us *service.URL, cs *service.Click, ks *service.APIKey, ds *service.Deletion, ts *service.Tag, ps *service.Policy,
+	authenticator *auth.Authenticator, CIDR string, wg *sync.WaitGroup) chi.Router {
 	uh := handler.NewURL(us)
-
-	urls, err := ur.ReadAll(context.Background())
-	if err != nil {
-		util.GetLogger().Infoln("init", err)
-		urls = make([]state.URLStringJSON, 1)
-	}
-
-	urlsMap := make(map[string]state.URLStringJSON)
-	for _, u := range urls {
-		urlsMap[u.OriginalURL] = u
-	}
-
-	state.InitCurrentURLs(&urlsMap)
-	m, _ := state.GetCurrentURLsPtr()
-	util.GetLogger().Infoln(m.Urls)
+	ch := handler.NewClick(cs)
+	kh := handler.NewAPIKey(ks)
+	dh := handler.NewDeletion(ds)
+	th := handler.NewTag(ts)
+	ph := handler.NewPolicy(ps)
 
 	r := chi.NewRouter()
 
-	r.Post("/", WrapHandler(uh.CreateShortened, jwtKey))
-	r.Get("/{short}", WrapHandler(uh.ReadOriginal, jwtKey))
-	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON, jwtKey))
-	r.Get("/ping", WrapHandler(uh.PingPg, jwtKey))
-	r.Post("/api/shorten/batch", WrapHandler(uh.CreateShortenedFromBatchAdapter(wg), jwtKey))
-	r.Get("/api/user/urls", WrapHandler(uh.ReadUserURLs, jwtKey))
-	r.Delete("/api/user/urls", WrapHandler(uh.DeleteUserURLsAdapter(shortURLsChan, once, wg), jwtKey))
-	r.Get("/api/internal/stats", middleware.CheckCIDR(WrapHandler(uh.ReadAmountOfURLsAndUsers, jwtKey), CIDR))
+	r.Post("/", WrapHandler(uh.CreateShortened, authenticator))
+	r.Get("/{short}", WrapHandler(middleware.RecordClicks(http.HandlerFunc(uh.ReadOriginal), cs), authenticator))
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON, authenticator))
+	r.Get("/ping", WrapHandler(uh.PingPg, authenticator))
+	r.Post("/api/shorten/batch", WrapHandler(uh.CreateShortenedFromBatchAdapter(wg), authenticator))
+	r.Get("/api/user/urls", WrapHandler(uh.ReadUserURLs, authenticator))
+	r.Delete("/api/user/urls", WrapHandler(dh.DeleteUserURLs, authenticator))
+	r.Post("/api/user/urls/restore", WrapHandler(dh.RestoreUserURLs, authenticator))
+	r.Get("/api/user/deletions/{id}", WrapHandler(dh.ReadDeletionJob, authenticator))
+	r.Get("/api/user/urls/{short}/stats", WrapHandler(ch.ReadURLStats, authenticator))
+	r.Patch("/api/user/urls/{short}", WrapHandler(uh.UpdateShortened, authenticator))
+	r.Get("/api/user/urls/{short}/revisions", WrapHandler(uh.ReadRevisions, authenticator))
+	r.Post("/api/user/urls/{short}/tags", WrapHandler(th.AddTags, authenticator))
+	r.Delete("/api/user/urls/{short}/tags", WrapHandler(th.RemoveTags, authenticator))
+	r.Get("/api/user/tags", WrapHandler(th.ReadTags, authenticator))
+	r.Post("/api/user/keys", WrapHandler(kh.Create, authenticator))
+	r.Get("/api/user/keys", WrapHandler(kh.ReadAll, authenticator))
+	r.Delete("/api/user/keys/{id}", WrapHandler(kh.Revoke, authenticator))
+	r.Get("/api/internal/stats", middleware.CheckCIDR(WrapHandler(uh.ReadAmountOfURLsAndUsers, authenticator), CIDR))
+	r.Post("/api/internal/policy/enforce", middleware.CheckCIDR(WrapHandler(ph.Enforce, authenticator), CIDR))
 	r.Mount("/debug", mdlwr.Profiler())
 
 	return r
 }
 
-func WrapHandler(h http.HandlerFunc, jwtKey string) http.HandlerFunc {
-	return middleware.GzipHandle(middleware.Authorize(middleware.WithLogging(h), jwtKey))
+func WrapHandler(h http.HandlerFunc, authenticator *auth.Authenticator) http.HandlerFunc {
+	return middleware.GzipHandle(middleware.Authorize(middleware.WithLogging(h), authenticator))
 }
 
 func defineFlags(conf *config.Config) {
@@ -112,27 +121,181 @@ func defineFlags(conf *config.Config) {
 	flag.StringVar(&conf.GRPCTrustedSubnet, "tg", "", "trusted subnet from which access for stats endpoint on gRPC server is not denied")
 
 	flag.StringVar(&conf.GRPCJWTKey, "jg", "", "gRPC server key to generate JWTs and get info from them")
+
+	flag.BoolVar(&conf.InMemory, "m", false, "keep URL data in memory only if database is not used")
+
+	flag.DurationVar(&conf.FileCompactionInterval, "fc", 0, "interval between compactions of file where URL data is stored")
+
+	flag.StringVar(&conf.BoltPath, "bd", "", "full name of embedded database file where to store URL data if postgres is not used")
+
+	flag.StringVar(&conf.GRPCBoltPath, "bdg", "", "full name of embedded database file of gRPC server")
+
+	flag.StringVar(&conf.ShortCodeStrategy, "sc", "", "strategy of short codes generation (random, sequential, hashids or hash)")
+
+	flag.StringVar(&conf.ShortCodeAlphabet, "sca", "", "symbols which short codes consist of")
+
+	flag.IntVar(&conf.ShortCodeLength, "scl", 0, "minimal length of short codes")
+
+	flag.StringVar(&conf.ShortCodeSalt, "scs", "", "salt of short codes if hashids strategy is used")
+
+	flag.DurationVar(&conf.ExpirationSweepInterval, "es", 0, "interval between checks for expired URLs")
+
+	flag.StringVar(&conf.DedupScope, "ds", "", "scope of original URLs deduplication (user or global)")
+
+	flag.StringVar(&conf.AllowedSchemes, "as", "", "comma separated schemes of original URLs which may be shortened (http and https by default)")
+
+	flag.BoolVar(&conf.StripFragment, "sf", false, "strip fragments of original URLs")
+
+	flag.BoolVar(&conf.SortQuery, "sq", false, "sort query parameters of original URLs by name")
+
+	flag.StringVar(&conf.PolicyDir, "pd", "", "directory with blocklist, allowlist, regex rules and hashes of original URLs which can't be shortened")
+
+	flag.DurationVar(&conf.PolicyReloadInterval, "pr", 0, "interval between checks for changes of policy files")
+
+	flag.IntVar(&conf.ClickBufferSize, "cb", 0, "amount of clicks which may wait to be saved")
+
+	flag.DurationVar(&conf.ClickFlushInterval, "cf", 0, "interval between saves of buffered clicks")
+
+	flag.IntVar(&conf.DeletionBatchSize, "dlb", 0, "amount of URLs which are deleted at once")
+
+	flag.DurationVar(&conf.DeletionFlushInterval, "dlf", 0, "interval between deletions of queued URLs")
+
+	flag.DurationVar(&conf.DeletionGracePeriod, "dlg", 0, "time after deletion during which URLs may be restored, URLs are purged after it")
+
+	flag.DurationVar(&conf.PurgeInterval, "pi", 0, "interval between purges of URLs which were deleted before grace period")
+
+	flag.DurationVar(&conf.TokenLifetime, "tl", 0, "time after which JWT of a user expires")
+
+	flag.StringVar(&conf.JWTSigningKeys, "jk", "", "comma separated keys to sign JWTs in form id:algorithm:path (HS256, RS256 or EdDSA), the first one signs new JWTs")
+
+	flag.BoolVar(&conf.AcceptLegacyJWTs, "jl", false, "accept JWTs without kid signed by jwt key when jwt signing keys are set")
+}
+
+// compactor is an interface of storage which should be compacted from time to time.
+type compactor interface {
+	RunCompaction(ctx context.Context, interval time.Duration)
+}
+
+// storage is an interface of repository which keeps URLs, clicks made by them, users, their API keys and URLs waiting to be deleted,
+// URLs may be disabled by policy.
+type storage interface {
+	domain.URLRepository
+	domain.ClickRepository
+	domain.UserRepository
+	domain.APIKeyRepository
+	domain.DeletionOutbox
+	domain.TagRepository
+	domain.PolicyRepository
+}
+
+// newStore is a function to create store with all the URLs which are saved in repository.
+func newStore(ur domain.URLRepository) *state.Store {
+	urls, err := ur.ReadAll(context.Background())
+	if err != nil {
+		util.GetLogger().Infoln("init", err)
+	}
+
+	return state.NewStore(urls)
+}
+
+// newRepository is a function to choose storage of URL data. Postgres is used if DSN was provided,
+// otherwise URL data is kept in embedded database (if its file was set), in memory (if it was requested) or in JSON file.
+func newRepository(pg *state.Postgres, jsonFile string, boltPath string, inMemory bool) (storage, error) {
+	if pg != nil && pg.GetDSN() != "" {
+		return repository.NewURL(jsonFile, pg), nil
+	}
+
+	if boltPath != "" {
+		return repository.NewBolt(boltPath)
+	}
+
+	if inMemory {
+		return repository.NewMemory(), nil
+	}
+
+	return repository.NewURL(jsonFile, pg), nil
 }
 
 func main() {
 	const (
-		defaultFileStorage = "./tmp/short-url-db.json"
-		defaultJWTKey      = "ultrasecretkey" // user should set a value to jwt key through config file/flag/env variable, if he won't then this unsafe value will be used
-		HTTPPrefix         = "http://"
-		HTTPSPrefix        = "https://"
-		slash              = "/"
+		defaultFileStorage   = "./tmp/short-url-db.json"
+		insecureJWTKey       = "ultrasecretkey" // the key which was used by default before, it is publicly known, so the app refuses to start with it
+		defaultTokenLifetime = 30 * 24 * time.Hour
+		HTTPPrefix           = "http://"
+		HTTPSPrefix          = "https://"
+		slash                = "/"
+	)
+
+	// unless configured otherwise, file storage is compacted and expired URLs are searched for with these intervals
+	const (
+		defaultFileCompactionInterval  = 10 * time.Minute
+		defaultExpirationSweepInterval = time.Minute
+	)
+
+	// unless configured otherwise, policy files are checked for changes with this interval
+	const defaultPolicyReloadInterval = 10 * time.Second
+
+	// unless configured otherwise, clicks are buffered and saved with these parameters
+	const (
+		defaultClickBufferSize    = 10000
+		defaultClickFlushInterval = time.Second
+	)
+
+	// unless configured otherwise, URLs which users asked to delete are queued and deleted with these parameters
+	const (
+		defaultDeletionBatchSize     = 10
+		defaultDeletionFlushInterval = 450 * time.Millisecond
+		defaultDeletionGracePeriod   = 30 * 24 * time.Hour
+		defaultPurgeInterval         = time.Hour
 	)
 
 	// default names of env variables
 	var (
-		serverAddressEnvName   = "SERVER_ADDRESS"
-		baseURLEnvName         = "BASE_URL"
-		fileStoragePathEnvName = "FILE_STORAGE_PATH"
-		databaseDSNEnvName     = "DATABASE_DSN"
-		enableHTTPSEnvName     = "ENABLE_HTTPS"
-		configFileEnvName      = "CONFIG"
-		trustedSubnetEnvName   = "TRUSTED_SUBNET"
-		jwtKeyEnvName          = "JWT_KEY"
+		serverAddressEnvName    = "SERVER_ADDRESS"
+		baseURLEnvName          = "BASE_URL"
+		fileStoragePathEnvName  = "FILE_STORAGE_PATH"
+		databaseDSNEnvName      = "DATABASE_DSN"
+		enableHTTPSEnvName      = "ENABLE_HTTPS"
+		configFileEnvName       = "CONFIG"
+		trustedSubnetEnvName    = "TRUSTED_SUBNET"
+		jwtKeyEnvName           = "JWT_KEY"
+		inMemoryEnvName         = "IN_MEMORY_STORAGE"
+		fileCompactionEnvName   = "FILE_COMPACTION_INTERVAL"
+		boltPathEnvName         = "BOLT_DB_PATH"
+		tokenLifetimeEnvName    = "TOKEN_LIFETIME"
+		jwtSigningKeysEnvName   = "JWT_SIGNING_KEYS"
+		acceptLegacyJWTsEnvName = "ACCEPT_LEGACY_JWTS"
+
+		// options of short codes generation are shared by both servers
+		shortCodeStrategyEnvName = "SHORT_CODE_STRATEGY"
+		shortCodeAlphabetEnvName = "SHORT_CODE_ALPHABET"
+		shortCodeLengthEnvName   = "SHORT_CODE_LENGTH"
+		shortCodeSaltEnvName     = "SHORT_CODE_SALT"
+
+		// options of expiration of URLs are shared by both servers
+		expirationSweepEnvName = "EXPIRATION_SWEEP_INTERVAL"
+
+		// options of deduplication of original URLs are shared by both servers
+		dedupScopeEnvName = "DEDUP_SCOPE"
+
+		// options of click analytics are shared by both servers
+		clickBufferSizeEnvName    = "CLICK_BUFFER_SIZE"
+		clickFlushIntervalEnvName = "CLICK_FLUSH_INTERVAL"
+
+		// options of canonicalization of original URLs are shared by both servers
+		allowedSchemesEnvName = "ALLOWED_SCHEMES"
+		stripFragmentEnvName  = "STRIP_FRAGMENT"
+		sortQueryEnvName      = "SORT_QUERY"
+
+		// options of policy of destinations are shared by both servers
+		policyDirEnvName    = "POLICY_DIR"
+		policyReloadEnvName = "POLICY_RELOAD_INTERVAL"
+
+		// options of deletion queue are shared by both servers
+		deletionBatchSizeEnvName     = "DELETION_BATCH_SIZE"
+		deletionFlushIntervalEnvName = "DELETION_FLUSH_INTERVAL"
+		deletionGracePeriodEnvName   = "DELETION_GRACE_PERIOD"
+		purgeIntervalEnvName         = "PURGE_INTERVAL"
 
 		// other options (not mentioned in this block) are shared with http/https server
 		grpcAddressEnvName       = "GRPC_ADDRESS"
@@ -141,6 +304,7 @@ func main() {
 		grpcDatabaseDSNEnvName   = "GRPC_DSN"
 		grpcTrustedSubnetEnvName = "GRPC_TRUSTED_SUBNET"
 		grpcJWTKeyEnvName        = "GRPC_JWT_KEY"
+		grpcBoltPathEnvName      = "GRPC_BOLT_DB_PATH"
 
 		// these vars can be configured through config file (certificate and key paths too)
 		defaultHTTPS01ChallengeServer = ":80"
@@ -178,20 +342,44 @@ func main() {
 
 	// struct to redefine default env variables names
 	var configWithNames struct {
-		JSONFileEnvName            string `json:"file_storage_path_env,omitempty"`
-		DSNEnvName                 string `json:"database_dsn_env,omitempty"`
-		HTTPAddrEnvName            string `json:"server_address_env,omitempty"`
-		ShortAddrEnvName           string `json:"base_url_env,omitempty"`
-		HTTPSEnabledEnvName        string `json:"enable_https_env,omitempty"`
-		ConfigEnvName              string `json:"config_env,omitempty"`
-		TrustedSubnetEnvName       string `json:"trusted_subnet_env,omitempty"`
-		JWTKeyEnvName              string `json:"jwt_key_env,omitempty"`
-		GRPCAddressEnvName         string `json:"grpc_address_env,omitempty"`
-		GRPCSecureEnabledEnvName   string `json:"grpc_secure_env,omitempty"`
-		GRPCFileStoragePathEnvName string `json:"grpc_file_storage_path_env_name,omitempty"`
-		GRPCDatabaseDSNEnvName     string `json:"grpc_database_dsn_env_name,omitempty"`
-		GRPCTrustedSubnetEnvName   string `json:"grpc_trusted_subnet_env_name,omitempty"`
-		GRPCJWTKeyEnvName          string `json:"grpc_jwt_key_env_name,omitempty"`
+		JSONFileEnvName              string `json:"file_storage_path_env,omitempty"`
+		DSNEnvName                   string `json:"database_dsn_env,omitempty"`
+		HTTPAddrEnvName              string `json:"server_address_env,omitempty"`
+		ShortAddrEnvName             string `json:"base_url_env,omitempty"`
+		HTTPSEnabledEnvName          string `json:"enable_https_env,omitempty"`
+		ConfigEnvName                string `json:"config_env,omitempty"`
+		TrustedSubnetEnvName         string `json:"trusted_subnet_env,omitempty"`
+		JWTKeyEnvName                string `json:"jwt_key_env,omitempty"`
+		GRPCAddressEnvName           string `json:"grpc_address_env,omitempty"`
+		GRPCSecureEnabledEnvName     string `json:"grpc_secure_env,omitempty"`
+		GRPCFileStoragePathEnvName   string `json:"grpc_file_storage_path_env_name,omitempty"`
+		GRPCDatabaseDSNEnvName       string `json:"grpc_database_dsn_env_name,omitempty"`
+		GRPCTrustedSubnetEnvName     string `json:"grpc_trusted_subnet_env_name,omitempty"`
+		GRPCJWTKeyEnvName            string `json:"grpc_jwt_key_env_name,omitempty"`
+		InMemoryEnvName              string `json:"in_memory_storage_env,omitempty"`
+		FileCompactionEnvName        string `json:"file_compaction_interval_env,omitempty"`
+		BoltPathEnvName              string `json:"bolt_db_path_env,omitempty"`
+		GRPCBoltPathEnvName          string `json:"grpc_bolt_db_path_env_name,omitempty"`
+		ShortCodeStrategyEnvName     string `json:"short_code_strategy_env,omitempty"`
+		ShortCodeAlphabetEnvName     string `json:"short_code_alphabet_env,omitempty"`
+		ShortCodeLengthEnvName       string `json:"short_code_length_env,omitempty"`
+		ShortCodeSaltEnvName         string `json:"short_code_salt_env,omitempty"`
+		ExpirationSweepEnvName       string `json:"expiration_sweep_interval_env,omitempty"`
+		DedupScopeEnvName            string `json:"dedup_scope_env,omitempty"`
+		AllowedSchemesEnvName        string `json:"allowed_schemes_env,omitempty"`
+		StripFragmentEnvName         string `json:"strip_fragment_env,omitempty"`
+		SortQueryEnvName             string `json:"sort_query_env,omitempty"`
+		PolicyDirEnvName             string `json:"policy_dir_env,omitempty"`
+		PolicyReloadEnvName          string `json:"policy_reload_interval_env,omitempty"`
+		ClickBufferSizeEnvName       string `json:"click_buffer_size_env,omitempty"`
+		ClickFlushIntervalEnvName    string `json:"click_flush_interval_env,omitempty"`
+		DeletionBatchSizeEnvName     string `json:"deletion_batch_size_env,omitempty"`
+		DeletionFlushIntervalEnvName string `json:"deletion_flush_interval_env,omitempty"`
+		DeletionGracePeriodEnvName   string `json:"deletion_grace_period_env,omitempty"`
+		PurgeIntervalEnvName         string `json:"purge_interval_env,omitempty"`
+		TokenLifetimeEnvName         string `json:"token_lifetime_env,omitempty"`
+		JWTSigningKeysEnvName        string `json:"jwt_signing_keys_env,omitempty"`
+		AcceptLegacyJWTsEnvName      string `json:"accept_legacy_jwts_env,omitempty"`
 	}
 
 	if configWithNamesPath != "" {
@@ -274,6 +462,102 @@ func main() {
 		if configWithNames.GRPCJWTKeyEnvName != "" {
 			grpcJWTKeyEnvName = configWithNames.GRPCJWTKeyEnvName
 		}
+
+		if configWithNames.InMemoryEnvName != "" {
+			inMemoryEnvName = configWithNames.InMemoryEnvName
+		}
+
+		if configWithNames.FileCompactionEnvName != "" {
+			fileCompactionEnvName = configWithNames.FileCompactionEnvName
+		}
+
+		if configWithNames.BoltPathEnvName != "" {
+			boltPathEnvName = configWithNames.BoltPathEnvName
+		}
+
+		if configWithNames.GRPCBoltPathEnvName != "" {
+			grpcBoltPathEnvName = configWithNames.GRPCBoltPathEnvName
+		}
+
+		if configWithNames.ShortCodeStrategyEnvName != "" {
+			shortCodeStrategyEnvName = configWithNames.ShortCodeStrategyEnvName
+		}
+
+		if configWithNames.ShortCodeAlphabetEnvName != "" {
+			shortCodeAlphabetEnvName = configWithNames.ShortCodeAlphabetEnvName
+		}
+
+		if configWithNames.ShortCodeLengthEnvName != "" {
+			shortCodeLengthEnvName = configWithNames.ShortCodeLengthEnvName
+		}
+
+		if configWithNames.ShortCodeSaltEnvName != "" {
+			shortCodeSaltEnvName = configWithNames.ShortCodeSaltEnvName
+		}
+
+		if configWithNames.ExpirationSweepEnvName != "" {
+			expirationSweepEnvName = configWithNames.ExpirationSweepEnvName
+		}
+
+		if configWithNames.DedupScopeEnvName != "" {
+			dedupScopeEnvName = configWithNames.DedupScopeEnvName
+		}
+
+		if configWithNames.AllowedSchemesEnvName != "" {
+			allowedSchemesEnvName = configWithNames.AllowedSchemesEnvName
+		}
+
+		if configWithNames.StripFragmentEnvName != "" {
+			stripFragmentEnvName = configWithNames.StripFragmentEnvName
+		}
+
+		if configWithNames.SortQueryEnvName != "" {
+			sortQueryEnvName = configWithNames.SortQueryEnvName
+		}
+
+		if configWithNames.PolicyDirEnvName != "" {
+			policyDirEnvName = configWithNames.PolicyDirEnvName
+		}
+
+		if configWithNames.PolicyReloadEnvName != "" {
+			policyReloadEnvName = configWithNames.PolicyReloadEnvName
+		}
+
+		if configWithNames.ClickBufferSizeEnvName != "" {
+			clickBufferSizeEnvName = configWithNames.ClickBufferSizeEnvName
+		}
+
+		if configWithNames.ClickFlushIntervalEnvName != "" {
+			clickFlushIntervalEnvName = configWithNames.ClickFlushIntervalEnvName
+		}
+
+		if configWithNames.DeletionBatchSizeEnvName != "" {
+			deletionBatchSizeEnvName = configWithNames.DeletionBatchSizeEnvName
+		}
+
+		if configWithNames.DeletionFlushIntervalEnvName != "" {
+			deletionFlushIntervalEnvName = configWithNames.DeletionFlushIntervalEnvName
+		}
+
+		if configWithNames.DeletionGracePeriodEnvName != "" {
+			deletionGracePeriodEnvName = configWithNames.DeletionGracePeriodEnvName
+		}
+
+		if configWithNames.PurgeIntervalEnvName != "" {
+			purgeIntervalEnvName = configWithNames.PurgeIntervalEnvName
+		}
+
+		if configWithNames.TokenLifetimeEnvName != "" {
+			tokenLifetimeEnvName = configWithNames.TokenLifetimeEnvName
+		}
+
+		if configWithNames.JWTSigningKeysEnvName != "" {
+			jwtSigningKeysEnvName = configWithNames.JWTSigningKeysEnvName
+		}
+
+		if configWithNames.AcceptLegacyJWTsEnvName != "" {
+			acceptLegacyJWTsEnvName = configWithNames.AcceptLegacyJWTsEnvName
+		}
 	}
 
 	// getting values of environment variables
@@ -291,8 +575,32 @@ func main() {
 	grpcDatabaseDSNEnv, grpcDatabaseDSNSet := os.LookupEnv(grpcDatabaseDSNEnvName)
 	grpcTrustedSubnetEnv, grpcTrustedSubnetSet := os.LookupEnv(grpcTrustedSubnetEnvName)
 	grpcJWTKeyEnv, grpcJWTKeySet := os.LookupEnv(grpcJWTKeyEnvName)
-
-	var boolSecureEnv, boolSecureGRPCEnv bool
+	inMemoryEnv, inMemorySet := os.LookupEnv(inMemoryEnvName)
+	fileCompactionEnv, fileCompactionSet := os.LookupEnv(fileCompactionEnvName)
+	boltPathEnv, boltPathSet := os.LookupEnv(boltPathEnvName)
+	grpcBoltPathEnv, grpcBoltPathSet := os.LookupEnv(grpcBoltPathEnvName)
+	shortCodeStrategyEnv, shortCodeStrategySet := os.LookupEnv(shortCodeStrategyEnvName)
+	shortCodeAlphabetEnv, shortCodeAlphabetSet := os.LookupEnv(shortCodeAlphabetEnvName)
+	shortCodeLengthEnv, shortCodeLengthSet := os.LookupEnv(shortCodeLengthEnvName)
+	shortCodeSaltEnv, shortCodeSaltSet := os.LookupEnv(shortCodeSaltEnvName)
+	expirationSweepEnv, expirationSweepSet := os.LookupEnv(expirationSweepEnvName)
+	dedupScopeEnv, dedupScopeSet := os.LookupEnv(dedupScopeEnvName)
+	allowedSchemesEnv, allowedSchemesSet := os.LookupEnv(allowedSchemesEnvName)
+	stripFragmentEnv, stripFragmentSet := os.LookupEnv(stripFragmentEnvName)
+	sortQueryEnv, sortQuerySet := os.LookupEnv(sortQueryEnvName)
+	policyDirEnv, policyDirSet := os.LookupEnv(policyDirEnvName)
+	policyReloadEnv, policyReloadSet := os.LookupEnv(policyReloadEnvName)
+	clickBufferSizeEnv, clickBufferSizeSet := os.LookupEnv(clickBufferSizeEnvName)
+	clickFlushIntervalEnv, clickFlushIntervalSet := os.LookupEnv(clickFlushIntervalEnvName)
+	deletionBatchSizeEnv, deletionBatchSizeSet := os.LookupEnv(deletionBatchSizeEnvName)
+	deletionFlushIntervalEnv, deletionFlushIntervalSet := os.LookupEnv(deletionFlushIntervalEnvName)
+	deletionGracePeriodEnv, deletionGracePeriodSet := os.LookupEnv(deletionGracePeriodEnvName)
+	purgeIntervalEnv, purgeIntervalSet := os.LookupEnv(purgeIntervalEnvName)
+	tokenLifetimeEnv, tokenLifetimeSet := os.LookupEnv(tokenLifetimeEnvName)
+	jwtSigningKeysEnv, jwtSigningKeysSet := os.LookupEnv(jwtSigningKeysEnvName)
+	acceptLegacyJWTsEnv, acceptLegacyJWTsSet := os.LookupEnv(acceptLegacyJWTsEnvName)
+
+	var boolSecureEnv, boolSecureGRPCEnv, boolInMemoryEnv bool
 	if secureSet {
 		// parsing value because os.LookupEnv returns a string, not a bool
 		boolSecureEnv, err = strconv.ParseBool(secureEnv)
@@ -310,6 +618,139 @@ func main() {
 		}
 	}
 
+	if inMemorySet {
+		boolInMemoryEnv, err = strconv.ParseBool(inMemoryEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var boolAcceptLegacyJWTsEnv bool
+	if acceptLegacyJWTsSet {
+		boolAcceptLegacyJWTsEnv, err = strconv.ParseBool(acceptLegacyJWTsEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var boolStripFragmentEnv, boolSortQueryEnv bool
+	if stripFragmentSet {
+		boolStripFragmentEnv, err = strconv.ParseBool(stripFragmentEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	if sortQuerySet {
+		boolSortQueryEnv, err = strconv.ParseBool(sortQueryEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var durationFileCompactionEnv time.Duration
+	if fileCompactionSet {
+		durationFileCompactionEnv, err = time.ParseDuration(fileCompactionEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var durationExpirationSweepEnv time.Duration
+	if expirationSweepSet {
+		durationExpirationSweepEnv, err = time.ParseDuration(expirationSweepEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var durationPolicyReloadEnv time.Duration
+	if policyReloadSet {
+		durationPolicyReloadEnv, err = time.ParseDuration(policyReloadEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var intClickBufferSizeEnv int
+	if clickBufferSizeSet {
+		intClickBufferSizeEnv, err = strconv.Atoi(clickBufferSizeEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var durationClickFlushIntervalEnv time.Duration
+	if clickFlushIntervalSet {
+		durationClickFlushIntervalEnv, err = time.ParseDuration(clickFlushIntervalEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var intDeletionBatchSizeEnv int
+	if deletionBatchSizeSet {
+		intDeletionBatchSizeEnv, err = strconv.Atoi(deletionBatchSizeEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var durationDeletionFlushIntervalEnv time.Duration
+	if deletionFlushIntervalSet {
+		durationDeletionFlushIntervalEnv, err = time.ParseDuration(deletionFlushIntervalEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var durationDeletionGracePeriodEnv time.Duration
+	if deletionGracePeriodSet {
+		durationDeletionGracePeriodEnv, err = time.ParseDuration(deletionGracePeriodEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var durationPurgeIntervalEnv time.Duration
+	if purgeIntervalSet {
+		durationPurgeIntervalEnv, err = time.ParseDuration(purgeIntervalEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var durationTokenLifetimeEnv time.Duration
+	if tokenLifetimeSet {
+		durationTokenLifetimeEnv, err = time.ParseDuration(tokenLifetimeEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
+	var intShortCodeLengthEnv int
+	if shortCodeLengthSet {
+		intShortCodeLengthEnv, err = strconv.Atoi(shortCodeLengthEnv)
+		if err != nil {
+			util.GetLogger().Infoln(err)
+			return
+		}
+	}
+
 	util.GetLogger().Debugln("serv", httpEnv, httpSet, "out", shortEnv, shortSet)
 
 	// if a value was set by environment variable, we have to redefine values in config because it was set by flags before
@@ -370,6 +811,102 @@ func main() {
 		conf.GRPCJWTKey = grpcJWTKeyEnv
 	}
 
+	if inMemorySet {
+		conf.InMemory = boolInMemoryEnv
+	}
+
+	if fileCompactionSet {
+		conf.FileCompactionInterval = durationFileCompactionEnv
+	}
+
+	if boltPathSet {
+		conf.BoltPath = boltPathEnv
+	}
+
+	if grpcBoltPathSet {
+		conf.GRPCBoltPath = grpcBoltPathEnv
+	}
+
+	if shortCodeStrategySet {
+		conf.ShortCodeStrategy = shortCodeStrategyEnv
+	}
+
+	if shortCodeAlphabetSet {
+		conf.ShortCodeAlphabet = shortCodeAlphabetEnv
+	}
+
+	if shortCodeLengthSet {
+		conf.ShortCodeLength = intShortCodeLengthEnv
+	}
+
+	if shortCodeSaltSet {
+		conf.ShortCodeSalt = shortCodeSaltEnv
+	}
+
+	if expirationSweepSet {
+		conf.ExpirationSweepInterval = durationExpirationSweepEnv
+	}
+
+	if dedupScopeSet {
+		conf.DedupScope = dedupScopeEnv
+	}
+
+	if allowedSchemesSet {
+		conf.AllowedSchemes = allowedSchemesEnv
+	}
+
+	if stripFragmentSet {
+		conf.StripFragment = boolStripFragmentEnv
+	}
+
+	if sortQuerySet {
+		conf.SortQuery = boolSortQueryEnv
+	}
+
+	if policyDirSet {
+		conf.PolicyDir = policyDirEnv
+	}
+
+	if policyReloadSet {
+		conf.PolicyReloadInterval = durationPolicyReloadEnv
+	}
+
+	if clickBufferSizeSet {
+		conf.ClickBufferSize = intClickBufferSizeEnv
+	}
+
+	if clickFlushIntervalSet {
+		conf.ClickFlushInterval = durationClickFlushIntervalEnv
+	}
+
+	if deletionBatchSizeSet {
+		conf.DeletionBatchSize = intDeletionBatchSizeEnv
+	}
+
+	if deletionFlushIntervalSet {
+		conf.DeletionFlushInterval = durationDeletionFlushIntervalEnv
+	}
+
+	if deletionGracePeriodSet {
+		conf.DeletionGracePeriod = durationDeletionGracePeriodEnv
+	}
+
+	if purgeIntervalSet {
+		conf.PurgeInterval = durationPurgeIntervalEnv
+	}
+
+	if tokenLifetimeSet {
+		conf.TokenLifetime = durationTokenLifetimeEnv
+	}
+
+	if jwtSigningKeysSet {
+		conf.JWTSigningKeys = jwtSigningKeysEnv
+	}
+
+	if acceptLegacyJWTsSet {
+		conf.AcceptLegacyJWTs = boolAcceptLegacyJWTsEnv
+	}
+
 	// required names of settings in a config file are not the same as in config struct, so we need another one which is rawConfig
 	var rawConfig struct {
 		JSONFile          string `json:"file_storage_path,omitempty"`
@@ -385,6 +922,30 @@ func main() {
 		GRPCDatabaseDSN   string `json:"grpc_dsn,omitempty"`
 		GRPCTrustedSubnet string `json:"grpc_trusted_subnet,omitempty"`
 		GRPCJWTKey        string `json:"grpc_jwt_key,omitempty"`
+		InMemory          bool   `json:"in_memory_storage,omitempty"`
+		FileCompaction    string `json:"file_compaction_interval,omitempty"`
+		ShortCodeStrategy string `json:"short_code_strategy,omitempty"`
+		ShortCodeAlphabet string `json:"short_code_alphabet,omitempty"`
+		ShortCodeLength   int    `json:"short_code_length,omitempty"`
+		ShortCodeSalt     string `json:"short_code_salt,omitempty"`
+		ExpirationSweep   string `json:"expiration_sweep_interval,omitempty"`
+		DedupScope        string `json:"dedup_scope,omitempty"`
+		AllowedSchemes    string `json:"allowed_schemes,omitempty"`
+		StripFragment     bool   `json:"strip_fragment,omitempty"`
+		SortQuery         bool   `json:"sort_query,omitempty"`
+		PolicyDir         string `json:"policy_dir,omitempty"`
+		PolicyReload      string `json:"policy_reload_interval,omitempty"`
+		ClickBufferSize   int    `json:"click_buffer_size,omitempty"`
+		ClickFlush        string `json:"click_flush_interval,omitempty"`
+		DeletionBatchSize int    `json:"deletion_batch_size,omitempty"`
+		DeletionFlush     string `json:"deletion_flush_interval,omitempty"`
+		DeletionGrace     string `json:"deletion_grace_period,omitempty"`
+		PurgeInterval     string `json:"purge_interval,omitempty"`
+		TokenLifetime     string `json:"token_lifetime,omitempty"`
+		JWTSigningKeys    string `json:"jwt_signing_keys,omitempty"`
+		AcceptLegacyJWTs  bool   `json:"accept_legacy_jwts,omitempty"`
+		BoltPath          string `json:"bolt_db_path,omitempty"`
+		GRPCBoltPath      string `json:"grpc_bolt_db_path,omitempty"`
 
 		DefaultHTTPS01ChallengeAddress string `json:"default_https_01_challenge_address"`
 		CacheDirPath                   string `json:"cache_dir"`
@@ -508,16 +1069,186 @@ func main() {
 		if conf.GRPCJWTKey == "" {
 			conf.GRPCJWTKey = rawConfig.GRPCJWTKey
 		}
+
+		if !conf.InMemory {
+			conf.InMemory = rawConfig.InMemory
+		}
+
+		if conf.BoltPath == "" {
+			conf.BoltPath = rawConfig.BoltPath
+		}
+
+		if conf.GRPCBoltPath == "" {
+			conf.GRPCBoltPath = rawConfig.GRPCBoltPath
+		}
+
+		if conf.ShortCodeStrategy == "" {
+			conf.ShortCodeStrategy = rawConfig.ShortCodeStrategy
+		}
+
+		if conf.ShortCodeAlphabet == "" {
+			conf.ShortCodeAlphabet = rawConfig.ShortCodeAlphabet
+		}
+
+		if conf.ShortCodeLength == 0 {
+			conf.ShortCodeLength = rawConfig.ShortCodeLength
+		}
+
+		if conf.ShortCodeSalt == "" {
+			conf.ShortCodeSalt = rawConfig.ShortCodeSalt
+		}
+
+		if conf.ExpirationSweepInterval == 0 && rawConfig.ExpirationSweep != "" {
+			conf.ExpirationSweepInterval, err = time.ParseDuration(rawConfig.ExpirationSweep)
+			if err != nil {
+				util.GetLogger().Infoln("Error parsing expiration sweep interval:", err)
+				return
+			}
+		}
+
+		if conf.DedupScope == "" {
+			conf.DedupScope = rawConfig.DedupScope
+		}
+
+		if conf.AllowedSchemes == "" {
+			conf.AllowedSchemes = rawConfig.AllowedSchemes
+		}
+
+		if !conf.StripFragment {
+			conf.StripFragment = rawConfig.StripFragment
+		}
+
+		if !conf.SortQuery {
+			conf.SortQuery = rawConfig.SortQuery
+		}
+
+		if conf.PolicyDir == "" {
+			conf.PolicyDir = rawConfig.PolicyDir
+		}
+
+		if conf.PolicyReloadInterval == 0 && rawConfig.PolicyReload != "" {
+			conf.PolicyReloadInterval, err = time.ParseDuration(rawConfig.PolicyReload)
+			if err != nil {
+				util.GetLogger().Infoln("Error parsing policy reload interval:", err)
+				return
+			}
+		}
+
+		if conf.ClickBufferSize == 0 {
+			conf.ClickBufferSize = rawConfig.ClickBufferSize
+		}
+
+		if conf.ClickFlushInterval == 0 && rawConfig.ClickFlush != "" {
+			conf.ClickFlushInterval, err = time.ParseDuration(rawConfig.ClickFlush)
+			if err != nil {
+				util.GetLogger().Infoln("Error parsing click flush interval:", err)
+				return
+			}
+		}
+
+		if conf.DeletionBatchSize == 0 {
+			conf.DeletionBatchSize = rawConfig.DeletionBatchSize
+		}
+
+		if conf.DeletionFlushInterval == 0 && rawConfig.DeletionFlush != "" {
+			conf.DeletionFlushInterval, err = time.ParseDuration(rawConfig.DeletionFlush)
+			if err != nil {
+				util.GetLogger().Infoln("Error parsing deletion flush interval:", err)
+				return
+			}
+		}
+
+		if conf.DeletionGracePeriod == 0 && rawConfig.DeletionGrace != "" {
+			conf.DeletionGracePeriod, err = time.ParseDuration(rawConfig.DeletionGrace)
+			if err != nil {
+				util.GetLogger().Infoln("Error parsing deletion grace period:", err)
+				return
+			}
+		}
+
+		if conf.PurgeInterval == 0 && rawConfig.PurgeInterval != "" {
+			conf.PurgeInterval, err = time.ParseDuration(rawConfig.PurgeInterval)
+			if err != nil {
+				util.GetLogger().Infoln("Error parsing purge interval:", err)
+				return
+			}
+		}
+
+		if conf.JWTSigningKeys == "" {
+			conf.JWTSigningKeys = rawConfig.JWTSigningKeys
+		}
+
+		if !conf.AcceptLegacyJWTs {
+			conf.AcceptLegacyJWTs = rawConfig.AcceptLegacyJWTs
+		}
+
+		if conf.TokenLifetime == 0 && rawConfig.TokenLifetime != "" {
+			conf.TokenLifetime, err = time.ParseDuration(rawConfig.TokenLifetime)
+			if err != nil {
+				util.GetLogger().Infoln("Error parsing token lifetime:", err)
+				return
+			}
+		}
+
+		if conf.FileCompactionInterval == 0 && rawConfig.FileCompaction != "" {
+			conf.FileCompactionInterval, err = time.ParseDuration(rawConfig.FileCompaction)
+			if err != nil {
+				util.GetLogger().Infoln("Error parsing file compaction interval:", err)
+				return
+			}
+		}
+	}
+
+	// anyone could sign JWTs for any user with publicly known key, so there is no default one
+	if conf.JWTKey == insecureJWTKey {
+		util.GetLogger().Infoln("jwt key", insecureJWTKey, "is publicly known, set another one")
+		return
 	}
 
-	if conf.JWTKey == "" {
-		conf.JWTKey = defaultJWTKey
+	if conf.TokenLifetime == 0 {
+		conf.TokenLifetime = defaultTokenLifetime
 	}
 
 	if conf.JSONFile == "" {
 		conf.JSONFile = defaultFileStorage
 	}
 
+	if conf.FileCompactionInterval == 0 {
+		conf.FileCompactionInterval = defaultFileCompactionInterval
+	}
+
+	if conf.ExpirationSweepInterval == 0 {
+		conf.ExpirationSweepInterval = defaultExpirationSweepInterval
+	}
+
+	if conf.PolicyReloadInterval == 0 {
+		conf.PolicyReloadInterval = defaultPolicyReloadInterval
+	}
+
+	if conf.ClickBufferSize == 0 {
+		conf.ClickBufferSize = defaultClickBufferSize
+	}
+
+	if conf.ClickFlushInterval == 0 {
+		conf.ClickFlushInterval = defaultClickFlushInterval
+	}
+
+	if conf.DeletionBatchSize == 0 {
+		conf.DeletionBatchSize = defaultDeletionBatchSize
+	}
+
+	if conf.DeletionFlushInterval == 0 {
+		conf.DeletionFlushInterval = defaultDeletionFlushInterval
+	}
+
+	if conf.DeletionGracePeriod == 0 {
+		conf.DeletionGracePeriod = defaultDeletionGracePeriod
+	}
+
+	if conf.PurgeInterval == 0 {
+		conf.PurgeInterval = defaultPurgeInterval
+	}
+
 	if conf.GRPCFileStorage == "" {
 		conf.GRPCFileStorage = conf.JSONFile
 	}
@@ -526,6 +1257,10 @@ func main() {
 		conf.GRPCDatabaseDSN = conf.DSN
 	}
 
+	if conf.GRPCBoltPath == "" {
+		conf.GRPCBoltPath = conf.BoltPath
+	}
+
 	if conf.GRPCTrustedSubnet == "" {
 		conf.GRPCTrustedSubnet = conf.TrustedSubnet
 	}
@@ -540,7 +1275,8 @@ func main() {
 	if conf.DSN != "" {
 		pg, err = state.NewPG(conf.DSN)
 		if err != nil {
-			util.GetLogger().Infoln(err)
+			// postgres is chosen only at startup, once it is used its errors are returned instead of switching to file storage
+			util.GetLogger().Infoln("postgres is not available, so URL data is kept in file storage:", err)
 		}
 		util.GetLogger().Debugln(pg)
 		var pgPtr *sql.DB
@@ -593,21 +1329,72 @@ func main() {
 	// WaitGroup is required for handlers which can create goroutines working in
 	// background without using network, so we could wait for them to shut down gracefully
 	var wg sync.WaitGroup
-	var once sync.Once
 
-	ur := repository.NewURL(conf.JSONFile, pg)
-	us := service.NewURL(ur)
+	ur, err := newRepository(pg, conf.JSONFile, conf.BoltPath, conf.InMemory)
+	if err != nil {
+		util.GetLogger().Infoln("failed to open storage:", err)
+		return
+	}
+
+	if c, ok := ur.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	gen, err := shortcode.New(conf.ShortCodeStrategy, conf.ShortCodeAlphabet, conf.ShortCodeLength, conf.ShortCodeSalt)
+	if err != nil {
+		util.GetLogger().Infoln("failed to configure short codes:", err)
+		return
+	}
+
+	scope, err := domain.ParseDedupScope(conf.DedupScope)
+	if err != nil {
+		util.GetLogger().Infoln("failed to configure deduplication:", err)
+		return
+	}
+
+	schemes, err := canonical.ParseSchemes(conf.AllowedSchemes)
+	if err != nil {
+		util.GetLogger().Infoln("failed to configure allowed schemes:", err)
+		return
+	}
+
+	// canonicalizer has no state, so it is shared by both servers
+	canon := canonical.New(schemes, conf.StripFragment, conf.SortQuery)
 
-	var urGRPC *repository.URL
+	// policy files are shared by both servers too
+	engine, err := policy.New(conf.PolicyDir)
+	if err != nil {
+		util.GetLogger().Infoln("failed to load policy:", err)
+		return
+	}
+
+	// policy service disables URLs in the same store which URL service reads
+	store := newStore(ur)
+	us := service.NewURL(ur, store, gen, canon, engine, scope)
+	cs := service.NewClick(ur, ur, conf.ClickBufferSize, conf.ClickFlushInterval)
+	users := service.NewUser(ur)
+	apiKeys := service.NewAPIKey(ur)
+	deletions := service.NewDeletion(ur, ur, conf.DeletionBatchSize, conf.DeletionFlushInterval, conf.DeletionGracePeriod)
+	tags := service.NewTag(ur)
+	policies := service.NewPolicy(ur, store, engine)
+
+	var urGRPC storage
 	var usGRPC *service.URL
+	var csGRPC *service.Click
+	var usersGRPC *service.User
+	var apiKeysGRPC *service.APIKey
+	var deletionsGRPC *service.Deletion
+	var tagsGRPC *service.Tag
+	var policiesGRPC *service.Policy
 	pgGRPC := &state.Postgres{}
-	if conf.JSONFile == conf.GRPCFileStorage && conf.DSN == conf.GRPCDatabaseDSN {
-		usGRPC = us
+	if conf.JSONFile == conf.GRPCFileStorage && conf.DSN == conf.GRPCDatabaseDSN && conf.BoltPath == conf.GRPCBoltPath {
+		usGRPC, csGRPC, usersGRPC, apiKeysGRPC, deletionsGRPC, tagsGRPC, policiesGRPC = us, cs, users, apiKeys, deletions, tags, policies
 	} else {
 		if conf.GRPCDatabaseDSN != "" {
 			pgGRPC, err = state.NewPG(conf.GRPCDatabaseDSN)
 			if err != nil {
-				util.GetLogger().Infoln(err)
+				// postgres is chosen only at startup, once it is used its errors are returned instead of switching to file storage
+				util.GetLogger().Infoln("postgres is not available, so URL data is kept in file storage:", err)
 			}
 			util.GetLogger().Debugln(pgGRPC)
 			var pgPtr *sql.DB
@@ -618,12 +1405,115 @@ func main() {
 			defer pgPtr.Close()
 		}
 
-		urGRPC = repository.NewURL(conf.GRPCFileStorage, pgGRPC)
-		usGRPC = service.NewURL(urGRPC)
+		urGRPC, err = newRepository(pgGRPC, conf.GRPCFileStorage, conf.GRPCBoltPath, conf.InMemory)
+		if err != nil {
+			util.GetLogger().Infoln("failed to open gRPC server storage:", err)
+			return
+		}
+
+		if c, ok := urGRPC.(io.Closer); ok {
+			defer c.Close()
+		}
+
+		// gRPC server uses its own storage, so URLs are cached from it separately
+		// counters of strategies are separate for separate storages
+		var genGRPC domain.ShortCodeGenerator
+		genGRPC, err = shortcode.New(conf.ShortCodeStrategy, conf.ShortCodeAlphabet, conf.ShortCodeLength, conf.ShortCodeSalt)
+		if err != nil {
+			util.GetLogger().Infoln("failed to configure short codes:", err)
+			return
+		}
+
+		storeGRPC := newStore(urGRPC)
+		usGRPC = service.NewURL(urGRPC, storeGRPC, genGRPC, canon, engine, scope)
+		csGRPC = service.NewClick(urGRPC, urGRPC, conf.ClickBufferSize, conf.ClickFlushInterval)
+		// ids of users are unique within a storage, so gRPC server registers its users in its own storage
+		usersGRPC = service.NewUser(urGRPC)
+		apiKeysGRPC = service.NewAPIKey(urGRPC)
+		// URLs are deleted from the storage whose outbox they were queued to, so gRPC server needs its own deletion worker
+		deletionsGRPC = service.NewDeletion(urGRPC, urGRPC, conf.DeletionBatchSize, conf.DeletionFlushInterval, conf.DeletionGracePeriod)
+		tagsGRPC = service.NewTag(urGRPC)
+		policiesGRPC = service.NewPolicy(urGRPC, storeGRPC, engine)
+	}
+
+	// file storages are compacted in background while the app is running
+	compactionCtx, stopCompaction := context.WithCancel(context.Background())
+	defer stopCompaction()
+
+	if c, ok := ur.(compactor); ok {
+		go c.RunCompaction(compactionCtx, conf.FileCompactionInterval)
+	}
+
+	if c, ok := urGRPC.(compactor); ok {
+		go c.RunCompaction(compactionCtx, conf.FileCompactionInterval)
+	}
+
+	// policy files are checked for changes in background, so new rules are applied to new URLs without restart
+	go engine.Run(compactionCtx, conf.PolicyReloadInterval)
+
+	// expired URLs are marked as deleted in background too, and deleted URLs are purged after grace period,
+	// gRPC service has its own sweeper and purger only if it has its own storage
+	go us.RunExpirationSweeper(compactionCtx, conf.ExpirationSweepInterval)
+	go us.RunPurger(compactionCtx, conf.PurgeInterval, conf.DeletionGracePeriod)
+	if usGRPC != us {
+		go usGRPC.RunExpirationSweeper(compactionCtx, conf.ExpirationSweepInterval)
+		go usGRPC.RunPurger(compactionCtx, conf.PurgeInterval, conf.DeletionGracePeriod)
 	}
 
-	shortURLsChan := domain.NewMutexChanString(make(chan domain.URLWithID, 10))
-	r := router(us, ur, conf.JWTKey, conf.TrustedSubnet, shortURLsChan, &wg, &once)
+	// clicks are saved in background, buffered clicks are saved when the servers are shut down
+	clicksCtx, stopClicks := context.WithCancel(context.Background())
+	defer stopClicks()
+
+	var clicksWg sync.WaitGroup
+	clicksWg.Add(1)
+	go func() {
+		defer clicksWg.Done()
+		cs.Run(clicksCtx)
+	}()
+
+	if csGRPC != cs {
+		clicksWg.Add(1)
+		go func() {
+			defer clicksWg.Done()
+			csGRPC.Run(clicksCtx)
+		}()
+	}
+
+	// queued URLs are deleted in background, the ones left in outbox are deleted when the servers are shut down
+	deletionsCtx, stopDeletions := context.WithCancel(context.Background())
+	defer stopDeletions()
+
+	var deletionsWg sync.WaitGroup
+	deletionsWg.Add(1)
+	go func() {
+		defer deletionsWg.Done()
+		deletions.Run(deletionsCtx)
+	}()
+
+	if deletionsGRPC != deletions {
+		deletionsWg.Add(1)
+		go func() {
+			defer deletionsWg.Done()
+			deletionsGRPC.Run(deletionsCtx)
+		}()
+	}
+
+	// both servers check JWTs with the same keys
+	keys, err := keyring.Load(conf.JWTKey, conf.JWTSigningKeys, conf.AcceptLegacyJWTs)
+	if err != nil {
+		util.GetLogger().Infoln("failed to load jwt signing keys:", err)
+		return
+	}
+
+	// users are registered in storage of the server which they came to
+	tokens := auth.NewJWT(keys, conf.TokenLifetime)
+	authenticator := auth.NewAuthenticator(tokens, tokens, users, apiKeys)
+	authenticatorGRPC := authenticator
+	if usersGRPC != users {
+		authenticatorGRPC = auth.NewAuthenticator(tokens, tokens, usersGRPC, apiKeysGRPC)
+	}
+
+	r := router(us, cs, apiKeys, deletions, tags, policies, authenticator, conf.TrustedSubnet, &wg)
 
 	var m *autocert.Manager
 
@@ -665,13 +1555,16 @@ func main() {
 			log.Fatalf("Failed to setup tls: %v", err)
 		}
 		grpcServer = grpc.NewServer(grpc.Creds(creds), grpc.ChainUnaryInterceptor(interceptor.Log,
-			interceptor.Authorize(conf.JWTKey), interceptor.CheckCIDR(conf.TrustedSubnet), interceptor.ValidateRequest))
+			interceptor.Authorize(authenticatorGRPC), interceptor.CheckCIDR(conf.TrustedSubnet), interceptor.ValidateRequest, interceptor.RecordClicks(csGRPC)),
+			grpc.ChainStreamInterceptor(interceptor.LogStream, interceptor.AuthorizeStream(authenticatorGRPC), interceptor.ValidateStream))
 	} else {
-		grpcServer = grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Log, interceptor.Authorize(conf.JWTKey),
-			interceptor.CheckCIDR(conf.TrustedSubnet), interceptor.ValidateRequest))
+		grpcServer = grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Log, interceptor.Authorize(authenticatorGRPC),
+			interceptor.CheckCIDR(conf.TrustedSubnet), interceptor.ValidateRequest, interceptor.RecordClicks(csGRPC)),
+			grpc.ChainStreamInterceptor(interceptor.LogStream, interceptor.AuthorizeStream(authenticatorGRPC), interceptor.ValidateStream))
 	}
 
-	urlshrtServer := &handler.Server{Wg: &wg, Once: &once, Srv: usGRPC, ShortURLsChan: shortURLsChan}
+	urlshrtServer := &handler.Server{Wg: &wg, Srv: usGRPC, ClickSrv: csGRPC, APIKeySrv: apiKeysGRPC, DeletionSrv: deletionsGRPC,
+		TagSrv: tagsGRPC, PolicySrv: policiesGRPC}
 	api.RegisterUrlshrtV1Server(grpcServer, urlshrtServer)
 
 	// channel to intercept signals for graceful shutdown
@@ -697,8 +1590,6 @@ func main() {
 	}()
 
 	go func() {
-		ma, _ := state.GetCurrentURLsPtr()
-		util.GetLogger().Infoln(ma.Urls)
 		err = grpcServer.Serve(listenerGRPC)
 		if err != nil {
 			util.GetLogger().Error(err)
@@ -731,6 +1622,15 @@ func main() {
 	wg.Wait()
 
 	grpcServer.GracefulStop()
+
+	// no more clicks can be made, so the buffered ones are saved
+	stopClicks()
+	clicksWg.Wait()
+
+	// no more URLs can be queued, so the ones left in outbox are deleted
+	stopDeletions()
+	deletionsWg.Wait()
+
 	// waiting for shutdown to finish
 	<-shutdownCtx.Done()
 	util.GetLogger().Debugln("shutdownCtx done:", shutdownCtx.Err().Error())
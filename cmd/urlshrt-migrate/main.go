@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func run() error {
+	from := flag.String("from", "", "source storage (file:path, bolt:path or postgres:DSN)")
+	to := flag.String("to", "", "target storage (file:path, bolt:path or postgres:DSN)")
+	checkpointLocation := flag.String("checkpoint", "", "file to save progress to, migration is resumed from it")
+	batchSize := flag.Int("batch", 500, "amount of records written at once")
+	dryRun := flag.Bool("dry-run", false, "only show how many records would be migrated")
+	verifyOnly := flag.Bool("verify-only", false, "only compare counts and checksums of the storages")
+	flag.Parse()
+
+	if *from == "" || *to == "" {
+		return errors.New("both -from and -to should be set")
+	}
+
+	if *from == *to {
+		return errors.New("source and target storages are the same")
+	}
+
+	if *batchSize <= 0 {
+		return errors.New("batch size should be positive")
+	}
+
+	if err := util.InitLogger(); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGQUIT)
+	defer stop()
+
+	source, closeSource, err := openStorage(*from)
+	if err != nil {
+		return fmt.Errorf("open source: %w", err)
+	}
+	defer closeSource()
+
+	target, closeTarget, err := openStorage(*to)
+	if err != nil {
+		return fmt.Errorf("open target: %w", err)
+	}
+	defer closeTarget()
+
+	if !*verifyOnly {
+		cp, err := readCheckpoint(*checkpointLocation, *from, *to)
+		if err != nil {
+			return err
+		}
+
+		res, err := migrate(ctx, source, target, cp, *checkpointLocation, *batchSize, *dryRun)
+		if err != nil {
+			return fmt.Errorf("migrate: %w", err)
+		}
+
+		related, err := migrateRelated(ctx, source, target, *batchSize, *dryRun)
+		if err != nil {
+			return fmt.Errorf("migrate related records: %w", err)
+		}
+
+		if *dryRun {
+			fmt.Printf("dry run: %d records would be migrated, %d were migrated before\n", res.Migrated, res.Skipped)
+			fmt.Printf("dry run: %d users, %d API keys, %d clicks and %d revisions would be copied\n", related.Users, related.APIKeys,
+				related.Clicks, related.Revisions)
+			return nil
+		}
+
+		fmt.Printf("%d records migrated, %d were migrated before\n", res.Migrated, res.Skipped)
+		fmt.Printf("%d users, %d API keys, %d clicks and %d revisions copied (the ones which already existed were skipped)\n",
+			related.Users, related.APIKeys, related.Clicks, related.Revisions)
+	}
+
+	return verify(ctx, os.Stdout, source, target)
+}
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,463 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+// exporter is an interface of storage which can give all its URLs with owners and deletion flags in order of their creation,
+// and all the records related to them: registered users, API keys, clicks and revisions of original URLs.
+type exporter interface {
+	Export(ctx context.Context, fn func(url state.URLStringJSON) error) error
+	ExportUsers(ctx context.Context, fn func(user domain.User) error) error
+	ExportAPIKeys(ctx context.Context, fn func(key domain.APIKey) error) error
+	ExportRevisions(ctx context.Context, fn func(rev domain.URLRevision) error) error
+	ReadClicks(ctx context.Context, shortened string) ([]domain.Click, error)
+}
+
+// importer is an interface of storage which can save URLs keeping their owners and deletion flags, and the records related to them.
+type importer interface {
+	Import(ctx context.Context, urls []state.URLStringJSON) error
+	ImportUsers(ctx context.Context, users []domain.User) error
+	ImportAPIKeys(ctx context.Context, keys []domain.APIKey) error
+	ImportRevisions(ctx context.Context, revisions []domain.URLRevision) error
+	CreateClicks(ctx context.Context, clicks []domain.Click) error
+	ReadClicks(ctx context.Context, shortened string) ([]domain.Click, error)
+}
+
+type storage interface {
+	exporter
+	importer
+}
+
+// openStorage opens storage which is set as kind:location. Returned function should be called to close the storage.
+func openStorage(spec string) (storage, func() error, error) {
+	kind, location, ok := strings.Cut(spec, ":")
+	if !ok || location == "" {
+		return nil, nil, fmt.Errorf("storage %s should be set as kind:location", spec)
+	}
+
+	noop := func() error { return nil }
+
+	switch kind {
+	case "file":
+		return repository.NewFile(location), noop, nil
+	case "bolt":
+		r, err := repository.NewBolt(location)
+		if err != nil {
+			return nil, nil, err
+		}
+		return r, r.Close, nil
+	case "postgres":
+		pg, err := state.NewPG(location)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		db, err := pg.GetPgPtr()
+		if err != nil {
+			return nil, nil, err
+		}
+		// file location is empty, so the repository never falls back to file storage
+		return repository.NewURL("", pg), db.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown kind of storage %s, file, bolt or postgres expected", kind)
+	}
+}
+
+// checkpoint is a type which represents progress of migration, it is saved after every written batch.
+type checkpoint struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	LastUUID  int    `json:"last_uuid"`
+	LastShort string `json:"last_short"`
+	Migrated  int    `json:"migrated"`
+}
+
+// after checks if URL goes after the last migrated one in order of export.
+func (c *checkpoint) after(url state.URLStringJSON) bool {
+	if c.Migrated == 0 {
+		return true
+	}
+
+	if url.UUID != c.LastUUID {
+		return url.UUID > c.LastUUID
+	}
+	return url.ShortURL > c.LastShort
+}
+
+func readCheckpoint(location string, from string, to string) (*checkpoint, error) {
+	cp := &checkpoint{From: from, To: to}
+	if location == "" {
+		return cp, nil
+	}
+
+	data, err := os.ReadFile(location)
+	if errors.Is(err, os.ErrNotExist) {
+		return cp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, cp); err != nil {
+		return nil, err
+	}
+
+	if cp.From != from || cp.To != to {
+		return nil, fmt.Errorf("checkpoint %s was saved for migration from %s to %s", location, cp.From, cp.To)
+	}
+
+	return cp, nil
+}
+
+// writeCheckpoint saves checkpoint to temporary file and then renames it, so the checkpoint is never half-written.
+func writeCheckpoint(location string, cp *checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+
+	tmp := location + ".tmp"
+	if err = os.MkdirAll(filepath.Dir(location), 0700); err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, location)
+}
+
+// migrationResult is a type which represents result of migration.
+type migrationResult struct {
+	Skipped  int
+	Migrated int
+}
+
+// migrate copies URLs from one storage to another in batches. URLs which were migrated before the checkpoint are skipped.
+// If dryRun is true, nothing is written.
+func migrate(ctx context.Context, from exporter, to importer, cp *checkpoint, checkpointLocation string, batchSize int, dryRun bool) (migrationResult, error) {
+	var res migrationResult
+	batch := make([]state.URLStringJSON, 0, batchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if !dryRun {
+			if err := to.Import(ctx, batch); err != nil {
+				return err
+			}
+
+			last := batch[len(batch)-1]
+			cp.LastUUID, cp.LastShort = last.UUID, last.ShortURL
+			cp.Migrated += len(batch)
+
+			if checkpointLocation != "" {
+				if err := writeCheckpoint(checkpointLocation, cp); err != nil {
+					return err
+				}
+			}
+		}
+
+		res.Migrated += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	err := from.Export(ctx, func(url state.URLStringJSON) error {
+		if !cp.after(url) {
+			res.Skipped++
+			return nil
+		}
+
+		batch = append(batch, url)
+		if len(batch) < batchSize {
+			return nil
+		}
+
+		return flush()
+	})
+	if err != nil {
+		return res, err
+	}
+
+	return res, flush()
+}
+
+// relatedResult is a type which represents amounts of records related to URLs which were copied.
+// Records which already exist in the target storage are counted too, but they are not written again.
+type relatedResult struct {
+	Users     int
+	APIKeys   int
+	Clicks    int
+	Revisions int
+}
+
+// migrateRelated copies registered users, API keys, revisions and clicks from one storage to another in batches,
+// it should be called after URLs are migrated, since revisions and clicks of unknown URLs are skipped. Users, API keys
+// and revisions which already exist in the target storage are skipped, clicks of URL are copied only if the target storage
+// has no clicks of it, so the migration may be started again. If dryRun is true, nothing is written.
+func migrateRelated(ctx context.Context, from exporter, to importer, batchSize int, dryRun bool) (relatedResult, error) {
+	var res relatedResult
+
+	users := make([]domain.User, 0, batchSize)
+	flushUsers := func() error {
+		if !dryRun && len(users) != 0 {
+			if err := to.ImportUsers(ctx, users); err != nil {
+				return err
+			}
+		}
+
+		res.Users += len(users)
+		users = users[:0]
+		return nil
+	}
+
+	err := from.ExportUsers(ctx, func(user domain.User) error {
+		if users = append(users, user); len(users) < batchSize {
+			return nil
+		}
+		return flushUsers()
+	})
+	if err == nil {
+		err = flushUsers()
+	}
+	if err != nil {
+		return res, fmt.Errorf("users: %w", err)
+	}
+
+	keys := make([]domain.APIKey, 0, batchSize)
+	flushKeys := func() error {
+		if !dryRun && len(keys) != 0 {
+			if err := to.ImportAPIKeys(ctx, keys); err != nil {
+				return err
+			}
+		}
+
+		res.APIKeys += len(keys)
+		keys = keys[:0]
+		return nil
+	}
+
+	err = from.ExportAPIKeys(ctx, func(key domain.APIKey) error {
+		if keys = append(keys, key); len(keys) < batchSize {
+			return nil
+		}
+		return flushKeys()
+	})
+	if err == nil {
+		err = flushKeys()
+	}
+	if err != nil {
+		return res, fmt.Errorf("API keys: %w", err)
+	}
+
+	revisions := make([]domain.URLRevision, 0, batchSize)
+	flushRevisions := func() error {
+		if !dryRun && len(revisions) != 0 {
+			if err := to.ImportRevisions(ctx, revisions); err != nil {
+				return err
+			}
+		}
+
+		res.Revisions += len(revisions)
+		revisions = revisions[:0]
+		return nil
+	}
+
+	err = from.ExportRevisions(ctx, func(rev domain.URLRevision) error {
+		if revisions = append(revisions, rev); len(revisions) < batchSize {
+			return nil
+		}
+		return flushRevisions()
+	})
+	if err == nil {
+		err = flushRevisions()
+	}
+	if err != nil {
+		return res, fmt.Errorf("revisions: %w", err)
+	}
+
+	err = from.Export(ctx, func(url state.URLStringJSON) error {
+		clicks, err := from.ReadClicks(ctx, url.ShortURL)
+		if err != nil || len(clicks) == 0 {
+			return err
+		}
+
+		res.Clicks += len(clicks)
+		if dryRun {
+			return nil
+		}
+
+		// clicks of URL are written at once, so the target storage has either all of them or none
+		saved, err := to.ReadClicks(ctx, url.ShortURL)
+		if err != nil || len(saved) != 0 {
+			return err
+		}
+
+		return to.CreateClicks(ctx, clicks)
+	})
+	if err != nil {
+		return res, fmt.Errorf("clicks: %w", err)
+	}
+
+	return res, nil
+}
+
+// summary is a type which represents counts and checksum of all the records of a storage.
+type summary struct {
+	Total     int
+	Deleted   int
+	Users     int
+	APIKeys   int
+	Clicks    int
+	Revisions int
+	Checksum  string
+}
+
+// formatTime is a function to write optional time in the form which doesn't depend on the storage
+// (postgres keeps time with microsecond precision).
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano)
+}
+
+// writeRecord writes URL with all its saved fields to hash in the form which doesn't depend on the storage
+// (tags are sorted, since storages may keep them in different order).
+func writeRecord(h hash.Hash, url state.URLStringJSON) {
+	tags := append(make([]string, 0, len(url.Tags)), url.Tags...)
+	sort.Strings(tags)
+
+	fmt.Fprintf(h, "%d\t%s\t%s\t%d\t%t\t%s\t%s\t%s\t%s\t%s\t%q\t%q\t%q\t%t\n", url.UUID, url.ShortURL, url.OriginalURL, url.UserID,
+		url.IsDeleted, formatTime(url.DeletedAt), formatTime(url.ExpiresAt), formatTime(url.NotBefore), formatTime(url.CreatedAt),
+		formatTime(url.UpdatedAt), url.Title, url.Note, tags, url.IsDisabled)
+}
+
+// writeClicks writes clicks of URL to hash sorted by all their fields, since storages may keep clicks made at the same time
+// in different order.
+func writeClicks(h hash.Hash, clicks []domain.Click) {
+	sort.Slice(clicks, func(i, j int) bool {
+		a, b := clicks[i], clicks[j]
+		if !a.Time.Equal(b.Time) {
+			return a.Time.Before(b.Time)
+		}
+		if a.Referrer != b.Referrer {
+			return a.Referrer < b.Referrer
+		}
+		if a.UserAgent != b.UserAgent {
+			return a.UserAgent < b.UserAgent
+		}
+		return a.IP < b.IP
+	})
+
+	for _, click := range clicks {
+		fmt.Fprintf(h, "click\t%s\t%s\t%q\t%q\t%q\n", click.ShortURL, formatTime(&click.Time), click.Referrer, click.UserAgent, click.IP)
+	}
+}
+
+func summarize(ctx context.Context, s exporter) (summary, error) {
+	var sum summary
+	h := sha256.New()
+
+	err := s.Export(ctx, func(url state.URLStringJSON) error {
+		sum.Total++
+		if url.IsDeleted {
+			sum.Deleted++
+		}
+
+		writeRecord(h, url)
+
+		clicks, err := s.ReadClicks(ctx, url.ShortURL)
+		if err != nil {
+			return err
+		}
+
+		sum.Clicks += len(clicks)
+		writeClicks(h, clicks)
+		return nil
+	})
+	if err != nil {
+		return sum, err
+	}
+
+	err = s.ExportUsers(ctx, func(user domain.User) error {
+		sum.Users++
+		fmt.Fprintf(h, "user\t%d\t%s\n", user.ID, formatTime(&user.CreatedAt))
+		return nil
+	})
+	if err != nil {
+		return sum, err
+	}
+
+	err = s.ExportAPIKeys(ctx, func(key domain.APIKey) error {
+		sum.APIKeys++
+		fmt.Fprintf(h, "key\t%s\t%d\t%q\t%s\t%s\t%s\t%s\n", key.ID, key.UserID, key.Name, key.Prefix, key.Hash,
+			formatTime(&key.CreatedAt), formatTime(key.RevokedAt))
+		return nil
+	})
+	if err != nil {
+		return sum, err
+	}
+
+	err = s.ExportRevisions(ctx, func(rev domain.URLRevision) error {
+		sum.Revisions++
+		fmt.Fprintf(h, "revision\t%s\t%d\t%s\t%s\t%s\n", rev.ShortURL, rev.Revision, rev.PreviousURL, rev.OriginalURL,
+			formatTime(&rev.ChangedAt))
+		return nil
+	})
+	if err != nil {
+		return sum, err
+	}
+
+	sum.Checksum = hex.EncodeToString(h.Sum(nil))
+	return sum, nil
+}
+
+// verify compares counts and checksums of both storages, an error is returned if they differ.
+func verify(ctx context.Context, w io.Writer, from exporter, to exporter) error {
+	fromSum, err := summarize(ctx, from)
+	if err != nil {
+		return err
+	}
+
+	toSum, err := summarize(ctx, to)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range []struct {
+		name string
+		sum  summary
+	}{{"source", fromSum}, {"target", toSum}} {
+		fmt.Fprintf(w, "%s: %d records (%d deleted), %d users, %d API keys, %d clicks, %d revisions, checksum %s\n", s.name,
+			s.sum.Total, s.sum.Deleted, s.sum.Users, s.sum.APIKeys, s.sum.Clicks, s.sum.Revisions, s.sum.Checksum)
+	}
+
+	if fromSum != toSum {
+		return errors.New("verification failed, storages are not equal")
+	}
+
+	return nil
+}
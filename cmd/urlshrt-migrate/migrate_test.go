@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+// failingImporter is an importer which fails after it has saved some batches, like if the migration was interrupted.
+type failingImporter struct {
+	importer
+	batchesLeft int
+}
+
+func (i *failingImporter) Import(ctx context.Context, urls []state.URLStringJSON) error {
+	if i.batchesLeft == 0 {
+		return errors.New("interrupted")
+	}
+
+	i.batchesLeft--
+	return i.importer.Import(ctx, urls)
+}
+
+func TestMigrate(t *testing.T) {
+	dir := t.TempDir()
+
+	source := repository.NewMemory()
+	require.NoError(t, source.Import(context.Background(), []state.URLStringJSON{
+		{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru", UserID: 1},
+		{UUID: 2, ShortURL: "cba", OriginalURL: "https://mail.ru", UserID: 2, IsDeleted: true},
+		{UUID: 3, ShortURL: "bca", OriginalURL: "https://hh.ru", UserID: -1},
+		{UUID: 3, ShortURL: "acb", OriginalURL: "https://go.dev", UserID: 1},
+		{UUID: 4, ShortURL: "cab", OriginalURL: "https://pkg.go.dev", UserID: 2},
+	}))
+
+	target, err := repository.NewBolt(filepath.Join(dir, "urlshrt.db"))
+	require.NoError(t, err)
+	defer target.Close()
+
+	cpLocation := filepath.Join(dir, "checkpoint.json")
+
+	cp, err := readCheckpoint(cpLocation, "memory", "bolt")
+	require.NoError(t, err)
+
+	res, err := migrate(context.Background(), source, target, cp, cpLocation, 2, true)
+	require.NoError(t, err)
+	require.Equal(t, 5, res.Migrated)
+	require.Error(t, verify(context.Background(), io.Discard, source, target))
+
+	_, err = migrate(context.Background(), source, &failingImporter{importer: target, batchesLeft: 1}, cp, cpLocation, 2, false)
+	require.Error(t, err)
+
+	_, err = readCheckpoint(cpLocation, "memory", "file")
+	require.Error(t, err)
+
+	cp, err = readCheckpoint(cpLocation, "memory", "bolt")
+	require.NoError(t, err)
+	require.Equal(t, 2, cp.Migrated)
+
+	res, err = migrate(context.Background(), source, target, cp, cpLocation, 2, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.Skipped)
+	require.Equal(t, 3, res.Migrated)
+
+	require.NoError(t, verify(context.Background(), io.Discard, source, target))
+
+	deleted, err := target.IsURLDeleted(context.Background(), "cba")
+	require.NoError(t, err)
+	require.True(t, deleted)
+}
+
+func TestVerifyAllFields(t *testing.T) {
+	dir := t.TempDir()
+
+	at := time.Date(2024, 5, 1, 10, 0, 0, 123456789, time.UTC)
+	later := at.Add(time.Hour)
+	url := state.URLStringJSON{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru", UserID: 1, IsDeleted: true, DeletedAt: &later,
+		ExpiresAt: &later, NotBefore: &at, CreatedAt: &at, UpdatedAt: &later, Title: "Yandex", Note: "search\tengine",
+		Tags: []string{"search", "ru"}, IsDisabled: true}
+
+	source := repository.NewMemory()
+	require.NoError(t, source.Import(context.Background(), []state.URLStringJSON{url}))
+
+	b, err := repository.NewBolt(filepath.Join(dir, "urlshrt.db"))
+	require.NoError(t, err)
+	defer b.Close()
+
+	f := repository.NewFile(filepath.Join(dir, "db.json"))
+
+	// every field is kept by storages, so the URL is the same after it went through all of them
+	for name, target := range map[string]storage{"bolt": b, "file": f} {
+		cp, err := readCheckpoint("", "memory", name)
+		require.NoError(t, err)
+
+		_, err = migrate(context.Background(), source, target, cp, "", 2, false)
+		require.NoError(t, err, name)
+		require.NoError(t, verify(context.Background(), io.Discard, source, target), name)
+	}
+
+	// a change of any field is found by verification
+	changes := []func(u *state.URLStringJSON){
+		func(u *state.URLStringJSON) { u.DeletedAt = &at },
+		func(u *state.URLStringJSON) { u.CreatedAt = nil },
+		func(u *state.URLStringJSON) { u.UpdatedAt = &at },
+		func(u *state.URLStringJSON) { u.Title = "" },
+		func(u *state.URLStringJSON) { u.Note = "search engine" },
+		func(u *state.URLStringJSON) { u.Tags = []string{"search"} },
+		func(u *state.URLStringJSON) { u.IsDisabled = false },
+	}
+
+	for i, change := range changes {
+		changed := url
+		change(&changed)
+
+		target := repository.NewMemory()
+		require.NoError(t, target.Import(context.Background(), []state.URLStringJSON{changed}))
+		require.Error(t, verify(context.Background(), io.Discard, source, target), i)
+	}
+}
+
+func TestMigrateRelated(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	source := repository.NewMemory()
+	require.NoError(t, source.CreateLegacyUser(ctx, 5))
+	owner, err := source.CreateUser(ctx)
+	require.NoError(t, err)
+	_, err = source.CreateUser(ctx)
+	require.NoError(t, err)
+
+	ownerCtx := domain.WithIdentity(ctx, domain.Identity{UserID: owner})
+	_, err = source.Create(ownerCtx, []state.URLStringJSON{
+		{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru"},
+		{UUID: 2, ShortURL: "cba", OriginalURL: "https://mail.ru"},
+	})
+	require.NoError(t, err)
+
+	at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	_, err = source.UpdateOriginal(ownerCtx, "abc", "https://go.dev", at)
+	require.NoError(t, err)
+	_, err = source.UpdateOriginal(ownerCtx, "abc", "https://pkg.go.dev", at.Add(time.Minute))
+	require.NoError(t, err)
+
+	require.NoError(t, source.CreateClicks(ctx, []domain.Click{
+		{ShortURL: "abc", Time: at, Referrer: "https://ya.ru", UserAgent: "curl", IP: "127.0.0.1"},
+		{ShortURL: "abc", Time: at},
+		{ShortURL: "cba", Time: at.Add(time.Hour)},
+	}))
+
+	require.NoError(t, source.CreateAPIKey(ctx, domain.APIKey{ID: "1", UserID: owner, Name: "ci", Prefix: "abc", Hash: "hash1", CreatedAt: at}))
+	require.NoError(t, source.CreateAPIKey(ctx, domain.APIKey{ID: "2", UserID: owner, Prefix: "cba", Hash: "hash2", CreatedAt: at}))
+	require.NoError(t, source.RevokeAPIKey(ctx, owner, "2", at.Add(time.Hour)))
+
+	b, err := repository.NewBolt(filepath.Join(dir, "urlshrt.db"))
+	require.NoError(t, err)
+	defer b.Close()
+
+	for name, target := range map[string]storage{"bolt": b, "file": repository.NewFile(filepath.Join(dir, "db.json"))} {
+		cp, err := readCheckpoint("", "memory", name)
+		require.NoError(t, err)
+
+		_, err = migrate(ctx, source, target, cp, "", 1, false)
+		require.NoError(t, err, name)
+
+		// nothing is written in dry run, so related records are still missing
+		res, err := migrateRelated(ctx, source, target, 1, true)
+		require.NoError(t, err, name)
+		require.Equal(t, relatedResult{Users: 3, APIKeys: 2, Clicks: 3, Revisions: 2}, res, name)
+		require.Error(t, verify(ctx, io.Discard, source, target), name)
+
+		// related records which were already copied are not copied again
+		for i := 0; i < 2; i++ {
+			res, err = migrateRelated(ctx, source, target, 1, false)
+			require.NoError(t, err, name)
+			require.Equal(t, relatedResult{Users: 3, APIKeys: 2, Clicks: 3, Revisions: 2}, res, name)
+			require.NoError(t, verify(ctx, io.Discard, source, target), name)
+		}
+
+		// ids of copied users are not given to new users
+		id, err := target.(domain.UserRepository).CreateUser(ctx)
+		require.NoError(t, err, name)
+		require.Equal(t, owner+2, id, name)
+
+		key, err := target.(domain.APIKeyRepository).ReadAPIKeyByHash(ctx, "hash2")
+		require.NoError(t, err, name)
+		require.NotNil(t, key.RevokedAt, name)
+
+		// numbers of copied revisions are not given to new revisions
+		_, err = target.(domain.URLRepository).UpdateOriginal(ownerCtx, "abc", "https://ya.ru", at.Add(time.Hour))
+		require.NoError(t, err, name)
+		revisions, err := target.(domain.URLRepository).ReadRevisions(ownerCtx, "abc")
+		require.NoError(t, err, name)
+		require.Len(t, revisions, 3, name)
+		require.Equal(t, 3, revisions[2].Revision, name)
+	}
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+// TokenInfo is a type which represents what was learned from a valid token.
+type TokenInfo struct {
+	UserID int64
+	// NeedsRefresh is true if the token is still valid, but it should be replaced with a new one for the same user.
+	NeedsRefresh bool
+	// Legacy is true if the token was issued before the registry of users existed, so the user should be saved to it.
+	Legacy bool
+}
+
+// TokenIssuer is an interface which defines what functions does an object which will issue tokens for users should implement.
+type TokenIssuer interface {
+	Issue(userID int64) (string, error)
+}
+
+// TokenVerifier is an interface which defines what functions does an object which will check tokens of users should implement.
+type TokenVerifier interface {
+	Verify(token string) (TokenInfo, error)
+}
+
+// APIKeyResolver is an interface which defines what functions does an object which will find owners of API keys should implement.
+type APIKeyResolver interface {
+	Resolve(ctx context.Context, key string) (int64, error)
+}
+
+// Authenticator is a type which finds out who the user is by token or API key. Users without valid token are registered as new users
+// only if the request may save something, so anonymous redirects don't grow the registry of users.
+type Authenticator struct {
+	verifier TokenVerifier
+	issuer   TokenIssuer
+	users    domain.UserService
+	keys     APIKeyResolver
+}
+
+func NewAuthenticator(verifier TokenVerifier, issuer TokenIssuer, users domain.UserService, keys APIKeyResolver) *Authenticator {
+	return &Authenticator{verifier: verifier, issuer: issuer, users: users, keys: keys}
+}
+
+// APIKey gets API key from values of X-API-Key and Authorization headers (or metadata), X-API-Key is preferred.
+// Empty string is returned if there is no API key, so the user should be authenticated by token.
+func APIKey(apiKey, authorization string) string {
+	if apiKey != "" {
+		return apiKey
+	}
+
+	const bearer = "Bearer "
+	if len(authorization) > len(bearer) && strings.EqualFold(authorization[:len(bearer)], bearer) {
+		return strings.TrimSpace(authorization[len(bearer):])
+	}
+
+	return ""
+}
+
+// AuthenticateAPIKey gets identity of the user who owns API key. Unlike token, API key which is not valid doesn't lead
+// to registration of a new user, error which wraps ErrInvalidAPIKey is returned, so the client learns that the key doesn't work.
+func (a *Authenticator) AuthenticateAPIKey(ctx context.Context, key string) (domain.Identity, error) {
+	if a.keys == nil {
+		return domain.Identity{}, domain.ErrInvalidAPIKey
+	}
+
+	id, err := a.keys.Resolve(ctx, key)
+	if err != nil {
+		return domain.Identity{}, err
+	}
+
+	return domain.Identity{UserID: id}, nil
+}
+
+// Authenticate gets identity of the user by token. User from legacy token is saved to the registry of users before the token is replaced.
+// If token is empty or not valid, a new user is registered when register is true,
+// otherwise identity of the user is new and has no id (-1), such user can't own anything.
+// If the user should get a new token, it is returned too, otherwise returned token is empty.
+func (a *Authenticator) Authenticate(ctx context.Context, token string, register bool) (domain.Identity, string, error) {
+	if token != "" {
+		info, err := a.verifier.Verify(token)
+		if err == nil {
+			identity := domain.Identity{UserID: info.UserID}
+			if info.Legacy {
+				if err = a.users.RegisterLegacy(ctx, info.UserID); err != nil {
+					return domain.Identity{}, "", err
+				}
+			}
+
+			if !info.NeedsRefresh {
+				return identity, "", nil
+			}
+
+			newToken, err := a.issuer.Issue(info.UserID)
+			return identity, newToken, err
+		}
+
+		util.GetLogger().Infoln("token is not valid:", err)
+	}
+
+	if !register {
+		return domain.Identity{UserID: -1, New: true}, "", nil
+	}
+
+	id, err := a.users.Register(ctx)
+	if err != nil {
+		return domain.Identity{}, "", err
+	}
+
+	newToken, err := a.issuer.Issue(id)
+	if err != nil {
+		return domain.Identity{}, "", err
+	}
+
+	return domain.Identity{UserID: id, New: true}, newToken, nil
+}
@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/domain/mocks"
+	"github.com/PoorMercymain/urlshrt/internal/keyring"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestAuthenticate(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	users := mocks.NewMockUserService(ctrl)
+	users.EXPECT().Register(gomock.Any()).Return(domain.FirstUserID, nil).Times(3)
+
+	tokens := NewJWT(keyring.NewHMAC("abc"), time.Hour)
+	a := NewAuthenticator(tokens, tokens, users, nil)
+
+	fresh, err := tokens.Issue(7)
+	require.NoError(t, err)
+
+	// a token issued by another instance which has a different key
+	foreign, err := NewJWT(keyring.NewHMAC("cba"), time.Hour).Issue(7)
+	require.NoError(t, err)
+
+	stale, err := NewJWT(keyring.NewHMAC("abc"), time.Minute).Issue(7)
+	require.NoError(t, err)
+
+	// users from legacy tokens are saved to the registry before they get new tokens
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"userid": 5}).SignedString([]byte("abc"))
+	require.NoError(t, err)
+	users.EXPECT().RegisterLegacy(gomock.Any(), int64(5)).Return(nil)
+
+	var testTable = []struct {
+		name     string
+		token    string
+		register bool
+		identity domain.Identity
+		newToken bool
+	}{
+		{"no token", "", true, domain.Identity{UserID: domain.FirstUserID, New: true}, true},
+		{"no token, nothing to save", "", false, domain.Identity{UserID: -1, New: true}, false},
+		{"fresh token", fresh, false, domain.Identity{UserID: 7}, false},
+		{"token about to expire", stale, false, domain.Identity{UserID: 7}, true},
+		{"legacy token", legacy, false, domain.Identity{UserID: 5}, true},
+		{"token signed by unknown key", foreign, true, domain.Identity{UserID: domain.FirstUserID, New: true}, true},
+		{"token signed by unknown key, nothing to save", foreign, false, domain.Identity{UserID: -1, New: true}, false},
+		{"malformed token", "abc", true, domain.Identity{UserID: domain.FirstUserID, New: true}, true},
+	}
+
+	for _, testCase := range testTable {
+		identity, newToken, err := a.Authenticate(context.Background(), testCase.token, testCase.register)
+		require.NoError(t, err, testCase.name)
+		require.Equal(t, testCase.identity, identity, testCase.name)
+		require.Equal(t, testCase.newToken, newToken != "", testCase.name)
+
+		if newToken != "" {
+			info, err := tokens.Verify(newToken)
+			require.NoError(t, err, testCase.name)
+			require.Equal(t, identity.UserID, info.UserID, testCase.name)
+			require.False(t, info.NeedsRefresh, testCase.name)
+		}
+	}
+
+	users.EXPECT().Register(gomock.Any()).Return(int64(-1), errors.New("storage is not available"))
+	_, _, err = a.Authenticate(context.Background(), "", true)
+	require.Error(t, err)
+
+	users.EXPECT().RegisterLegacy(gomock.Any(), int64(5)).Return(errors.New("storage is not available"))
+	_, _, err = a.Authenticate(context.Background(), legacy, false)
+	require.Error(t, err)
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keys := mocks.NewMockAPIKeyService(ctrl)
+	keys.EXPECT().Resolve(gomock.Any(), "urlshrt_good").Return(int64(7), nil)
+	keys.EXPECT().Resolve(gomock.Any(), "urlshrt_revoked").Return(int64(-1), domain.ErrInvalidAPIKey)
+
+	tokens := NewJWT(keyring.NewHMAC("abc"), time.Hour)
+	// users are never registered by API keys
+	a := NewAuthenticator(tokens, tokens, mocks.NewMockUserService(ctrl), keys)
+
+	identity, err := a.AuthenticateAPIKey(context.Background(), "urlshrt_good")
+	require.NoError(t, err)
+	require.Equal(t, domain.Identity{UserID: 7}, identity)
+
+	_, err = a.AuthenticateAPIKey(context.Background(), "urlshrt_revoked")
+	require.ErrorIs(t, err, domain.ErrInvalidAPIKey)
+
+	_, err = NewAuthenticator(tokens, tokens, nil, nil).AuthenticateAPIKey(context.Background(), "urlshrt_good")
+	require.ErrorIs(t, err, domain.ErrInvalidAPIKey)
+
+	var testTable = []struct {
+		apiKey        string
+		authorization string
+		expected      string
+	}{
+		{"", "", ""},
+		{"urlshrt_a", "Bearer urlshrt_b", "urlshrt_a"},
+		{"", "Bearer urlshrt_b", "urlshrt_b"},
+		{"", "bearer urlshrt_b ", "urlshrt_b"},
+		{"", "Basic dXNlcjpwYXNz", ""},
+		{"", "Bearer ", ""},
+	}
+
+	for _, testCase := range testTable {
+		require.Equal(t, testCase.expected, APIKey(testCase.apiKey, testCase.authorization), testCase.authorization)
+	}
+}
+
+func TestVerifyLegacyToken(t *testing.T) {
+	tokens := NewJWT(keyring.NewHMAC("abc"), time.Hour)
+
+	// tokens of the first versions had only id of the user, which was random
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"userid": 5}).SignedString([]byte("abc"))
+	require.NoError(t, err)
+
+	info, err := tokens.Verify(legacy)
+	require.NoError(t, err)
+	require.Equal(t, TokenInfo{UserID: 5, NeedsRefresh: true, Legacy: true}, info)
+
+	// ids of registered users were never put to legacy tokens
+	for _, id := range []int64{domain.FirstUserID, -1} {
+		notRandom, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"userid": id}).SignedString([]byte("abc"))
+		require.NoError(t, err)
+
+		_, err = tokens.Verify(notRandom)
+		require.ErrorIs(t, err, errLegacyUserID)
+	}
+
+	withoutUser, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{}).SignedString([]byte("abc"))
+	require.NoError(t, err)
+
+	_, err = tokens.Verify(withoutUser)
+	require.ErrorIs(t, err, errNoUserID)
+}
@@ -0,0 +1,3 @@
+// auth is a package which authenticates users by their tokens and registers new users. HTTP middleware and gRPC interceptor
+// are thin adapters of Authenticator, so both transports follow the same rules.
+package auth
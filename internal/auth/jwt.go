@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/keyring"
+)
+
+var (
+	errNoUserID     = errors.New("token has no user id")
+	errLegacyUserID = errors.New("legacy token has id which is not random")
+)
+
+// userClaims is a type which represents claims of JWT, id of the user is kept in subject.
+type userClaims struct {
+	jwt.RegisteredClaims
+	// LegacyUserID is set in tokens which were issued before the registry of users existed, such tokens have no subject
+	LegacyUserID *int64 `json:"userid,omitempty"`
+}
+
+// userID is a function to get id of the user from claims, legacy is true if the id is from a legacy token.
+// Ids in legacy tokens were random and below FirstUserID, other ids are not accepted from them.
+func (c *userClaims) userID() (id int64, legacy bool, err error) {
+	if c.Subject != "" {
+		id, err = strconv.ParseInt(c.Subject, 10, 64)
+		return id, false, err
+	}
+
+	if c.LegacyUserID != nil {
+		if *c.LegacyUserID < 0 || *c.LegacyUserID >= domain.FirstUserID {
+			return -1, true, errLegacyUserID
+		}
+
+		return *c.LegacyUserID, true, nil
+	}
+
+	return -1, false, errNoUserID
+}
+
+// JWT is a type which issues JWTs signed by keyring and checks them. JWT expires after lifetime.
+type JWT struct {
+	keys     *keyring.Keyring
+	lifetime time.Duration
+}
+
+func NewJWT(keys *keyring.Keyring, lifetime time.Duration) *JWT {
+	return &JWT{keys: keys, lifetime: lifetime}
+}
+
+// Issue creates JWT which contains id of the user in subject and has issue and expiration time.
+func (j *JWT) Issue(userID int64) (string, error) {
+	now := time.Now()
+	claims := userClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.FormatInt(userID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(j.lifetime)),
+		},
+	}
+
+	return j.keys.Sign(claims)
+}
+
+// Verify checks signature and expiration time of JWT. JWT should be refreshed when less than a half of its lifetime is left,
+// when it is a legacy token (made before the registry of users existed) or when it was signed by a key which is not active anymore.
+func (j *JWT) Verify(tokenString string) (TokenInfo, error) {
+	claims := &userClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, j.keys.Keyfunc)
+	if err != nil {
+		return TokenInfo{}, err
+	}
+
+	if !token.Valid {
+		return TokenInfo{}, errors.New("token is not valid")
+	}
+
+	id, legacy, err := claims.userID()
+	if err != nil {
+		return TokenInfo{}, err
+	}
+
+	return TokenInfo{
+		UserID:       id,
+		Legacy:       legacy,
+		NeedsRefresh: legacy || !j.keys.IsActive(token) || claims.ExpiresAt == nil || time.Until(claims.ExpiresAt.Time) < j.lifetime/2,
+	}, nil
+}
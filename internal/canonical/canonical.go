@@ -0,0 +1,245 @@
+// canonical is a package which contains checks and canonicalization of original URLs.
+package canonical
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/idna"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// DefaultSchemes are schemes of original URLs which are allowed if schemes were not configured.
+var DefaultSchemes = []string{"http", "https"}
+
+// defaultPorts are ports which are dropped from URLs with the schemes, because the URLs lead to the same place without them.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ftp":   "21",
+	"ws":    "80",
+	"wss":   "443",
+}
+
+// hostProfile converts hosts the way browsers look them up, but underscores are allowed,
+// because they are used in names of some real hosts.
+var hostProfile = idna.New(idna.MapForLookup(), idna.StrictDomainName(false), idna.VerifyDNSLength(true), idna.BidiRule())
+
+// Canonicalizer checks original URLs and brings them to the canonical form: URL without scheme gets the default one,
+// scheme and host are lowercased, IDN host is converted to punycode, default port is dropped and empty path is replaced with "/".
+// Fragment may be stripped and query parameters may be sorted by name if it was configured.
+type Canonicalizer struct {
+	schemes map[string]struct{}
+	// defaultScheme is added to URLs without scheme, it is https if https is allowed, otherwise the first allowed scheme
+	defaultScheme string
+	stripFragment bool
+	sortQuery     bool
+}
+
+// New creates Canonicalizer which allows only URLs with schemes from the list (or DefaultSchemes if the list is empty).
+func New(schemes []string, stripFragment bool, sortQuery bool) *Canonicalizer {
+	if len(schemes) == 0 {
+		schemes = DefaultSchemes
+	}
+
+	allowed := make(map[string]struct{}, len(schemes))
+	for _, scheme := range schemes {
+		allowed[strings.ToLower(strings.TrimSpace(scheme))] = struct{}{}
+	}
+
+	defaultScheme := strings.ToLower(strings.TrimSpace(schemes[0]))
+	if _, ok := allowed["https"]; ok {
+		defaultScheme = "https"
+	}
+
+	return &Canonicalizer{schemes: allowed, defaultScheme: defaultScheme, stripFragment: stripFragment, sortQuery: sortQuery}
+}
+
+// ParseSchemes is a function to get schemes from comma separated list, empty list means that DefaultSchemes are allowed.
+func ParseSchemes(list string) ([]string, error) {
+	if strings.TrimSpace(list) == "" {
+		return nil, nil
+	}
+
+	var schemes []string
+	for _, scheme := range strings.Split(list, ",") {
+		scheme = strings.ToLower(strings.TrimSpace(scheme))
+		if !isScheme(scheme) {
+			return nil, fmt.Errorf("invalid scheme %q", scheme)
+		}
+		schemes = append(schemes, scheme)
+	}
+
+	return schemes, nil
+}
+
+// isScheme checks if s may be a scheme of URL (RFC 3986, section 3.1).
+func isScheme(s string) bool {
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		return false
+	}
+
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '+' && c != '-' && c != '.' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isPort checks if s consists only of digits, like a port after the host.
+func isPort(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// withScheme adds the default scheme to original URL which has none (like "example.com/path", "localhost:8080" or
+// "//example.com"), so such URLs are shortened the same way as the ones with the scheme.
+func (c *Canonicalizer) withScheme(original string) string {
+	if strings.HasPrefix(original, "//") {
+		return c.defaultScheme + ":" + original
+	}
+
+	end := strings.IndexAny(original, "/?#")
+	if end < 0 {
+		end = len(original)
+	}
+
+	// "localhost:8080" is a host with port, not a URL with scheme localhost
+	name, rest, ok := strings.Cut(original[:end], ":")
+	if ok && isScheme(strings.ToLower(name)) && !isPort(rest) {
+		return original
+	}
+
+	return c.defaultScheme + "://" + original
+}
+
+// Canonicalize checks original URL and returns its canonical form. Error wraps domain.ErrInvalidURL and tells
+// why the URL can't be shortened.
+func (c *Canonicalizer) Canonicalize(original string) (string, error) {
+	original = strings.TrimSpace(original)
+	if original == "" {
+		return "", fmt.Errorf("%w: url should not be empty", domain.ErrInvalidURL)
+	}
+
+	u, err := url.Parse(c.withScheme(original))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", domain.ErrInvalidURL, err)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+
+	if _, ok := c.schemes[u.Scheme]; !ok {
+		return "", fmt.Errorf("%w: scheme %s is not allowed", domain.ErrInvalidURL, u.Scheme)
+	}
+
+	if u.Opaque != "" || u.Host == "" {
+		return "", fmt.Errorf("%w: url should contain host", domain.ErrInvalidURL)
+	}
+
+	host, err := Host(u.Hostname())
+	if err != nil {
+		return "", err
+	}
+
+	port := u.Port()
+	if port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return "", fmt.Errorf("%w: invalid port %s", domain.ErrInvalidURL, port)
+		}
+	}
+
+	if port == defaultPorts[u.Scheme] {
+		port = ""
+	}
+
+	if strings.Contains(host, ":") {
+		// IPv6 address
+		host = "[" + host + "]"
+	}
+
+	u.Host = host
+	if port != "" {
+		u.Host = host + ":" + port
+	}
+
+	if u.Path == "" {
+		u.Path, u.RawPath = "/", ""
+	}
+
+	if c.stripFragment {
+		u.Fragment, u.RawFragment = "", ""
+	}
+
+	if c.sortQuery {
+		u.RawQuery = sortQuery(u.RawQuery)
+	}
+
+	return u.String(), nil
+}
+
+// Host lowercases host and converts IDN host to punycode, IP addresses are returned as they are.
+func Host(host string) (string, error) {
+	if host == "" {
+		return "", fmt.Errorf("%w: url should contain host", domain.ErrInvalidURL)
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String(), nil
+	}
+
+	ascii, err := hostProfile.ToASCII(strings.TrimSuffix(host, "."))
+	if err != nil || !isHostname(ascii) {
+		return "", fmt.Errorf("%w: invalid host %s", domain.ErrInvalidURL, host)
+	}
+
+	return strings.ToLower(ascii), nil
+}
+
+// isHostname checks if ASCII host contains only letters, digits, hyphens, underscores and dots.
+func isHostname(host string) bool {
+	for i := 0; i < len(host); i++ {
+		c := host[i]
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' && c != '_' && c != '.' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sortQuery sorts query parameters by name, parameters with the same name keep their order. Parameters are not re-encoded,
+// so the query means the same thing to the server it is sent to.
+func sortQuery(query string) string {
+	if query == "" {
+		return ""
+	}
+
+	params := strings.Split(query, "&")
+	name := func(param string) string {
+		n, _, _ := strings.Cut(param, "=")
+		return n
+	}
+
+	sort.SliceStable(params, func(i, j int) bool {
+		return name(params[i]) < name(params[j])
+	})
+
+	return strings.Join(params, "&")
+}
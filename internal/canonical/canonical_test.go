@@ -0,0 +1,107 @@
+package canonical
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+func TestCanonicalize(t *testing.T) {
+	c := New(nil, false, false)
+
+	var testTable = []struct {
+		original  string
+		canonical string
+	}{
+		{"https://example.com", "https://example.com/"},
+		{"  HTTPS://EXAMPLE.com/ ", "https://example.com/"},
+		{"http://example.com:80/path", "http://example.com/path"},
+		{"https://example.com:443", "https://example.com/"},
+		{"https://example.com:8443/", "https://example.com:8443/"},
+		{"http://example.com./", "http://example.com/"},
+		{"https://пример.рф/путь", "https://xn--e1afmkfd.xn--p1ai/%D0%BF%D1%83%D1%82%D1%8C"},
+		{"https://Bücher.example/", "https://xn--bcher-kva.example/"},
+		{"https://my_site.example.com", "https://my_site.example.com/"},
+		{"http://[::1]:80/", "http://[::1]/"},
+		{"http://127.0.0.1:8080", "http://127.0.0.1:8080/"},
+		{"https://example.com/Path?b=2&a=1#Frag", "https://example.com/Path?b=2&a=1#Frag"},
+		// URLs without scheme get the default one
+		{"example.com/", "https://example.com/"},
+		{"EXAMPLE.com", "https://example.com/"},
+		{"example.com:443/path?q=1", "https://example.com/path?q=1"},
+		{"localhost:8080", "https://localhost:8080/"},
+		{"//example.com/path", "https://example.com/path"},
+		{"[::1]:8443/", "https://[::1]:8443/"},
+	}
+
+	for _, testCase := range testTable {
+		canonical, err := c.Canonicalize(testCase.original)
+		require.NoError(t, err, testCase.original)
+		require.Equal(t, testCase.canonical, canonical, testCase.original)
+	}
+
+	for _, original := range []string{
+		"",
+		" ",
+		"/path",
+		"://example.com",
+		"http:example.com",
+		"ftp://example.com/file",
+		"javascript:alert(1)",
+		"mailto:user@example.com",
+		"https://",
+		"https:///path",
+		"https://example.com:0/",
+		"https://example.com:99999/",
+		"https://exa mple.com/",
+		"https://xn--/",
+		"https://a..b/",
+		"https://ex!ample.com/",
+		"https://-example.com/",
+	} {
+		_, err := c.Canonicalize(original)
+		require.ErrorIs(t, err, domain.ErrInvalidURL, original)
+	}
+}
+
+func TestCanonicalizeOptions(t *testing.T) {
+	c := New([]string{"HTTPS", "ftp"}, true, true)
+
+	canonical, err := c.Canonicalize("https://example.com/?b=2&a=1&b=1&c#top")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/?a=1&b=2&b=1&c", canonical)
+
+	canonical, err = c.Canonicalize("ftp://example.com:21/file")
+	require.NoError(t, err)
+	require.Equal(t, "ftp://example.com/file", canonical)
+
+	_, err = c.Canonicalize("http://example.com/")
+	require.ErrorIs(t, err, domain.ErrInvalidURL)
+
+	// https is allowed, so it is added to URLs without scheme
+	canonical, err = c.Canonicalize("example.com")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/", canonical)
+
+	// otherwise the first allowed scheme is added
+	canonical, err = New([]string{"ftp", "http"}, false, false).Canonicalize("Example.com/file")
+	require.NoError(t, err)
+	require.Equal(t, "ftp://example.com/file", canonical)
+}
+
+func TestParseSchemes(t *testing.T) {
+	schemes, err := ParseSchemes("")
+	require.NoError(t, err)
+	require.Empty(t, schemes)
+
+	schemes, err = ParseSchemes(" HTTPS, git+ssh ")
+	require.NoError(t, err)
+	require.Equal(t, []string{"https", "git+ssh"}, schemes)
+
+	for _, list := range []string{"https,", "1http", "ht tp"} {
+		_, err = ParseSchemes(list)
+		require.Error(t, err, list)
+	}
+}
@@ -1,6 +1,8 @@
 // config package contains some types for the app configuration.
 package config
 
+import "time"
+
 // Config type contains some of the app's configuration info.
 type Config struct {
 	JSONFile          string
@@ -17,6 +19,48 @@ type Config struct {
 	GRPCDatabaseDSN   string
 	GRPCTrustedSubnet string
 	GRPCJWTKey        string
+	InMemory          bool
+	BoltPath          string
+	GRPCBoltPath      string
+	// FileCompactionInterval is an interval between compactions of JSON file storage.
+	FileCompactionInterval time.Duration
+	// ShortCodeStrategy is a name of strategy of short codes generation (random, sequential, hashids or hash).
+	ShortCodeStrategy string
+	ShortCodeAlphabet string
+	ShortCodeLength   int
+	ShortCodeSalt     string
+	// ExpirationSweepInterval is an interval between checks for expired URLs.
+	ExpirationSweepInterval time.Duration
+	// DedupScope defines if an original URL gets one short URL for every user ("user") or for all the users at once ("global").
+	DedupScope string
+	// AllowedSchemes is a comma separated list of schemes of original URLs which may be shortened.
+	AllowedSchemes string
+	// StripFragment defines if fragments are stripped from original URLs before they are shortened.
+	StripFragment bool
+	// SortQuery defines if query parameters of original URLs are sorted by name before the URLs are shortened.
+	SortQuery bool
+	// PolicyDir is a directory with blocklist, allowlist, regex rules and hashes of original URLs which can't be shortened.
+	PolicyDir string
+	// PolicyReloadInterval is an interval between checks for changes of the policy files.
+	PolicyReloadInterval time.Duration
+	// ClickBufferSize is an amount of clicks which may wait to be saved, clicks are dropped when the buffer is full.
+	ClickBufferSize int
+	// ClickFlushInterval is an interval between saves of buffered clicks.
+	ClickFlushInterval time.Duration
+	// DeletionBatchSize is an amount of URLs which are deleted at once, queued URLs are deleted earlier than planned when there are enough of them.
+	DeletionBatchSize int
+	// DeletionFlushInterval is an interval between deletions of queued URLs.
+	DeletionFlushInterval time.Duration
+	// DeletionGracePeriod is a time after deletion during which URLs may be restored, URLs are purged for good after it.
+	DeletionGracePeriod time.Duration
+	// PurgeInterval is an interval between purges of URLs whose grace period is over.
+	PurgeInterval time.Duration
+	// TokenLifetime is a time after which JWT of a user expires, JWT is replaced when less than a half of the time is left.
+	TokenLifetime time.Duration
+	// JWTSigningKeys is a comma separated list of keys in form id:algorithm:path, the first key signs new JWTs, others only check JWTs signed before rotation.
+	JWTSigningKeys string
+	// AcceptLegacyJWTs defines if JWTs without kid, signed by jwt key, are still accepted when JWTSigningKeys are set.
+	AcceptLegacyJWTs bool
 }
 
 // AddrWithCheck is a type which represents address and adiitional variable to check if the address was set.
@@ -18,7 +18,7 @@ func TestAddrWithCheck(t *testing.T) {
 
 	c := Config{HTTPAddr: a, ShortAddr: a, JSONFile: "a", DSN: "a", HTTPSEnabled: true, ConfigFilePath: "./config.json",
 		TrustedSubnet: "192.168.1.0/24", JWTKey: "abc", GRPCAddr: "a", GRPCSecureEnabled: true, GRPCTrustedSubnet: "a",
-		GRPCDatabaseDSN: "a", GRPCFileStorage: "a", GRPCJWTKey: "a"}
+		GRPCDatabaseDSN: "a", GRPCFileStorage: "a", GRPCJWTKey: "a", InMemory: true, ShortCodeStrategy: "random"}
 	require.NotEmpty(t, c)
 
 	str := a.String()
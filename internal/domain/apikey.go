@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// APIKeyPrefix is a beginning of every API key, so API keys can be told apart from JWTs.
+const APIKeyPrefix = "urlshrt_"
+
+// APIKey is a type which represents API key of a user. The key itself is never stored, only its hash is.
+type APIKey struct {
+	ID     string `json:"id"`
+	UserID int64  `json:"-"`
+	Name   string `json:"name"`
+	// Prefix is the beginning of the key, so the user could recognize it
+	Prefix    string     `json:"prefix"`
+	Hash      string     `json:"-"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// APIKeyService is an interface which defines what functions does an object which will manage API keys should implement.
+//
+//go:generate mockgen -destination=mocks/apikey_srv_mock.gen.go -package=mocks . APIKeyService
+type APIKeyService interface {
+	Create(ctx context.Context, name string) (APIKey, string, error)
+	ReadAll(ctx context.Context) ([]APIKey, error)
+	Revoke(ctx context.Context, id string) error
+	Resolve(ctx context.Context, key string) (int64, error)
+}
+
+// APIKeyRepository is an interface which defines what functions does an object which will store API keys should implement.
+//
+//go:generate mockgen -destination=mocks/apikey_repo_mock.gen.go -package=mocks . APIKeyRepository
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key APIKey) error
+	ReadAPIKeys(ctx context.Context, userID int64) ([]APIKey, error)
+	ReadAPIKeyByHash(ctx context.Context, hash string) (APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID int64, id string, at time.Time) error
+}
@@ -1,10 +1,18 @@
 package domain
 
+import "time"
+
 // BatchElement is a type which represent an element of a batch from JSON.
 type BatchElement struct {
-	ID           string `json:"correlation_id"`
-	OriginalURL  string `json:"original_url"`
-	ShortenedURL string `json:"short_url"`
+	ID           string     `json:"correlation_id"`
+	OriginalURL  string     `json:"original_url"`
+	ShortenedURL string     `json:"short_url"`
+	Alias        string     `json:"alias,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	NotBefore    *time.Time `json:"not_before,omitempty"`
+	Title        string     `json:"title,omitempty"`
+	Note         string     `json:"note,omitempty"`
+	Tags         []string   `json:"tags,omitempty"`
 }
 
 // BatchElementResult is a type which shall be written to JSON in handler for batch and sent as a response.
@@ -0,0 +1,7 @@
+package domain
+
+// URLCanonicalizer is an interface which defines how original URLs are checked and brought to the canonical form,
+// so the same resource written differently is shortened only once.
+type URLCanonicalizer interface {
+	Canonicalize(original string) (string, error)
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultClickBucket is a length of periods by which clicks are counted if another length is not requested.
+const DefaultClickBucket = 24 * time.Hour
+
+// Click is a type which represents one redirect made by short URL.
+type Click struct {
+	ShortURL  string    `json:"short_url"`
+	Time      time.Time `json:"time"`
+	Referrer  string    `json:"referrer,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	IP        string    `json:"ip,omitempty"`
+}
+
+// ClickBucket is a type which represents amount of clicks made in a period of time which begins at Start.
+type ClickBucket struct {
+	Start  time.Time `json:"start"`
+	Clicks int       `json:"clicks"`
+}
+
+// ClickStats is a type which represents total amount of clicks made by short URL and their amounts by periods of time.
+type ClickStats struct {
+	ShortURL string        `json:"short_url"`
+	Total    int           `json:"total"`
+	Buckets  []ClickBucket `json:"buckets"`
+}
+
+// ClickService is an interface which defines what functions does an object which will record clicks and count them should implement.
+//
+//go:generate mockgen -destination=mocks/click_srv_mock.gen.go -package=mocks . ClickService
+type ClickService interface {
+	Record(click Click)
+	ReadStats(ctx context.Context, shortened string, bucket time.Duration) (ClickStats, error)
+}
+
+// ClickRepository is an interface which defines what functions does an object which will store clicks should implement.
+//
+//go:generate mockgen -destination=mocks/click_repo_mock.gen.go -package=mocks . ClickRepository
+type ClickRepository interface {
+	CreateClicks(ctx context.Context, clicks []Click) error
+	ReadClicks(ctx context.Context, shortened string) ([]Click, error)
+}
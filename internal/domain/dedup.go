@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+// DedupScope is a type which defines among which URLs an original URL gets only one short URL.
+type DedupScope string
+
+const (
+	// DedupPerUser means that every user gets own short URL for an original URL, even if another user has already shortened it.
+	DedupPerUser DedupScope = "user"
+	// DedupGlobal means that an original URL has only one short URL, which is given to everybody who shortens it.
+	DedupGlobal DedupScope = "global"
+)
+
+// ParseDedupScope is a function to get scope of deduplication by its name, per user scope is used if the name is empty.
+func ParseDedupScope(name string) (DedupScope, error) {
+	switch DedupScope(name) {
+	case "", DedupPerUser:
+		return DedupPerUser, nil
+	case DedupGlobal:
+		return DedupGlobal, nil
+	default:
+		return "", fmt.Errorf("unknown dedup scope %s, %s or %s expected", name, DedupPerUser, DedupGlobal)
+	}
+}
+
+// dedupScopeKey is a key of scope of deduplication in context.
+type dedupScopeKey struct{}
+
+// WithDedupScope is a function to put scope of deduplication to context, so repository checks original URLs which are saved
+// in the same scope as the service does.
+func WithDedupScope(ctx context.Context, scope DedupScope) context.Context {
+	return context.WithValue(ctx, dedupScopeKey{}, scope)
+}
+
+// DedupScopeFromContext is a function to get scope of deduplication from context, per user scope is returned if there is no scope.
+func DedupScopeFromContext(ctx context.Context) DedupScope {
+	if scope, ok := ctx.Value(dedupScopeKey{}).(DedupScope); ok {
+		return scope
+	}
+
+	return DedupPerUser
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DeletionStatus is a type which represents what happened to short URL which the user asked to delete.
+type DeletionStatus string
+
+const (
+	// DeletionPending means that the URL is not processed yet.
+	DeletionPending DeletionStatus = "pending"
+	// DeletionDeleted means that the URL belongs to the user and is deleted now (or was deleted before).
+	DeletionDeleted DeletionStatus = "deleted"
+	// DeletionNotOwned means that the URL exists, but belongs to somebody else, so it was not deleted.
+	DeletionNotOwned DeletionStatus = "not_owned"
+	// DeletionNotFound means that there is no such URL.
+	DeletionNotFound DeletionStatus = "not_found"
+	// DeletionFailed means that the URL could not be deleted because of an error of repository.
+	DeletionFailed DeletionStatus = "failed"
+)
+
+// DeletionResult is a type which represents status of one short URL of a deletion job.
+type DeletionResult struct {
+	ShortURL string         `json:"short_url"`
+	Status   DeletionStatus `json:"status"`
+}
+
+// DeletionJob is a type which represents one request of the user to delete URLs. URLs are deleted in background,
+// so the job is done only when every URL has a status other than pending.
+type DeletionJob struct {
+	ID        string           `json:"id"`
+	UserID    int64            `json:"-"`
+	CreatedAt time.Time        `json:"created_at"`
+	Done      bool             `json:"done"`
+	Results   []DeletionResult `json:"results"`
+}
+
+// RestoreStatus is a type which represents what happened to short URL which the user asked to restore.
+type RestoreStatus string
+
+const (
+	// RestoreRestored means that the URL belongs to the user and works again.
+	RestoreRestored RestoreStatus = "restored"
+	// RestoreNotDeleted means that the URL belongs to the user, but it was not deleted.
+	RestoreNotDeleted RestoreStatus = "not_deleted"
+	// RestoreNotOwned means that the URL exists, but belongs to somebody else, so it was not restored.
+	RestoreNotOwned RestoreStatus = "not_owned"
+	// RestoreNotFound means that there is no such URL (it may be already purged).
+	RestoreNotFound RestoreStatus = "not_found"
+	// RestoreExpired means that grace period after deletion of the URL is over or expiration time of the URL has come.
+	RestoreExpired RestoreStatus = "expired"
+)
+
+// RestoreResult is a type which represents status of one short URL which the user asked to restore.
+type RestoreResult struct {
+	ShortURL string        `json:"short_url"`
+	Status   RestoreStatus `json:"status"`
+}
+
+// DeletionTask is a type which represents one short URL which waits in outbox to be deleted.
+type DeletionTask struct {
+	// ID is a position of the task in outbox, it is given by repository
+	ID       int64  `json:"id"`
+	ShortURL string `json:"short_url"`
+	UserID   int64  `json:"user_id"`
+	JobID    string `json:"job_id"`
+}
+
+// DeletionService is an interface which defines what functions does an object which will delete URLs of users in background should implement.
+//
+//go:generate mockgen -destination=mocks/deletion_srv_mock.gen.go -package=mocks . DeletionService
+type DeletionService interface {
+	DeleteUserURLs(ctx context.Context, shortURLs []string) (string, error)
+	ReadDeletionJob(ctx context.Context, id string) (DeletionJob, error)
+	RestoreUserURLs(ctx context.Context, shortURLs []string) ([]RestoreResult, error)
+}
+
+// DeletionOutbox is an interface which defines what functions does an object which will keep URLs waiting to be deleted should implement.
+// Tasks stay in outbox until they are acknowledged, so they are not lost if the app stops before deleting them.
+//
+//go:generate mockgen -destination=mocks/deletion_outbox_mock.gen.go -package=mocks . DeletionOutbox
+type DeletionOutbox interface {
+	EnqueueDeletions(ctx context.Context, tasks []DeletionTask) error
+	ReadDeletions(ctx context.Context, limit int) ([]DeletionTask, error)
+	AckDeletions(ctx context.Context, ids []int64) error
+}
@@ -1,6 +1,40 @@
 package domain
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrShortURLExists is an error which repository returns if short URL is already used by another original URL.
+	ErrShortURLExists = errors.New("short url already exists")
+	// ErrInvalidAlias is an error which means that requested alias can't be used as short URL.
+	ErrInvalidAlias = errors.New("invalid alias")
+	// ErrInvalidWindow is an error which means that URL would never be active with requested expiration and activation times.
+	ErrInvalidWindow = errors.New("invalid activity window")
+	// ErrURLNotFound is an error which means that there is no such short URL among URLs of the user.
+	ErrURLNotFound = errors.New("url not found")
+	// ErrInvalidBucket is an error which means that clicks can't be counted by periods of requested length.
+	ErrInvalidBucket = errors.New("invalid bucket")
+	// ErrAPIKeyNotFound is an error which means that there is no such API key among API keys of the user.
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrInvalidAPIKey is an error which means that API key from request doesn't exist or was revoked.
+	ErrInvalidAPIKey = errors.New("invalid api key")
+	// ErrInvalidAPIKeyName is an error which means that API key can't be given requested name.
+	ErrInvalidAPIKeyName = errors.New("invalid api key name")
+	// ErrUnauthenticated is an error which means that there is no user who could own what the request creates.
+	ErrUnauthenticated = errors.New("user is not authenticated")
+	// ErrDeletionJobNotFound is an error which means that there is no such deletion job among deletion jobs of the user.
+	ErrDeletionJobNotFound = errors.New("deletion job not found")
+	// ErrInvalidQuery is an error which means that URLs can't be listed with requested cursor, page size, order or filter.
+	ErrInvalidQuery = errors.New("invalid query")
+	// ErrInvalidMetadata is an error which means that URL can't be given requested title, note or tags.
+	ErrInvalidMetadata = errors.New("invalid metadata")
+	// ErrInvalidURL is an error which means that original URL can't be shortened, for example its scheme is not allowed.
+	ErrInvalidURL = errors.New("invalid url")
+	// ErrForbiddenURL is an error which means that original URL matches a rule of destinations which can't be shortened.
+	ErrForbiddenURL = errors.New("forbidden url")
+)
 
 // UniqueError is a type to check error of unique violation from database.
 type UniqueError struct {
@@ -16,3 +50,18 @@ func NewUniqueError(err error) error {
 		Err: err,
 	}
 }
+
+// AliasConflictError is a type to check if requested alias is already used as short URL of another original URL.
+type AliasConflictError struct {
+	Alias string
+}
+
+func (ae *AliasConflictError) Error() string {
+	return fmt.Sprintf("alias %s is already taken", ae.Alias)
+}
+
+func NewAliasConflictError(alias string) error {
+	return &AliasConflictError{
+		Alias: alias,
+	}
+}
@@ -14,3 +14,12 @@ func TestUniqueError(t *testing.T) {
 	str := ue.Error()
 	require.NotEmpty(t, str)
 }
+
+func TestAliasConflictError(t *testing.T) {
+	ae := NewAliasConflictError("spring-sale")
+	require.Error(t, ae)
+
+	var uErr *UniqueError
+	require.False(t, errors.As(ae, &uErr))
+	require.Contains(t, ae.Error(), "spring-sale")
+}
@@ -1,4 +1,37 @@
 package domain
 
+import "context"
+
 // Key is a key to get (and put) values from context.
 type Key string
+
+// Identity is a type which represents the user who made the request.
+type Identity struct {
+	UserID int64
+	// New is true if the user had no valid token, so the user has nothing saved yet. Such user is registered only
+	// if the request may save something, otherwise UserID is -1.
+	New bool
+}
+
+// identityKey is a key of identity in context, it is unexported, so identity can be put to context only by WithIdentity.
+type identityKey struct{}
+
+// WithIdentity is a function to put identity of the user to context.
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext is a function to get identity of the user from context.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// UserIDFromContext is a function to get id of the user from context, -1 is returned if there is no id.
+func UserIDFromContext(ctx context.Context) int64 {
+	if identity, ok := IdentityFromContext(ctx); ok {
+		return identity.UserID
+	}
+
+	return -1
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/PoorMercymain/urlshrt/internal/domain (interfaces: APIKeyRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+
+	domain "github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// MockAPIKeyRepository is a mock of APIKeyRepository interface.
+type MockAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyRepositoryMockRecorder
+}
+
+// MockAPIKeyRepositoryMockRecorder is the mock recorder for MockAPIKeyRepository.
+type MockAPIKeyRepositoryMockRecorder struct {
+	mock *MockAPIKeyRepository
+}
+
+// NewMockAPIKeyRepository creates a new mock instance.
+func NewMockAPIKeyRepository(ctrl *gomock.Controller) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockAPIKeyRepository) CreateAPIKey(arg0 context.Context, arg1 domain.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) CreateAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).CreateAPIKey), arg0, arg1)
+}
+
+// ReadAPIKeyByHash mocks base method.
+func (m *MockAPIKeyRepository) ReadAPIKeyByHash(arg0 context.Context, arg1 string) (domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAPIKeyByHash", arg0, arg1)
+	ret0, _ := ret[0].(domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAPIKeyByHash indicates an expected call of ReadAPIKeyByHash.
+func (mr *MockAPIKeyRepositoryMockRecorder) ReadAPIKeyByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAPIKeyByHash", reflect.TypeOf((*MockAPIKeyRepository)(nil).ReadAPIKeyByHash), arg0, arg1)
+}
+
+// ReadAPIKeys mocks base method.
+func (m *MockAPIKeyRepository) ReadAPIKeys(arg0 context.Context, arg1 int64) ([]domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].([]domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAPIKeys indicates an expected call of ReadAPIKeys.
+func (mr *MockAPIKeyRepositoryMockRecorder) ReadAPIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAPIKeys", reflect.TypeOf((*MockAPIKeyRepository)(nil).ReadAPIKeys), arg0, arg1)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockAPIKeyRepository) RevokeAPIKey(arg0 context.Context, arg1 int64, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) RevokeAPIKey(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).RevokeAPIKey), arg0, arg1, arg2, arg3)
+}
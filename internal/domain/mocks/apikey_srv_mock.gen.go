@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/PoorMercymain/urlshrt/internal/domain (interfaces: APIKeyService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	domain "github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// MockAPIKeyService is a mock of APIKeyService interface.
+type MockAPIKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyServiceMockRecorder
+}
+
+// MockAPIKeyServiceMockRecorder is the mock recorder for MockAPIKeyService.
+type MockAPIKeyServiceMockRecorder struct {
+	mock *MockAPIKeyService
+}
+
+// NewMockAPIKeyService creates a new mock instance.
+func NewMockAPIKeyService(ctrl *gomock.Controller) *MockAPIKeyService {
+	mock := &MockAPIKeyService{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyService) EXPECT() *MockAPIKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAPIKeyService) Create(arg0 context.Context, arg1 string) (domain.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(domain.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAPIKeyServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIKeyService)(nil).Create), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockAPIKeyService) ReadAll(arg0 context.Context) ([]domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0)
+	ret0, _ := ret[0].([]domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockAPIKeyServiceMockRecorder) ReadAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockAPIKeyService)(nil).ReadAll), arg0)
+}
+
+// Resolve mocks base method.
+func (m *MockAPIKeyService) Resolve(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockAPIKeyServiceMockRecorder) Resolve(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockAPIKeyService)(nil).Resolve), arg0, arg1)
+}
+
+// Revoke mocks base method.
+func (m *MockAPIKeyService) Revoke(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPIKeyServiceMockRecorder) Revoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPIKeyService)(nil).Revoke), arg0, arg1)
+}
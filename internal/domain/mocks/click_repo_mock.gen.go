@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/PoorMercymain/urlshrt/internal/domain (interfaces: ClickRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	domain "github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// MockClickRepository is a mock of ClickRepository interface.
+type MockClickRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockClickRepositoryMockRecorder
+}
+
+// MockClickRepositoryMockRecorder is the mock recorder for MockClickRepository.
+type MockClickRepositoryMockRecorder struct {
+	mock *MockClickRepository
+}
+
+// NewMockClickRepository creates a new mock instance.
+func NewMockClickRepository(ctrl *gomock.Controller) *MockClickRepository {
+	mock := &MockClickRepository{ctrl: ctrl}
+	mock.recorder = &MockClickRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClickRepository) EXPECT() *MockClickRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateClicks mocks base method.
+func (m *MockClickRepository) CreateClicks(arg0 context.Context, arg1 []domain.Click) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClicks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClicks indicates an expected call of CreateClicks.
+func (mr *MockClickRepositoryMockRecorder) CreateClicks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClicks", reflect.TypeOf((*MockClickRepository)(nil).CreateClicks), arg0, arg1)
+}
+
+// ReadClicks mocks base method.
+func (m *MockClickRepository) ReadClicks(arg0 context.Context, arg1 string) ([]domain.Click, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadClicks", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Click)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadClicks indicates an expected call of ReadClicks.
+func (mr *MockClickRepositoryMockRecorder) ReadClicks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadClicks", reflect.TypeOf((*MockClickRepository)(nil).ReadClicks), arg0, arg1)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/PoorMercymain/urlshrt/internal/domain (interfaces: ClickService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+
+	domain "github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// MockClickService is a mock of ClickService interface.
+type MockClickService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClickServiceMockRecorder
+}
+
+// MockClickServiceMockRecorder is the mock recorder for MockClickService.
+type MockClickServiceMockRecorder struct {
+	mock *MockClickService
+}
+
+// NewMockClickService creates a new mock instance.
+func NewMockClickService(ctrl *gomock.Controller) *MockClickService {
+	mock := &MockClickService{ctrl: ctrl}
+	mock.recorder = &MockClickServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClickService) EXPECT() *MockClickServiceMockRecorder {
+	return m.recorder
+}
+
+// ReadStats mocks base method.
+func (m *MockClickService) ReadStats(arg0 context.Context, arg1 string, arg2 time.Duration) (domain.ClickStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].(domain.ClickStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStats indicates an expected call of ReadStats.
+func (mr *MockClickServiceMockRecorder) ReadStats(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStats", reflect.TypeOf((*MockClickService)(nil).ReadStats), arg0, arg1, arg2)
+}
+
+// Record mocks base method.
+func (m *MockClickService) Record(arg0 domain.Click) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", arg0)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockClickServiceMockRecorder) Record(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockClickService)(nil).Record), arg0)
+}
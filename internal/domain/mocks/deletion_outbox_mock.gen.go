@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/PoorMercymain/urlshrt/internal/domain (interfaces: DeletionOutbox)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	domain "github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// MockDeletionOutbox is a mock of DeletionOutbox interface.
+type MockDeletionOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeletionOutboxMockRecorder
+}
+
+// MockDeletionOutboxMockRecorder is the mock recorder for MockDeletionOutbox.
+type MockDeletionOutboxMockRecorder struct {
+	mock *MockDeletionOutbox
+}
+
+// NewMockDeletionOutbox creates a new mock instance.
+func NewMockDeletionOutbox(ctrl *gomock.Controller) *MockDeletionOutbox {
+	mock := &MockDeletionOutbox{ctrl: ctrl}
+	mock.recorder = &MockDeletionOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeletionOutbox) EXPECT() *MockDeletionOutboxMockRecorder {
+	return m.recorder
+}
+
+// AckDeletions mocks base method.
+func (m *MockDeletionOutbox) AckDeletions(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AckDeletions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AckDeletions indicates an expected call of AckDeletions.
+func (mr *MockDeletionOutboxMockRecorder) AckDeletions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AckDeletions", reflect.TypeOf((*MockDeletionOutbox)(nil).AckDeletions), arg0, arg1)
+}
+
+// EnqueueDeletions mocks base method.
+func (m *MockDeletionOutbox) EnqueueDeletions(arg0 context.Context, arg1 []domain.DeletionTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueDeletions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueDeletions indicates an expected call of EnqueueDeletions.
+func (mr *MockDeletionOutboxMockRecorder) EnqueueDeletions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueDeletions", reflect.TypeOf((*MockDeletionOutbox)(nil).EnqueueDeletions), arg0, arg1)
+}
+
+// ReadDeletions mocks base method.
+func (m *MockDeletionOutbox) ReadDeletions(arg0 context.Context, arg1 int) ([]domain.DeletionTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDeletions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.DeletionTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDeletions indicates an expected call of ReadDeletions.
+func (mr *MockDeletionOutboxMockRecorder) ReadDeletions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDeletions", reflect.TypeOf((*MockDeletionOutbox)(nil).ReadDeletions), arg0, arg1)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/PoorMercymain/urlshrt/internal/domain (interfaces: DeletionService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	domain "github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// MockDeletionService is a mock of DeletionService interface.
+type MockDeletionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeletionServiceMockRecorder
+}
+
+// MockDeletionServiceMockRecorder is the mock recorder for MockDeletionService.
+type MockDeletionServiceMockRecorder struct {
+	mock *MockDeletionService
+}
+
+// NewMockDeletionService creates a new mock instance.
+func NewMockDeletionService(ctrl *gomock.Controller) *MockDeletionService {
+	mock := &MockDeletionService{ctrl: ctrl}
+	mock.recorder = &MockDeletionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeletionService) EXPECT() *MockDeletionServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteUserURLs mocks base method.
+func (m *MockDeletionService) DeleteUserURLs(arg0 context.Context, arg1 []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserURLs", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserURLs indicates an expected call of DeleteUserURLs.
+func (mr *MockDeletionServiceMockRecorder) DeleteUserURLs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserURLs", reflect.TypeOf((*MockDeletionService)(nil).DeleteUserURLs), arg0, arg1)
+}
+
+// ReadDeletionJob mocks base method.
+func (m *MockDeletionService) ReadDeletionJob(arg0 context.Context, arg1 string) (domain.DeletionJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDeletionJob", arg0, arg1)
+	ret0, _ := ret[0].(domain.DeletionJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDeletionJob indicates an expected call of ReadDeletionJob.
+func (mr *MockDeletionServiceMockRecorder) ReadDeletionJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDeletionJob", reflect.TypeOf((*MockDeletionService)(nil).ReadDeletionJob), arg0, arg1)
+}
+
+// RestoreUserURLs mocks base method.
+func (m *MockDeletionService) RestoreUserURLs(arg0 context.Context, arg1 []string) ([]domain.RestoreResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUserURLs", arg0, arg1)
+	ret0, _ := ret[0].([]domain.RestoreResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreUserURLs indicates an expected call of RestoreUserURLs.
+func (mr *MockDeletionServiceMockRecorder) RestoreUserURLs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUserURLs", reflect.TypeOf((*MockDeletionService)(nil).RestoreUserURLs), arg0, arg1)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/PoorMercymain/urlshrt/internal/domain (interfaces: PolicyRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	state "github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+// MockPolicyRepository is a mock of PolicyRepository interface.
+type MockPolicyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyRepositoryMockRecorder
+}
+
+// MockPolicyRepositoryMockRecorder is the mock recorder for MockPolicyRepository.
+type MockPolicyRepositoryMockRecorder struct {
+	mock *MockPolicyRepository
+}
+
+// NewMockPolicyRepository creates a new mock instance.
+func NewMockPolicyRepository(ctrl *gomock.Controller) *MockPolicyRepository {
+	mock := &MockPolicyRepository{ctrl: ctrl}
+	mock.recorder = &MockPolicyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyRepository) EXPECT() *MockPolicyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DisableURLs mocks base method.
+func (m *MockPolicyRepository) DisableURLs(arg0 context.Context, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableURLs", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableURLs indicates an expected call of DisableURLs.
+func (mr *MockPolicyRepositoryMockRecorder) DisableURLs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableURLs", reflect.TypeOf((*MockPolicyRepository)(nil).DisableURLs), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockPolicyRepository) ReadAll(arg0 context.Context) ([]state.URLStringJSON, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0)
+	ret0, _ := ret[0].([]state.URLStringJSON)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockPolicyRepositoryMockRecorder) ReadAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockPolicyRepository)(nil).ReadAll), arg0)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/PoorMercymain/urlshrt/internal/domain (interfaces: PolicyService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPolicyService is a mock of PolicyService interface.
+type MockPolicyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyServiceMockRecorder
+}
+
+// MockPolicyServiceMockRecorder is the mock recorder for MockPolicyService.
+type MockPolicyServiceMockRecorder struct {
+	mock *MockPolicyService
+}
+
+// NewMockPolicyService creates a new mock instance.
+func NewMockPolicyService(ctrl *gomock.Controller) *MockPolicyService {
+	mock := &MockPolicyService{ctrl: ctrl}
+	mock.recorder = &MockPolicyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyService) EXPECT() *MockPolicyServiceMockRecorder {
+	return m.recorder
+}
+
+// Enforce mocks base method.
+func (m *MockPolicyService) Enforce(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enforce", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enforce indicates an expected call of Enforce.
+func (mr *MockPolicyServiceMockRecorder) Enforce(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enforce", reflect.TypeOf((*MockPolicyService)(nil).Enforce), arg0)
+}
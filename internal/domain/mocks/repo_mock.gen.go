@@ -7,9 +7,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 
+	domain "github.com/PoorMercymain/urlshrt/internal/domain"
 	state "github.com/PoorMercymain/urlshrt/internal/state"
 )
 
@@ -81,12 +83,28 @@ func (mr *MockURLRepositoryMockRecorder) CreateBatch(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockURLRepository)(nil).CreateBatch), arg0, arg1)
 }
 
+// DeleteExpiredURLs mocks base method.
+func (m *MockURLRepository) DeleteExpiredURLs(arg0 context.Context, arg1 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredURLs", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredURLs indicates an expected call of DeleteExpiredURLs.
+func (mr *MockURLRepositoryMockRecorder) DeleteExpiredURLs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredURLs", reflect.TypeOf((*MockURLRepository)(nil).DeleteExpiredURLs), arg0, arg1)
+}
+
 // DeleteUserURLs mocks base method.
-func (m *MockURLRepository) DeleteUserURLs(arg0 context.Context, arg1 []string, arg2 []int64) error {
+func (m *MockURLRepository) DeleteUserURLs(arg0 context.Context, arg1 []string, arg2 []int64) ([]domain.DeletionStatus, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteUserURLs", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]domain.DeletionStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteUserURLs indicates an expected call of DeleteUserURLs.
@@ -124,6 +142,21 @@ func (mr *MockURLRepositoryMockRecorder) PingPg(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PingPg", reflect.TypeOf((*MockURLRepository)(nil).PingPg), arg0)
 }
 
+// PurgeDeletedURLs mocks base method.
+func (m *MockURLRepository) PurgeDeletedURLs(arg0 context.Context, arg1 time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedURLs", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedURLs indicates an expected call of PurgeDeletedURLs.
+func (mr *MockURLRepositoryMockRecorder) PurgeDeletedURLs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedURLs", reflect.TypeOf((*MockURLRepository)(nil).PurgeDeletedURLs), arg0, arg1)
+}
+
 // ReadAll mocks base method.
 func (m *MockURLRepository) ReadAll(arg0 context.Context) ([]state.URLStringJSON, error) {
 	m.ctrl.T.Helper()
@@ -139,17 +172,77 @@ func (mr *MockURLRepositoryMockRecorder) ReadAll(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockURLRepository)(nil).ReadAll), arg0)
 }
 
+// ReadOwner mocks base method.
+func (m *MockURLRepository) ReadOwner(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOwner", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadOwner indicates an expected call of ReadOwner.
+func (mr *MockURLRepositoryMockRecorder) ReadOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOwner", reflect.TypeOf((*MockURLRepository)(nil).ReadOwner), arg0, arg1)
+}
+
+// ReadRevisions mocks base method.
+func (m *MockURLRepository) ReadRevisions(arg0 context.Context, arg1 string) ([]domain.URLRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.URLRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRevisions indicates an expected call of ReadRevisions.
+func (mr *MockURLRepositoryMockRecorder) ReadRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRevisions", reflect.TypeOf((*MockURLRepository)(nil).ReadRevisions), arg0, arg1)
+}
+
 // ReadUserURLs mocks base method.
-func (m *MockURLRepository) ReadUserURLs(arg0 context.Context) ([]state.URLStringJSON, error) {
+func (m *MockURLRepository) ReadUserURLs(arg0 context.Context, arg1 domain.URLQuery) ([]state.URLStringJSON, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadUserURLs", arg0)
+	ret := m.ctrl.Call(m, "ReadUserURLs", arg0, arg1)
 	ret0, _ := ret[0].([]state.URLStringJSON)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadUserURLs indicates an expected call of ReadUserURLs.
-func (mr *MockURLRepositoryMockRecorder) ReadUserURLs(arg0 interface{}) *gomock.Call {
+func (mr *MockURLRepositoryMockRecorder) ReadUserURLs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserURLs", reflect.TypeOf((*MockURLRepository)(nil).ReadUserURLs), arg0, arg1)
+}
+
+// RestoreUserURLs mocks base method.
+func (m *MockURLRepository) RestoreUserURLs(arg0 context.Context, arg1 []string, arg2 time.Time) ([]domain.RestoreStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUserURLs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]domain.RestoreStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreUserURLs indicates an expected call of RestoreUserURLs.
+func (mr *MockURLRepositoryMockRecorder) RestoreUserURLs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUserURLs", reflect.TypeOf((*MockURLRepository)(nil).RestoreUserURLs), arg0, arg1, arg2)
+}
+
+// UpdateOriginal mocks base method.
+func (m *MockURLRepository) UpdateOriginal(arg0 context.Context, arg1, arg2 string, arg3 time.Time) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOriginal", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOriginal indicates an expected call of UpdateOriginal.
+func (mr *MockURLRepositoryMockRecorder) UpdateOriginal(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserURLs", reflect.TypeOf((*MockURLRepository)(nil).ReadUserURLs), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOriginal", reflect.TypeOf((*MockURLRepository)(nil).UpdateOriginal), arg0, arg1, arg2, arg3)
 }
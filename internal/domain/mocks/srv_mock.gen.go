@@ -55,18 +55,18 @@ func (mr *MockURLServiceMockRecorder) CountURLsAndUsers(arg0 interface{}) *gomoc
 }
 
 // CreateShortened mocks base method.
-func (m *MockURLService) CreateShortened(arg0 context.Context, arg1 string) (string, error) {
+func (m *MockURLService) CreateShortened(arg0 context.Context, arg1 string, arg2 domain.ShortenOptions) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateShortened", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateShortened", arg0, arg1, arg2)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateShortened indicates an expected call of CreateShortened.
-func (mr *MockURLServiceMockRecorder) CreateShortened(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockURLServiceMockRecorder) CreateShortened(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShortened", reflect.TypeOf((*MockURLService)(nil).CreateShortened), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShortened", reflect.TypeOf((*MockURLService)(nil).CreateShortened), arg0, arg1, arg2)
 }
 
 // CreateShortenedFromBatch mocks base method.
@@ -84,18 +84,6 @@ func (mr *MockURLServiceMockRecorder) CreateShortenedFromBatch(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShortenedFromBatch", reflect.TypeOf((*MockURLService)(nil).CreateShortenedFromBatch), arg0, arg1, arg2)
 }
 
-// DeleteUserURLs mocks base method.
-func (m *MockURLService) DeleteUserURLs(arg0 context.Context, arg1 []domain.URLWithID, arg2 *domain.MutexChanString, arg3 *sync.Once, arg4 *sync.WaitGroup) {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "DeleteUserURLs", arg0, arg1, arg2, arg3, arg4)
-}
-
-// DeleteUserURLs indicates an expected call of DeleteUserURLs.
-func (mr *MockURLServiceMockRecorder) DeleteUserURLs(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserURLs", reflect.TypeOf((*MockURLService)(nil).DeleteUserURLs), arg0, arg1, arg2, arg3, arg4)
-}
-
 // PingPg mocks base method.
 func (m *MockURLService) PingPg(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -125,6 +113,21 @@ func (mr *MockURLServiceMockRecorder) ReadOriginal(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOriginal", reflect.TypeOf((*MockURLService)(nil).ReadOriginal), arg0, arg1, arg2)
 }
 
+// ReadRevisions mocks base method.
+func (m *MockURLService) ReadRevisions(arg0 context.Context, arg1 string) ([]domain.URLRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]domain.URLRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRevisions indicates an expected call of ReadRevisions.
+func (mr *MockURLServiceMockRecorder) ReadRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRevisions", reflect.TypeOf((*MockURLService)(nil).ReadRevisions), arg0, arg1)
+}
+
 // ReadUserURLs mocks base method.
 func (m *MockURLService) ReadUserURLs(arg0 context.Context) ([]state.URLStringJSON, error) {
 	m.ctrl.T.Helper()
@@ -139,3 +142,33 @@ func (mr *MockURLServiceMockRecorder) ReadUserURLs(arg0 interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserURLs", reflect.TypeOf((*MockURLService)(nil).ReadUserURLs), arg0)
 }
+
+// ReadUserURLsPage mocks base method.
+func (m *MockURLService) ReadUserURLsPage(arg0 context.Context, arg1 domain.URLPageRequest) (domain.URLPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUserURLsPage", arg0, arg1)
+	ret0, _ := ret[0].(domain.URLPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadUserURLsPage indicates an expected call of ReadUserURLsPage.
+func (mr *MockURLServiceMockRecorder) ReadUserURLsPage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserURLsPage", reflect.TypeOf((*MockURLService)(nil).ReadUserURLsPage), arg0, arg1)
+}
+
+// UpdateShortened mocks base method.
+func (m *MockURLService) UpdateShortened(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShortened", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShortened indicates an expected call of UpdateShortened.
+func (mr *MockURLServiceMockRecorder) UpdateShortened(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShortened", reflect.TypeOf((*MockURLService)(nil).UpdateShortened), arg0, arg1, arg2)
+}
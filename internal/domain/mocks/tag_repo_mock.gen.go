@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/PoorMercymain/urlshrt/internal/domain (interfaces: TagRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	domain "github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// MockTagRepository is a mock of TagRepository interface.
+type MockTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepositoryMockRecorder
+}
+
+// MockTagRepositoryMockRecorder is the mock recorder for MockTagRepository.
+type MockTagRepositoryMockRecorder struct {
+	mock *MockTagRepository
+}
+
+// NewMockTagRepository creates a new mock instance.
+func NewMockTagRepository(ctrl *gomock.Controller) *MockTagRepository {
+	mock := &MockTagRepository{ctrl: ctrl}
+	mock.recorder = &MockTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ReadTags mocks base method.
+func (m *MockTagRepository) ReadTags(arg0 context.Context) ([]domain.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTags", arg0)
+	ret0, _ := ret[0].([]domain.TagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTags indicates an expected call of ReadTags.
+func (mr *MockTagRepositoryMockRecorder) ReadTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTags", reflect.TypeOf((*MockTagRepository)(nil).ReadTags), arg0)
+}
+
+// UpdateTags mocks base method.
+func (m *MockTagRepository) UpdateTags(arg0 context.Context, arg1 string, arg2, arg3 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTags indicates an expected call of UpdateTags.
+func (mr *MockTagRepositoryMockRecorder) UpdateTags(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTags", reflect.TypeOf((*MockTagRepository)(nil).UpdateTags), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/PoorMercymain/urlshrt/internal/domain (interfaces: TagService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	domain "github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// MockTagService is a mock of TagService interface.
+type MockTagService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagServiceMockRecorder
+}
+
+// MockTagServiceMockRecorder is the mock recorder for MockTagService.
+type MockTagServiceMockRecorder struct {
+	mock *MockTagService
+}
+
+// NewMockTagService creates a new mock instance.
+func NewMockTagService(ctrl *gomock.Controller) *MockTagService {
+	mock := &MockTagService{ctrl: ctrl}
+	mock.recorder = &MockTagServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagService) EXPECT() *MockTagServiceMockRecorder {
+	return m.recorder
+}
+
+// AddTags mocks base method.
+func (m *MockTagService) AddTags(arg0 context.Context, arg1 string, arg2 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTags indicates an expected call of AddTags.
+func (mr *MockTagServiceMockRecorder) AddTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockTagService)(nil).AddTags), arg0, arg1, arg2)
+}
+
+// ReadTags mocks base method.
+func (m *MockTagService) ReadTags(arg0 context.Context) ([]domain.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTags", arg0)
+	ret0, _ := ret[0].([]domain.TagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTags indicates an expected call of ReadTags.
+func (mr *MockTagServiceMockRecorder) ReadTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTags", reflect.TypeOf((*MockTagService)(nil).ReadTags), arg0)
+}
+
+// RemoveTags mocks base method.
+func (m *MockTagService) RemoveTags(arg0 context.Context, arg1 string, arg2 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTags indicates an expected call of RemoveTags.
+func (mr *MockTagServiceMockRecorder) RemoveTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTags", reflect.TypeOf((*MockTagService)(nil).RemoveTags), arg0, arg1, arg2)
+}
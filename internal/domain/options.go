@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// ShortenOptions is a type which contains optional parameters of short URL creation.
+type ShortenOptions struct {
+	// Alias is a short URL requested by user, if it is empty, short URL is generated
+	Alias string
+	// URL is active only after NotBefore and before ExpiresAt (if they are set)
+	ExpiresAt *time.Time
+	NotBefore *time.Time
+	// Title, Note and Tags are shown to the user only, they don't change how URL works
+	Title string
+	Note  string
+	Tags  []string
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+const (
+	// DefaultPageSize is an amount of URLs in a page if another amount is not requested.
+	DefaultPageSize = 100
+	// MaxPageSize is the biggest amount of URLs in a page which can be requested.
+	MaxPageSize = 1000
+)
+
+// SortOrder is a type which defines in which order URLs are listed, URLs are sorted by time of their creation.
+type SortOrder string
+
+const (
+	// SortAsc means that URLs which were created first are listed first.
+	SortAsc SortOrder = "asc"
+	// SortDesc means that URLs which were created last are listed first.
+	SortDesc SortOrder = "desc"
+)
+
+// ParseSortOrder is a function to get order of URLs by its name, ascending order is used if the name is empty.
+func ParseSortOrder(name string) (SortOrder, error) {
+	switch SortOrder(name) {
+	case "", SortAsc:
+		return SortAsc, nil
+	case SortDesc:
+		return SortDesc, nil
+	default:
+		return "", fmt.Errorf("%w: unknown order %s, %s or %s expected", ErrInvalidQuery, name, SortAsc, SortDesc)
+	}
+}
+
+// URLFilter is a type which defines which URLs of the user are listed. Empty fields don't filter anything.
+type URLFilter struct {
+	// Contains is a substring of original URL, letter case is ignored
+	Contains string
+	// Domain is a host of original URL, URLs of its subdomains are listed too
+	Domain string
+	// Tag is a tag which URL should have
+	Tag string
+}
+
+// URLCursor is a type which represents position of URL in the list of URLs. URLs are sorted by time of creation and then
+// by short URL, so the position is the same even if some URLs were added or deleted. URLs which were saved before time of
+// creation was kept have zero time, so they are listed first.
+type URLCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ShortURL  string    `json:"short_url"`
+}
+
+// NewURLCursor creates cursor which points to position of URL in the list of URLs.
+func NewURLCursor(url state.URLStringJSON) URLCursor {
+	c := URLCursor{ShortURL: url.ShortURL}
+	if url.CreatedAt != nil {
+		c.CreatedAt = *url.CreatedAt
+	}
+
+	return c
+}
+
+// URLQuery is a type which defines which URLs of the user repository should read and in which order.
+type URLQuery struct {
+	URLFilter
+	// After is a position after which URLs are read, URLs are read from the beginning if it is nil
+	After *URLCursor
+	// Limit is the biggest amount of URLs to read, all the URLs are read if it is zero
+	Limit int
+	Order SortOrder
+}
+
+// URLPageRequest is a type which represents request of a page of URLs of the user.
+type URLPageRequest struct {
+	URLFilter
+	// Cursor is the one which was returned with the previous page, the first page is returned if it is empty
+	Cursor string
+	// Limit is an amount of URLs in a page, DefaultPageSize is used if it is zero
+	Limit int
+	Order SortOrder
+}
+
+// URLPage is a type which represents a page of URLs of the user. NextCursor is empty if the page is the last one.
+type URLPage struct {
+	URLs       []state.URLStringJSON
+	NextCursor string
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+// URLPolicy is an interface which defines rules of destinations which may be shortened.
+type URLPolicy interface {
+	// Check returns error which wraps ErrForbiddenURL if original URL (in the canonical form) can't be shortened.
+	Check(original string) error
+	// Reload reads the rules again if they were changed.
+	Reload() error
+}
+
+// PolicyService is an interface which defines what functions does an object which will apply rules of destinations
+// to URLs which were already shortened should implement.
+//
+//go:generate mockgen -destination=mocks/policy_srv_mock.gen.go -package=mocks . PolicyService
+type PolicyService interface {
+	Enforce(ctx context.Context) ([]string, error)
+}
+
+// PolicyRepository is an interface which defines what functions does an object which will disable URLs should implement.
+//
+//go:generate mockgen -destination=mocks/policy_repo_mock.gen.go -package=mocks . PolicyRepository
+type PolicyRepository interface {
+	ReadAll(ctx context.Context) ([]state.URLStringJSON, error)
+	DisableURLs(ctx context.Context, shortURLs []string) error
+}
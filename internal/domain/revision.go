@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// URLRevision is a type which represents one change of original URL of short URL. Revisions of short URL are numbered from one
+// in order they were made.
+type URLRevision struct {
+	ShortURL    string    `json:"short_url"`
+	Revision    int       `json:"revision"`
+	PreviousURL string    `json:"previous_url"`
+	OriginalURL string    `json:"original_url"`
+	ChangedAt   time.Time `json:"changed_at"`
+}
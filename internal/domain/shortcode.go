@@ -0,0 +1,19 @@
+package domain
+
+import "math/rand"
+
+// ShortCodeRequest is a type which contains data that may be used to generate short code for a URL.
+type ShortCodeRequest struct {
+	OriginalURL string
+	// Random is a source of randomness of the request, it is seeded with RandSeed if it was provided
+	Random *rand.Rand
+	// Used is an amount of URLs which already have short codes
+	Used int
+	// Attempt is a number of the try, it is greater than zero if codes generated before were already used
+	Attempt int
+}
+
+// ShortCodeGenerator is an interface which defines strategy of short codes generation.
+type ShortCodeGenerator interface {
+	Generate(req ShortCodeRequest) string
+}
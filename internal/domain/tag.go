@@ -0,0 +1,29 @@
+package domain
+
+import "context"
+
+// MaxTags is a maximal amount of tags of one URL.
+const MaxTags = 32
+
+// TagCount is a type which represents tag of user's URLs with amount of the URLs which have it.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// TagService is an interface which defines what functions does an object which will manage tags of URLs should implement.
+//
+//go:generate mockgen -destination=mocks/tag_srv_mock.gen.go -package=mocks . TagService
+type TagService interface {
+	AddTags(ctx context.Context, shortened string, tags []string) ([]string, error)
+	RemoveTags(ctx context.Context, shortened string, tags []string) ([]string, error)
+	ReadTags(ctx context.Context) ([]TagCount, error)
+}
+
+// TagRepository is an interface which defines what functions does an object which will store tags of URLs should implement.
+//
+//go:generate mockgen -destination=mocks/tag_repo_mock.gen.go -package=mocks . TagRepository
+type TagRepository interface {
+	UpdateTags(ctx context.Context, shortened string, added []string, removed []string) ([]string, error)
+	ReadTags(ctx context.Context) ([]TagCount, error)
+}
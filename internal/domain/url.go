@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/PoorMercymain/urlshrt/internal/state"
 )
@@ -12,15 +13,20 @@ import (
 //go:generate mockgen -destination=mocks/srv_mock.gen.go -package=mocks . URLService
 type URLService interface {
 	ReadOriginal(ctx context.Context, shortened string, errChan chan error) (string, error)
-	CreateShortened(ctx context.Context, original string) (string, error)
+	CreateShortened(ctx context.Context, original string, opts ShortenOptions) (string, error)
 	CreateShortenedFromBatch(ctx context.Context, batch []*BatchElement, wg *sync.WaitGroup) ([]BatchElementResult, error)
 	PingPg(ctx context.Context) error
 	ReadUserURLs(ctx context.Context) ([]state.URLStringJSON, error)
-	DeleteUserURLs(ctx context.Context, short []URLWithID, shortURLsChan *MutexChanString, once *sync.Once, wg *sync.WaitGroup)
+	ReadUserURLsPage(ctx context.Context, req URLPageRequest) (URLPage, error)
 	CountURLsAndUsers(ctx context.Context) (int, int, error)
+	UpdateShortened(ctx context.Context, shortened string, original string) (string, error)
+	ReadRevisions(ctx context.Context, shortened string) ([]URLRevision, error)
 }
 
 // URLRepository is an interface which defines what functions does an object which will operate on repository layer should implement.
+// Create, CreateBatch and UpdateOriginal check uniqueness of original URLs in the scope of deduplication from context
+// (see WithDedupScope): among URLs of the user by default, or among URLs of all the users if the scope is global.
+// The check and the write are atomic, so concurrent requests can't save one original URL twice.
 //
 //go:generate mockgen -destination=mocks/repo_mock.gen.go -package=mocks . URLRepository
 type URLRepository interface {
@@ -28,8 +34,14 @@ type URLRepository interface {
 	Create(ctx context.Context, urls []state.URLStringJSON) (string, error)
 	CreateBatch(ctx context.Context, batch []*state.URLStringJSON) error
 	PingPg(ctx context.Context) error
-	ReadUserURLs(ctx context.Context) ([]state.URLStringJSON, error)
-	DeleteUserURLs(ctx context.Context, shortURLs []string, uid []int64) error
+	ReadUserURLs(ctx context.Context, query URLQuery) ([]state.URLStringJSON, error)
+	DeleteUserURLs(ctx context.Context, shortURLs []string, uid []int64) ([]DeletionStatus, error)
 	IsURLDeleted(ctx context.Context, shortened string) (bool, error)
+	ReadOwner(ctx context.Context, shortened string) (int64, error)
 	CountURLsAndUsers(ctx context.Context) (int, int, error)
+	DeleteExpiredURLs(ctx context.Context, now time.Time) (int, error)
+	RestoreUserURLs(ctx context.Context, shortURLs []string, deletedAfter time.Time) ([]RestoreStatus, error)
+	PurgeDeletedURLs(ctx context.Context, deletedBefore time.Time) ([]string, error)
+	UpdateOriginal(ctx context.Context, shortened string, original string, at time.Time) (string, error)
+	ReadRevisions(ctx context.Context, shortened string) ([]URLRevision, error)
 }
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// FirstUserID is the smallest id which is given to registered users. Before the registry of users existed,
+// users got random ids below it, so their tokens stay valid and their ids are never given to anybody else.
+const FirstUserID int64 = 1000
+
+// User is a type which represents registered user.
+type User struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UserService is an interface which defines what functions does an object which will register users should implement.
+//
+//go:generate mockgen -destination=mocks/user_srv_mock.gen.go -package=mocks . UserService
+type UserService interface {
+	Register(ctx context.Context) (int64, error)
+	RegisterLegacy(ctx context.Context, id int64) error
+}
+
+// UserRepository is an interface which defines what functions does an object which will allocate ids of users should implement.
+// Every call of CreateUser should return an id which was never returned before. CreateLegacyUser should save the user
+// who got random id before the registry existed, saving the same user again should change nothing.
+//
+//go:generate mockgen -destination=mocks/user_repo_mock.gen.go -package=mocks . UserRepository
+type UserRepository interface {
+	CreateUser(ctx context.Context) (int64, error)
+	CreateLegacyUser(ctx context.Context, id int64) error
+}
@@ -1,7 +1,14 @@
 package domain
 
+import "time"
+
 // UserOutput is a type of element used to show user's URLs in specific handler.
 type UserOutput struct {
-	ShortURL    string `json:"short_url"`
-	OriginalURL string `json:"original_url"`
+	ShortURL    string     `json:"short_url"`
+	OriginalURL string     `json:"original_url"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Title       string     `json:"title,omitempty"`
+	Note        string     `json:"note,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
 }
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestAliases(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	us := service.NewURL(repository.NewMemory(), state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	uh := NewURL(us)
+
+	var wg sync.WaitGroup
+	r := chi.NewRouter()
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+	r.Post("/api/shorten/batch", WrapHandler(uh.CreateShortenedFromBatchAdapter(&wg)))
+	r.Get("/{short}", WrapHandler(uh.ReadOriginal))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	var testTable = []struct {
+		url    string
+		body   string
+		status int
+	}{
+		{"/api/shorten", "{\"url\":\"https://ya.ru\",\"alias\":\"spring-sale\"}", http.StatusCreated},
+		{"/api/shorten", "{\"url\":\"https://ya.ru\",\"alias\":\"spring-sale\"}", http.StatusConflict},
+		{"/api/shorten", "{\"url\":\"https://mail.ru\",\"alias\":\"spring-sale\"}", http.StatusConflict},
+		{"/api/shorten", "{\"url\":\"https://mail.ru\",\"alias\":\"api\"}", http.StatusBadRequest},
+		{"/api/shorten", "{\"url\":\"https://mail.ru\",\"alias\":\"Debug\"}", http.StatusBadRequest},
+		{"/api/shorten", "{\"url\":\"https://mail.ru\",\"alias\":\"summer/sale\"}", http.StatusBadRequest},
+		{"/api/shorten", "{\"url\":\"https://mail.ru\",\"alias\":\"" + strings.Repeat("a", 65) + "\"}", http.StatusBadRequest},
+		{"/api/shorten/batch", "[{\"correlation_id\":\"1\",\"original_url\":\"https://hh.ru\",\"alias\":\"spring-sale\"}]", http.StatusConflict},
+		{"/api/shorten/batch", "[{\"correlation_id\":\"1\",\"original_url\":\"https://hh.ru\",\"alias\":\"ping\"}]", http.StatusBadRequest},
+		{"/api/shorten/batch", "[{\"correlation_id\":\"1\",\"original_url\":\"https://hh.ru\",\"alias\":\"jobs\"}," +
+			"{\"correlation_id\":\"2\",\"original_url\":\"https://go.dev\"}]", http.StatusCreated},
+	}
+
+	for _, testCase := range testTable {
+		req, err := http.NewRequest(http.MethodPost, ts.URL+testCase.url, strings.NewReader(testCase.body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		require.Equal(t, testCase.status, resp.StatusCode, testCase.body)
+	}
+
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	for alias, original := range map[string]string{"spring-sale": "https://ya.ru", "jobs": "https://hh.ru"} {
+		resp, err := client.Get(ts.URL + "/" + alias)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		require.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+		require.Equal(t, original, resp.Header.Get("Location"))
+	}
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+type APIKey struct {
+	srv domain.APIKeyService
+}
+
+// NewAPIKey creates object to operate handler functions of API keys.
+func NewAPIKey(srv domain.APIKeyService) *APIKey {
+	return &APIKey{srv: srv}
+}
+
+// Create - handler to create API key for the user. The key itself is sent only in this response, it can't be read later.
+func (h *APIKey) Create(w http.ResponseWriter, r *http.Request) {
+	if !IsJSONContentTypeCorrect(r) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	apiKey, key, err := h.srv.Create(r.Context(), req.Name)
+	if errors.Is(err, domain.ErrInvalidAPIKeyName) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if errors.Is(err, domain.ErrUnauthenticated) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, struct {
+		domain.APIKey
+		Key string `json:"key"`
+	}{APIKey: apiKey, Key: key})
+}
+
+// ReadAll - handler to get all API keys of the user, revoked keys are included.
+func (h *APIKey) ReadAll(w http.ResponseWriter, r *http.Request) {
+	if identity, _ := domain.IdentityFromContext(r.Context()); identity.New {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	keys, err := h.srv.ReadAll(r.Context())
+	if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, keys)
+}
+
+// Revoke - handler to revoke API key of the user, so it can't be used anymore.
+func (h *APIKey) Revoke(w http.ResponseWriter, r *http.Request) {
+	if identity, _ := domain.IdentityFromContext(r.Context()); identity.New {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	err := h.srv.Revoke(r.Context(), chi.URLParam(r, "id"))
+	if errors.Is(err, domain.ErrAPIKeyNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
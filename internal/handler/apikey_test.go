@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/interceptor"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/api"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestAPIKeys(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	us := service.NewURL(repository.NewMemory(), state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	uh := NewURL(us)
+	kh := NewAPIKey(testAPIKeys)
+
+	r := chi.NewRouter()
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+	r.Get("/api/user/urls", WrapHandler(uh.ReadUserURLs))
+	r.Post("/api/user/keys", WrapHandler(kh.Create))
+	r.Get("/api/user/keys", WrapHandler(kh.ReadAll))
+	r.Delete("/api/user/keys/{id}", WrapHandler(kh.Revoke))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	owner := newClient(t)
+
+	resp, err := owner.Post(ts.URL+"/api/shorten", "application/json", strings.NewReader("{\"url\":\"https://ya.ru\"}"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, err = owner.Post(ts.URL+"/api/user/keys", "application/json", strings.NewReader("{\"name\":\"backend\"}"))
+	require.NoError(t, err)
+
+	var created struct {
+		domain.APIKey
+		Key  string `json:"key"`
+		Hash string `json:"hash"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Equal(t, "backend", created.Name)
+	require.True(t, strings.HasPrefix(created.Key, created.Prefix))
+	require.Empty(t, created.Hash)
+
+	// requests with API key are made on behalf of the owner of the key, no cookie is needed
+	do := func(method, path, header, value string) *http.Response {
+		req, err := http.NewRequest(method, ts.URL+path, nil)
+		require.NoError(t, err)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	for header, value := range map[string]string{"X-API-Key": created.Key, "Authorization": "Bearer " + created.Key} {
+		resp = do(http.MethodGet, "/api/user/urls", header, value)
+		var urls []domain.UserOutput
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&urls))
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode, header)
+		require.Len(t, urls, 1, header)
+		require.Empty(t, resp.Cookies(), header)
+	}
+
+	resp = do(http.MethodGet, "/api/user/keys", "X-API-Key", created.Key)
+	var keys []domain.APIKey
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&keys))
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, keys, 1)
+	require.Equal(t, created.ID, keys[0].ID)
+	require.Nil(t, keys[0].RevokedAt)
+
+	var testTable = []struct {
+		method string
+		path   string
+		header string
+		value  string
+		status int
+	}{
+		{http.MethodGet, "/api/user/keys", "", "", http.StatusUnauthorized},
+		{http.MethodGet, "/api/user/urls", "X-API-Key", domain.APIKeyPrefix + "nope", http.StatusUnauthorized},
+		{http.MethodDelete, "/api/user/keys/nope", "X-API-Key", created.Key, http.StatusNotFound},
+		{http.MethodDelete, "/api/user/keys/" + created.ID, "Authorization", "Bearer " + created.Key, http.StatusNoContent},
+		// revoked key can't be used anymore
+		{http.MethodGet, "/api/user/urls", "X-API-Key", created.Key, http.StatusUnauthorized},
+	}
+
+	for _, testCase := range testTable {
+		resp = do(testCase.method, testCase.path, testCase.header, testCase.value)
+		resp.Body.Close()
+		require.Equal(t, testCase.status, resp.StatusCode, testCase.method+" "+testCase.path)
+	}
+
+	// the owner still sees the revoked key
+	resp, err = owner.Get(ts.URL + "/api/user/keys")
+	require.NoError(t, err)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&keys))
+	resp.Body.Close()
+	require.Len(t, keys, 1)
+	require.NotNil(t, keys[0].RevokedAt)
+}
+
+func TestGRPCAPIKeys(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Authorize(testAuthenticator), interceptor.ValidateRequest))
+	api.RegisterUrlshrtV1Server(grpcServer, &Server{Wg: &sync.WaitGroup{}, APIKeySrv: testAPIKeys})
+
+	client := startGRPC(t, grpcServer)
+
+	created, err := client.CreateAPIKeyV1(context.Background(), &api.CreateAPIKeyRequestV1{Name: "backend"})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(created.Key, created.ApiKey.Prefix))
+
+	// every client without jwt is registered as a separate user, so keys of anonymous clients have different owners
+	other, err := client.CreateAPIKeyV1(context.Background(), &api.CreateAPIKeyRequestV1{Name: "other"})
+	require.NoError(t, err)
+	require.NotEqual(t, created.ApiKey.Id, other.ApiKey.Id)
+
+	for key, value := range map[string]string{"x-api-key": created.Key, "authorization": "Bearer " + created.Key} {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), key, value)
+		reply, err := client.ReadAPIKeysV1(ctx, &emptypb.Empty{})
+		require.NoError(t, err, key)
+		require.Len(t, reply.ApiKeys, 1, key)
+		require.Equal(t, created.ApiKey.Id, reply.ApiKeys[0].Id, key)
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-api-key", created.Key)
+	var testTable = []struct {
+		ctx  context.Context
+		id   string
+		code codes.Code
+	}{
+		{context.Background(), created.ApiKey.Id, codes.Unauthenticated},
+		{ctx, "nope", codes.NotFound},
+		{ctx, created.ApiKey.Id, codes.OK},
+		// revoked key can't be used anymore
+		{ctx, created.ApiKey.Id, codes.Unauthenticated},
+	}
+
+	for _, testCase := range testTable {
+		_, err = client.RevokeAPIKeyV1(testCase.ctx, &api.RevokeAPIKeyRequestV1{Id: testCase.id})
+		require.Equal(t, testCase.code, status.Code(err), testCase.id)
+	}
+
+	// keys are never owned by nobody, whatever transport lets through
+	for _, ctx := range []context.Context{context.Background(), domain.WithIdentity(context.Background(), domain.Identity{UserID: -1, New: true})} {
+		_, _, err = testAPIKeys.Create(ctx, "nobody")
+		require.ErrorIs(t, err, domain.ErrUnauthenticated)
+	}
+}
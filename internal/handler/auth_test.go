@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestAuthorize(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+
+	r := chi.NewRouter()
+	r.HandleFunc("/", WrapHandler(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-User-Id", strconv.FormatInt(domain.UserIDFromContext(r.Context()), 10))
+	}))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	sign := func(claims jwt.Claims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("abc"))
+		require.NoError(t, err)
+		return token
+	}
+
+	now := time.Now()
+	var testTable = []struct {
+		name    string
+		method  string
+		cookie  string
+		newUser bool
+		userID  string
+		refresh bool
+	}{
+		{"no token", http.MethodPost, "", true, "", true},
+		{"no token, nothing to save", http.MethodGet, "", false, "-1", false},
+		{"fresh token", http.MethodGet, sign(jwt.RegisteredClaims{Subject: "7", IssuedAt: jwt.NewNumericDate(now), ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour))}), false, "7", false},
+		{"token about to expire", http.MethodGet, sign(jwt.RegisteredClaims{Subject: "7", ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute))}), false, "7", true},
+		{"expired token", http.MethodPost, sign(jwt.RegisteredClaims{Subject: "7", ExpiresAt: jwt.NewNumericDate(now.Add(-time.Minute))}), true, "", true},
+		{"expired token, nothing to save", http.MethodHead, sign(jwt.RegisteredClaims{Subject: "7", ExpiresAt: jwt.NewNumericDate(now.Add(-time.Minute))}), false, "-1", false},
+		{"legacy token", http.MethodGet, sign(jwt.MapClaims{"userid": 5}), false, "5", true},
+		{"token without user", http.MethodDelete, sign(jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour))}), true, "", true},
+		{"wrong key", http.MethodPatch, "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiI3In0.wrong", true, "", true},
+	}
+
+	for _, testCase := range testTable {
+		req, err := http.NewRequest(testCase.method, ts.URL, nil)
+		require.NoError(t, err)
+		if testCase.cookie != "" {
+			req.AddCookie(&http.Cookie{Name: "auth", Value: testCase.cookie})
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		id := resp.Header.Get("X-User-Id")
+		if testCase.newUser {
+			parsed, err := strconv.ParseInt(id, 10, 64)
+			require.NoError(t, err, testCase.name)
+			require.GreaterOrEqual(t, parsed, domain.FirstUserID, testCase.name)
+		} else {
+			require.Equal(t, testCase.userID, id, testCase.name)
+		}
+
+		var cookie *http.Cookie
+		for _, c := range resp.Cookies() {
+			if c.Name == "auth" {
+				cookie = c
+			}
+		}
+		require.Equal(t, testCase.refresh, cookie != nil, testCase.name)
+
+		if cookie == nil {
+			continue
+		}
+
+		// new token keeps id of the user in subject and has standard claims
+		var claims jwt.RegisteredClaims
+		_, err = jwt.ParseWithClaims(cookie.Value, &claims, func(t *jwt.Token) (interface{}, error) {
+			return []byte("abc"), nil
+		})
+		require.NoError(t, err, testCase.name)
+		require.Equal(t, id, claims.Subject, testCase.name)
+		require.NotNil(t, claims.IssuedAt, testCase.name)
+		require.NotNil(t, claims.ExpiresAt, testCase.name)
+	}
+}
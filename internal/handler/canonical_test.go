@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/PoorMercymain/urlshrt/internal/canonical"
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/interceptor"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/api"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestCanonicalURLs(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	us := service.NewURL(repository.NewMemory(), state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength),
+		canonical.New(nil, true, false), nil, domain.DedupPerUser)
+	uh := NewURL(us)
+
+	var wg sync.WaitGroup
+	r := chi.NewRouter()
+	r.Post("/", WrapHandler(uh.CreateShortened))
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+	r.Post("/api/shorten/batch", WrapHandler(uh.CreateShortenedFromBatchAdapter(&wg)))
+	r.Patch("/api/user/urls/{short}", WrapHandler(uh.UpdateShortened))
+	r.Get("/api/user/urls", WrapHandler(uh.ReadUserURLs))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	client := newClient(t)
+
+	send := func(method string, path string, contentType string, body string) (int, string) {
+		req, err := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp.StatusCode, string(respBody)
+	}
+
+	code, short := send(http.MethodPost, "/", "text/plain", "example.com/")
+	require.Equal(t, http.StatusCreated, code)
+
+	// the same URL written differently (with or without scheme) is not shortened again
+	for _, original := range []string{"EXAMPLE.com", "https://example.com/#top", "HTTPS://EXAMPLE.com:443"} {
+		code, body := send(http.MethodPost, "/", "text/plain", original)
+		require.Equal(t, http.StatusConflict, code, original)
+		require.Equal(t, short, body, original)
+	}
+
+	var testTable = []struct {
+		method      string
+		path        string
+		contentType string
+		body        string
+		status      int
+	}{
+		{http.MethodPost, "/api/shorten", "application/json", "{\"url\":\"https://Example.com\"}", http.StatusConflict},
+		{http.MethodPost, "/", "text/plain", "", http.StatusBadRequest},
+		{http.MethodPost, "/", "text/plain", "example.com:8080", http.StatusCreated},
+		{http.MethodPost, "/", "text/plain", "/path", http.StatusBadRequest},
+		{http.MethodPost, "/api/shorten", "application/json", "{\"url\":\"ftp://example.com/file\"}", http.StatusBadRequest},
+		{http.MethodPost, "/api/shorten", "application/json", "{\"url\":\"javascript:alert(1)\"}", http.StatusBadRequest},
+		{http.MethodPost, "/api/shorten/batch", "application/json", "[{\"correlation_id\":\"1\",\"original_url\":\"https://пример.рф\"}]", http.StatusCreated},
+		{http.MethodPost, "/api/shorten/batch", "application/json", "[{\"correlation_id\":\"1\",\"original_url\":\"https://exa mple.com\"}]", http.StatusBadRequest},
+		{http.MethodPatch, "/api/user/urls/" + strings.TrimPrefix(short, "http://localhost:8080/"), "application/json", "{\"url\":\"mailto:user@example.com\"}", http.StatusBadRequest},
+	}
+
+	for _, testCase := range testTable {
+		code, _ := send(testCase.method, testCase.path, testCase.contentType, testCase.body)
+		require.Equal(t, testCase.status, code, testCase.body)
+	}
+
+	resp, err := client.Get(ts.URL + "/api/user/urls")
+	require.NoError(t, err)
+
+	var urls []domain.UserOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&urls))
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, urls, 3)
+	require.Equal(t, "https://example.com/", urls[0].OriginalURL)
+	require.Equal(t, "https://example.com:8080/", urls[1].OriginalURL)
+	require.Equal(t, "https://xn--e1afmkfd.xn--p1ai/", urls[2].OriginalURL)
+}
+
+func TestGRPCCanonicalURLs(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Authorize(testAuthenticator), interceptor.ValidateRequest))
+
+	us := service.NewURL(repository.NewMemory(), state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength),
+		canonical.New(nil, false, true), nil, domain.DedupPerUser)
+	api.RegisterUrlshrtV1Server(grpcServer, &Server{Wg: &sync.WaitGroup{}, Srv: us})
+
+	client := startGRPC(t, grpcServer)
+
+	jwt, err := testTokens.Issue(2)
+	require.NoError(t, err)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "auth", jwt)
+
+	created, err := client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: "https://example.com/search?q=go&a=1"})
+	require.NoError(t, err)
+
+	_, err = client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: "https://EXAMPLE.com/search?a=1&q=go"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: "ftp://example.com/file"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CreateShortenedFromBatchV1(ctx, &api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{
+		{Original: "javascript:alert(1)", Correlation: "1"}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// URL without scheme gets the default one, so it isn't shortened again
+	_, err = client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: "Example.com/search?q=go&a=1"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = client.UpdateShortenedV1(ctx, &api.UpdateShortenedRequestV1{Shortened: strings.TrimPrefix(created.Shortened, "http://localhost:8080/"),
+		Original: "https://example.com:99999/"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	urls, err := client.ReadUserURLsV1(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, urls.OriginalWithShortened, 1)
+	require.Equal(t, "https://example.com/search?a=1&q=go", urls.OriginalWithShortened[0].Original)
+}
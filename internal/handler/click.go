@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+type Click struct {
+	srv domain.ClickService
+}
+
+// NewClick creates object to operate handler functions of click analytics.
+func NewClick(srv domain.ClickService) *Click {
+	return &Click{srv: srv}
+}
+
+// ReadURLStats - handler to get amount of clicks made by user's short URL, in total and by periods of time.
+// Length of periods may be set with bucket query parameter (e.g. 1h), a day is used by default.
+func (h *Click) ReadURLStats(w http.ResponseWriter, r *http.Request) {
+	if identity, _ := domain.IdentityFromContext(r.Context()); identity.New {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	bucket := domain.DefaultClickBucket
+	if bucketParam := r.URL.Query().Get("bucket"); bucketParam != "" {
+		var err error
+		bucket, err = time.ParseDuration(bucketParam)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	stats, err := h.srv.ReadStats(r.Context(), chi.URLParam(r, "short"), bucket)
+	if errors.Is(err, domain.ErrInvalidBucket) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if errors.Is(err, domain.ErrURLNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var statsJSONBytes []byte
+	buf := bytes.NewBuffer(statsJSONBytes)
+	err = json.NewEncoder(buf).Encode(stats)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(buf.Bytes())
+	if err != nil {
+		util.GetLogger().Infoln(err)
+	}
+}
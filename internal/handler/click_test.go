@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/middleware"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestClicks(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	repo := repository.NewMemory()
+	us := service.NewURL(repo, state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	cs := service.NewClick(repo, repo, 10, time.Hour)
+	uh := NewURL(us)
+	ch := NewClick(cs)
+
+	ctx, stop := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		cs.Run(ctx)
+	}()
+
+	r := chi.NewRouter()
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+	r.Get("/{short}", WrapHandler(middleware.RecordClicks(http.HandlerFunc(uh.ReadOriginal), cs)))
+	r.Get("/api/user/urls/{short}/stats", WrapHandler(ch.ReadURLStats))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	owner, stranger := newClient(t), newClient(t)
+
+	resp, err := owner.Post(ts.URL+"/api/shorten", "application/json", strings.NewReader("{\"url\":\"https://ya.ru\"}"))
+	require.NoError(t, err)
+
+	var res struct {
+		Result string `json:"result"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	short := strings.TrimPrefix(res.Result, "http://localhost:8080/")
+
+	// redirects don't register users, so the stranger saves a URL too to get an id
+	resp, err = stranger.Post(ts.URL+"/api/shorten", "application/json", strings.NewReader("{\"url\":\"https://mail.ru\"}"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	for _, referrer := range []string{"", "https://mail.ru", ""} {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+"/"+short, nil)
+		require.NoError(t, err)
+		req.Header.Set("Referer", referrer)
+
+		resp, err = stranger.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+	}
+
+	// redirects which failed are not counted
+	resp, err = stranger.Get(ts.URL + "/nope")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// buffered clicks are saved when the service is stopped
+	stop()
+	wg.Wait()
+
+	clicks, err := repo.ReadClicks(context.Background(), short)
+	require.NoError(t, err)
+	require.Len(t, clicks, 3)
+	require.Equal(t, "https://mail.ru", clicks[1].Referrer)
+	require.NotEmpty(t, clicks[0].IP)
+
+	var testTable = []struct {
+		client *http.Client
+		query  string
+		status int
+	}{
+		{owner, "", http.StatusOK},
+		{owner, "?bucket=1h", http.StatusOK},
+		{owner, "?bucket=1s", http.StatusBadRequest},
+		{owner, "?bucket=hour", http.StatusBadRequest},
+		{stranger, "", http.StatusNotFound},
+	}
+
+	for _, testCase := range testTable {
+		resp, err = testCase.client.Get(ts.URL + "/api/user/urls/" + short + "/stats" + testCase.query)
+		require.NoError(t, err)
+
+		if testCase.status == http.StatusOK {
+			var stats domain.ClickStats
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&stats))
+			require.Equal(t, 3, stats.Total)
+			require.Len(t, stats.Buckets, 1)
+			require.Equal(t, 3, stats.Buckets[0].Clicks)
+		}
+		resp.Body.Close()
+
+		require.Equal(t, testCase.status, resp.StatusCode, testCase.query)
+	}
+}
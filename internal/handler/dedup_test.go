@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestDedupScope(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	shorten := func(client *http.Client, url string) (string, int) {
+		resp, err := client.Post(url+"/api/shorten", "application/json", strings.NewReader("{\"url\":\"https://ya.ru\"}"))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var res struct {
+			Result string `json:"result"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+
+		return strings.TrimPrefix(res.Result, "http://localhost:8080/"), resp.StatusCode
+	}
+
+	var testTable = []struct {
+		scope    domain.DedupScope
+		status   int
+		sameShrt bool
+		owned    int
+	}{
+		{domain.DedupPerUser, http.StatusCreated, false, 1},
+		{domain.DedupGlobal, http.StatusConflict, true, 0},
+	}
+
+	newServer := func(repo domain.URLRepository, scope domain.DedupScope) *httptest.Server {
+		us := service.NewURL(repo, state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, scope)
+		uh := NewURL(us)
+
+		r := chi.NewRouter()
+		r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+		r.Get("/api/user/urls", WrapHandler(uh.ReadUserURLs))
+
+		return httptest.NewServer(r)
+	}
+
+	for _, testCase := range testTable {
+		repo := repository.NewMemory()
+		ts := newServer(repo, testCase.scope)
+
+		first, second := newClient(t), newClient(t)
+
+		firstShrt, status := shorten(first, ts.URL)
+		require.Equal(t, http.StatusCreated, status, testCase.scope)
+
+		// the same user always gets the URL which was already saved
+		shrt, status := shorten(first, ts.URL)
+		require.Equal(t, http.StatusConflict, status, testCase.scope)
+		require.Equal(t, firstShrt, shrt, testCase.scope)
+
+		shrt, status = shorten(second, ts.URL)
+		require.Equal(t, testCase.status, status, testCase.scope)
+		require.Equal(t, testCase.sameShrt, firstShrt == shrt, testCase.scope)
+
+		resp, err := second.Get(ts.URL + "/api/user/urls")
+		require.NoError(t, err)
+
+		var userURLs []map[string]string
+		if resp.StatusCode == http.StatusOK {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&userURLs))
+		}
+		resp.Body.Close()
+		require.Len(t, userURLs, testCase.owned, testCase.scope)
+
+		ts.Close()
+
+		if testCase.scope != domain.DedupGlobal {
+			continue
+		}
+
+		// another instance of the app doesn't have the URL in its store, so it is found by the repository
+		other := newServer(repo, testCase.scope)
+		shrt, status = shorten(newClient(t), other.URL)
+		require.Equal(t, http.StatusConflict, status)
+		require.Equal(t, firstShrt, shrt)
+
+		other.Close()
+	}
+}
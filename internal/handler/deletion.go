@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+type Deletion struct {
+	srv domain.DeletionService
+}
+
+// NewDeletion creates object to operate handler functions of deletion of URLs.
+func NewDeletion(srv domain.DeletionService) *Deletion {
+	return &Deletion{srv: srv}
+}
+
+// DeleteUserURLs - handler to mark URLs of the user as deleted. URLs are deleted in background,
+// so the user gets id of the job to check what happened to them.
+func (h *Deletion) DeleteUserURLs(w http.ResponseWriter, r *http.Request) {
+	short := make([]string, 0, 1)
+
+	if !IsJSONContentTypeCorrect(r) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&short); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(short) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	jobID, err := h.srv.DeleteUserURLs(r.Context(), short)
+	if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, struct {
+		JobID string `json:"job_id"`
+	}{JobID: jobID})
+}
+
+// ReadDeletionJob - handler to get statuses of URLs which the user asked to delete.
+func (h *Deletion) ReadDeletionJob(w http.ResponseWriter, r *http.Request) {
+	if identity, _ := domain.IdentityFromContext(r.Context()); identity.New {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	job, err := h.srv.ReadDeletionJob(r.Context(), chi.URLParam(r, "id"))
+	if errors.Is(err, domain.ErrDeletionJobNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, job)
+}
+
+// RestoreUserURLs - handler to restore deleted URLs of the user if grace period after their deletion is not over yet.
+// Statuses of the URLs are returned in the same order.
+func (h *Deletion) RestoreUserURLs(w http.ResponseWriter, r *http.Request) {
+	if identity, _ := domain.IdentityFromContext(r.Context()); identity.New {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	short := make([]string, 0, 1)
+
+	if !IsJSONContentTypeCorrect(r) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&short); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(short) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	results, err := h.srv.RestoreUserURLs(r.Context(), short)
+	if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, results)
+}
@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/interceptor"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/api"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestDeletionJobs(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	repo := repository.NewMemory()
+	us := service.NewURL(repo, state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	ds := service.NewDeletion(repo, repo, 10, 10*time.Millisecond, time.Hour)
+	uh := NewURL(us)
+	dh := NewDeletion(ds)
+
+	ctx, stop := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ds.Run(ctx)
+	}()
+	defer func() {
+		stop()
+		wg.Wait()
+	}()
+
+	r := chi.NewRouter()
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+	r.Delete("/api/user/urls", WrapHandler(dh.DeleteUserURLs))
+	r.Get("/api/user/deletions/{id}", WrapHandler(dh.ReadDeletionJob))
+	r.Post("/api/user/urls/restore", WrapHandler(dh.RestoreUserURLs))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	owner, stranger := newClient(t), newClient(t)
+
+	for client, body := range map[*http.Client]string{
+		owner:    "{\"url\":\"https://ya.ru\",\"alias\":\"mine\"}",
+		stranger: "{\"url\":\"https://mail.ru\",\"alias\":\"theirs\"}",
+	} {
+		resp, err := client.Post(ts.URL+"/api/shorten", "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, ts.URL+"/api/user/urls", strings.NewReader("[\"mine\",\"theirs\",\"nope\"]"))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := owner.Do(req)
+	require.NoError(t, err)
+
+	var accepted struct {
+		JobID string `json:"job_id"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&accepted))
+	resp.Body.Close()
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	require.NotEmpty(t, accepted.JobID)
+
+	var job domain.DeletionJob
+	require.Eventually(t, func() bool {
+		resp, err := owner.Get(ts.URL + "/api/user/deletions/" + accepted.JobID)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&job))
+		return job.Done
+	}, 5*time.Second, 50*time.Millisecond)
+
+	require.Equal(t, []domain.DeletionResult{
+		{ShortURL: "mine", Status: domain.DeletionDeleted},
+		{ShortURL: "theirs", Status: domain.DeletionNotOwned},
+		{ShortURL: "nope", Status: domain.DeletionNotFound},
+	}, job.Results)
+
+	// jobs of other users are not shown
+	resp, err = stranger.Get(ts.URL + "/api/user/deletions/" + accepted.JobID)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Get(ts.URL + "/api/user/deletions/" + accepted.JobID)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	var testTable = []struct {
+		client *http.Client
+		body   string
+		status int
+	}{
+		{owner, "[\"mine\",\"theirs\",\"mine\"]", http.StatusOK},
+		{owner, "[]", http.StatusBadRequest},
+		{owner, "mine", http.StatusBadRequest},
+		{http.DefaultClient, "[\"mine\"]", http.StatusUnauthorized},
+	}
+
+	for _, testCase := range testTable {
+		resp, err = testCase.client.Post(ts.URL+"/api/user/urls/restore", "application/json", strings.NewReader(testCase.body))
+		require.NoError(t, err)
+
+		if testCase.status == http.StatusOK {
+			var results []domain.RestoreResult
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&results))
+			require.Equal(t, []domain.RestoreResult{
+				{ShortURL: "mine", Status: domain.RestoreRestored},
+				{ShortURL: "theirs", Status: domain.RestoreNotOwned},
+				{ShortURL: "mine", Status: domain.RestoreRestored},
+			}, results)
+		}
+		resp.Body.Close()
+
+		require.Equal(t, testCase.status, resp.StatusCode, testCase.body)
+	}
+
+	deleted, err := repo.IsURLDeleted(context.Background(), "mine")
+	require.NoError(t, err)
+	require.False(t, deleted)
+}
+
+func TestGRPCDeletionJobs(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Authorize(testAuthenticator), interceptor.ValidateRequest))
+
+	repo := repository.NewMemory()
+	us := service.NewURL(repo, state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	ds := service.NewDeletion(repo, repo, 10, 10*time.Millisecond, time.Hour)
+	api.RegisterUrlshrtV1Server(grpcServer, &Server{Wg: &sync.WaitGroup{}, Srv: us, DeletionSrv: ds})
+
+	runCtx, stop := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ds.Run(runCtx)
+	}()
+	defer func() {
+		stop()
+		wg.Wait()
+	}()
+
+	client := startGRPC(t, grpcServer)
+
+	// URLs are deleted on behalf of the caller, not of some fixed user
+	jwt, err := testTokens.Issue(2)
+	require.NoError(t, err)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "auth", jwt)
+
+	_, err = client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: "https://ya.ru", Alias: "grpc-mine"})
+	require.NoError(t, err)
+
+	reply, err := client.DeleteUserURLsV1(ctx, &api.DeleteUserURLsRequestV1{UrlsToDelete: []string{"grpc-mine", "nope"}})
+	require.NoError(t, err)
+	require.NotEmpty(t, reply.JobId)
+
+	var job *api.ReadDeletionJobReplyV1
+	require.Eventually(t, func() bool {
+		job, err = client.ReadDeletionJobV1(ctx, &api.ReadDeletionJobRequestV1{JobId: reply.JobId})
+		require.NoError(t, err)
+		return job.Done
+	}, 5*time.Second, 50*time.Millisecond)
+
+	require.Len(t, job.Results, 2)
+	require.Equal(t, api.DeletionStatusV1_DELETION_STATUS_V1_DELETED, job.Results[0].Status)
+	require.Equal(t, api.DeletionStatusV1_DELETION_STATUS_V1_NOT_FOUND, job.Results[1].Status)
+
+	other, err := testTokens.Issue(3)
+	require.NoError(t, err)
+
+	_, err = client.ReadDeletionJobV1(metadata.AppendToOutgoingContext(context.Background(), "auth", other),
+		&api.ReadDeletionJobRequestV1{JobId: reply.JobId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	restoreReply, err := client.RestoreUserURLsV1(ctx, &api.RestoreUserURLsRequestV1{UrlsToRestore: []string{"grpc-mine", "nope"}})
+	require.NoError(t, err)
+	require.Len(t, restoreReply.Results, 2)
+	require.Equal(t, api.RestoreStatusV1_RESTORE_STATUS_V1_RESTORED, restoreReply.Results[0].Status)
+	require.Equal(t, api.RestoreStatusV1_RESTORE_STATUS_V1_NOT_FOUND, restoreReply.Results[1].Status)
+
+	_, err = client.RestoreUserURLsV1(ctx, &api.RestoreUserURLsRequestV1{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
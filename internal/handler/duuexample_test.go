@@ -4,26 +4,23 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
-	"sync"
 
 	"github.com/go-chi/chi/v5"
 )
 
 func routerExampleDeleteUserURLs() chi.Router {
-	us := GetExampleMockSrv()
+	ds := GetExampleMockDeletionSrv()
 
 	r := chi.NewRouter()
 
-	uh := NewURL(us)
+	dh := NewDeletion(ds)
 
-	var wg sync.WaitGroup
-
-	r.Delete("/api/user/urls", WrapHandler(uh.DeleteUserURLsAdapter(nil, nil, &wg)))
+	r.Delete("/api/user/urls", WrapHandler(dh.DeleteUserURLs))
 
 	return r
 }
 
-func ExampleURL_DeleteUserURLsAdapter() {
+func ExampleDeletion_DeleteUserURLs() {
 	ts := httptest.NewServer(routerExampleDeleteUserURLs())
 	defer ts.Close()
 
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"strconv"
 	"sync"
+	"time"
 
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -18,11 +20,39 @@ import (
 	"github.com/PoorMercymain/urlshrt/pkg/util"
 )
 
+// timeOrNil is a function to convert optional timestamp from request to pointer.
+func timeOrNil(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+	return &t
+}
+
+// timestampOrNil is a function to convert optional time to timestamp for reply.
+func timestampOrNil(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+// originalWithShortened is a function to convert URL of the user to reply, addr is added to short URL.
+func originalWithShortened(addr string, url state.URLStringJSON) *api.OriginalWithShortenedV1 {
+	return &api.OriginalWithShortenedV1{Original: url.OriginalURL, Shortened: addr + url.ShortURL, CreatedAt: timestampOrNil(url.CreatedAt),
+		UpdatedAt: timestampOrNil(url.UpdatedAt), Title: url.Title, Note: url.Note, Tags: url.Tags}
+}
+
 type Server struct {
-	Wg            *sync.WaitGroup
-	Once          *sync.Once
-	Srv           domain.URLService
-	ShortURLsChan *domain.MutexChanString
+	Wg          *sync.WaitGroup
+	Srv         domain.URLService
+	ClickSrv    domain.ClickService
+	APIKeySrv   domain.APIKeyService
+	DeletionSrv domain.DeletionService
+	TagSrv      domain.TagService
+	PolicySrv   domain.PolicyService
 	api.UnimplementedUrlshrtV1Server
 }
 
@@ -64,9 +94,18 @@ func (h *Server) CreateShortenedV1(ctx context.Context, req *api.CreateShortened
 		ctx = context.WithValue(ctx, domain.Key("seed"), int64(randSeed))
 	}
 
-	shortenedURL, err := h.Srv.CreateShortened(ctx, req.Original)
+	shortenedURL, err := h.Srv.CreateShortened(ctx, req.Original, domain.ShortenOptions{Alias: req.Alias,
+		ExpiresAt: timeOrNil(req.ExpiresAt), NotBefore: timeOrNil(req.NotBefore), Title: req.Title, Note: req.Note, Tags: req.Tags})
 	var uErr *domain.UniqueError
-	if err != nil && errors.As(err, &uErr) {
+	var aErr *domain.AliasConflictError
+	if errors.Is(err, domain.ErrInvalidAlias) || errors.Is(err, domain.ErrInvalidWindow) || errors.Is(err, domain.ErrInvalidMetadata) ||
+		errors.Is(err, domain.ErrInvalidURL) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	} else if errors.Is(err, domain.ErrForbiddenURL) {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	} else if errors.As(err, &aErr) {
+		return nil, status.Errorf(codes.AlreadyExists, "requested alias is already taken")
+	} else if err != nil && errors.As(err, &uErr) {
 		return &api.CreateShortenedReplyV1{Shortened: addr + shortenedURL},
 			status.Errorf(codes.AlreadyExists, "provided URL already exist in the service")
 	} else if err != nil {
@@ -76,10 +115,39 @@ func (h *Server) CreateShortenedV1(ctx context.Context, req *api.CreateShortened
 	return &api.CreateShortenedReplyV1{Shortened: addr + shortenedURL}, nil
 }
 
+func (h *Server) UpdateShortenedV1(ctx context.Context, req *api.UpdateShortenedRequestV1) (*api.UpdateShortenedReplyV1, error) {
+	if identity, _ := domain.IdentityFromContext(ctx); identity.New {
+		return nil, status.Errorf(codes.Unauthenticated, "please use jwt from response metadata to access the handler")
+	}
+
+	addr := state.GetBaseShortAddress()
+	if addr[len(addr)-1] != '/' {
+		addr = addr + "/"
+	}
+
+	shortenedURL, err := h.Srv.UpdateShortened(ctx, req.Shortened, req.Original)
+	var uErr *domain.UniqueError
+	if errors.Is(err, domain.ErrURLNotFound) {
+		return nil, status.Errorf(codes.NotFound, "there is no such url among urls of the user")
+	} else if errors.Is(err, domain.ErrInvalidURL) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	} else if errors.Is(err, domain.ErrForbiddenURL) {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	} else if errors.As(err, &uErr) {
+		return &api.UpdateShortenedReplyV1{Shortened: addr + shortenedURL},
+			status.Errorf(codes.AlreadyExists, "provided URL already exist in the service")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "something went wrong in the service")
+	}
+
+	return &api.UpdateShortenedReplyV1{Shortened: addr + shortenedURL}, nil
+}
+
 func (h *Server) CreateShortenedFromBatchV1(ctx context.Context, req *api.CreateShortenedFromBatchRequestV1) (*api.CreateShortenedFromBatchReplyV1, error) {
 	batch := make([]*domain.BatchElement, len(req.Original))
 	for i, elem := range req.Original {
-		batch[i] = &domain.BatchElement{ID: elem.Correlation, OriginalURL: elem.Original}
+		batch[i] = &domain.BatchElement{ID: elem.Correlation, OriginalURL: elem.Original, Alias: elem.Alias,
+			ExpiresAt: timeOrNil(elem.ExpiresAt), NotBefore: timeOrNil(elem.NotBefore), Title: elem.Title, Note: elem.Note, Tags: elem.Tags}
 	}
 
 	util.GetLogger().Infoln(batch)
@@ -102,7 +170,15 @@ func (h *Server) CreateShortenedFromBatchV1(ctx context.Context, req *api.Create
 	}
 
 	shortened, err := h.Srv.CreateShortenedFromBatch(ctx, batch, h.Wg)
-	if err != nil {
+	var aErr *domain.AliasConflictError
+	if errors.Is(err, domain.ErrInvalidAlias) || errors.Is(err, domain.ErrInvalidWindow) || errors.Is(err, domain.ErrInvalidMetadata) ||
+		errors.Is(err, domain.ErrInvalidURL) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	} else if errors.Is(err, domain.ErrForbiddenURL) {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	} else if errors.As(err, &aErr) {
+		return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "something went wrong while processing the request")
 	}
 
@@ -121,7 +197,7 @@ func (h *Server) CreateShortenedFromBatchV1(ctx context.Context, req *api.Create
 }
 
 func (h *Server) ReadUserURLsV1(ctx context.Context, req *emptypb.Empty) (*api.ReadUserURLsReplyV1, error) {
-	if unauthorized := ctx.Value(domain.Key("unauthorized")); unauthorized != nil {
+	if identity, _ := domain.IdentityFromContext(ctx); identity.New {
 		return nil, status.Errorf(codes.Unauthenticated, "please use jwt from response metadata to access the handler")
 	}
 
@@ -137,12 +213,54 @@ func (h *Server) ReadUserURLsV1(ctx context.Context, req *emptypb.Empty) (*api.R
 
 	userURLsReply := &api.ReadUserURLsReplyV1{OriginalWithShortened: make([]*api.OriginalWithShortenedV1, len(UserURLs))}
 	for i, url := range UserURLs {
-		userURLsReply.OriginalWithShortened[i] = &api.OriginalWithShortenedV1{Original: url.OriginalURL, Shortened: addr + url.ShortURL}
+		userURLsReply.OriginalWithShortened[i] = originalWithShortened(addr, url)
 	}
 
 	return userURLsReply, nil
 }
 
+// sortOrders contains orders of URLs by their values in requests.
+var sortOrders = map[api.SortOrderV1]domain.SortOrder{
+	api.SortOrderV1_SORT_ORDER_V1_UNSPECIFIED: domain.SortAsc,
+	api.SortOrderV1_SORT_ORDER_V1_ASC:         domain.SortAsc,
+	api.SortOrderV1_SORT_ORDER_V1_DESC:        domain.SortDesc,
+}
+
+// StreamUserURLsV1 sends URLs of the user one by one, URLs are read from the service page by page, so all of them are never kept in memory.
+func (h *Server) StreamUserURLsV1(req *api.StreamUserURLsRequestV1, stream api.UrlshrtV1_StreamUserURLsV1Server) error {
+	ctx := stream.Context()
+	if identity, _ := domain.IdentityFromContext(ctx); identity.New {
+		return status.Errorf(codes.Unauthenticated, "please use jwt from response metadata to access the handler")
+	}
+
+	addr := state.GetBaseShortAddress()
+	if addr[len(addr)-1] != '/' {
+		addr = addr + "/"
+	}
+
+	pageReq := domain.URLPageRequest{URLFilter: domain.URLFilter{Contains: req.Contains, Domain: req.Domain, Tag: req.Tag},
+		Limit: domain.MaxPageSize, Order: sortOrders[req.Order]}
+	for {
+		page, err := h.Srv.ReadUserURLsPage(ctx, pageReq)
+		if errors.Is(err, domain.ErrInvalidQuery) {
+			return status.Errorf(codes.InvalidArgument, "%v", err)
+		} else if err != nil {
+			return status.Errorf(codes.Internal, "something went wrong while processing the request")
+		}
+
+		for _, url := range page.URLs {
+			if err = stream.Send(originalWithShortened(addr, url)); err != nil {
+				return err
+			}
+		}
+
+		if page.NextCursor == "" {
+			return nil
+		}
+		pageReq.Cursor = page.NextCursor
+	}
+}
+
 func (h *Server) ReadAmountOfURLsAndUsersV1(ctx context.Context, req *emptypb.Empty) (*api.ReadAmountOfURLsAndUsersReplyV1, error) {
 	readAmountReply := &api.ReadAmountOfURLsAndUsersReplyV1{}
 
@@ -160,16 +278,227 @@ func (h *Server) ReadAmountOfURLsAndUsersV1(ctx context.Context, req *emptypb.Em
 	return readAmountReply, nil
 }
 
-func (h *Server) DeleteUserURLsV1(ctx context.Context, req *api.DeleteUserURLsRequestV1) (*emptypb.Empty, error) {
-	ctx = context.WithValue(ctx, domain.Key("id"), int64(1))
-	shortURLWithID := make([]domain.URLWithID, len(req.UrlsToDelete))
-	for i, url := range req.UrlsToDelete {
-		shortURLWithID[i] = domain.URLWithID{ID: ctx.Value(domain.Key("id")).(int64), URL: url}
+func (h *Server) EnforcePolicyV1(ctx context.Context, req *emptypb.Empty) (*api.EnforcePolicyReplyV1, error) {
+	disabled, err := h.PolicySrv.Enforce(ctx)
+	if err != nil {
+		util.GetLogger().Infoln(err)
+		return nil, status.Errorf(codes.Internal, "something went wrong while processing the request")
+	}
+
+	addr := state.GetBaseShortAddress()
+	if addr[len(addr)-1] != '/' {
+		addr = addr + "/"
+	}
+
+	for i, short := range disabled {
+		disabled[i] = addr + short
+	}
+
+	return &api.EnforcePolicyReplyV1{Disabled: disabled}, nil
+}
+
+func (h *Server) DeleteUserURLsV1(ctx context.Context, req *api.DeleteUserURLsRequestV1) (*api.DeleteUserURLsReplyV1, error) {
+	jobID, err := h.DeletionSrv.DeleteUserURLs(ctx, req.UrlsToDelete)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "something went wrong while processing the request")
+	}
+
+	return &api.DeleteUserURLsReplyV1{JobId: jobID}, nil
+}
+
+// deletionStatuses contains statuses of deletion in replies by their domain values.
+var deletionStatuses = map[domain.DeletionStatus]api.DeletionStatusV1{
+	domain.DeletionPending:  api.DeletionStatusV1_DELETION_STATUS_V1_PENDING,
+	domain.DeletionDeleted:  api.DeletionStatusV1_DELETION_STATUS_V1_DELETED,
+	domain.DeletionNotOwned: api.DeletionStatusV1_DELETION_STATUS_V1_NOT_OWNED,
+	domain.DeletionNotFound: api.DeletionStatusV1_DELETION_STATUS_V1_NOT_FOUND,
+	domain.DeletionFailed:   api.DeletionStatusV1_DELETION_STATUS_V1_FAILED,
+}
+
+func (h *Server) ReadDeletionJobV1(ctx context.Context, req *api.ReadDeletionJobRequestV1) (*api.ReadDeletionJobReplyV1, error) {
+	if identity, _ := domain.IdentityFromContext(ctx); identity.New {
+		return nil, status.Errorf(codes.Unauthenticated, "please use jwt from response metadata to access the handler")
+	}
+
+	job, err := h.DeletionSrv.ReadDeletionJob(ctx, req.JobId)
+	if errors.Is(err, domain.ErrDeletionJobNotFound) {
+		return nil, status.Errorf(codes.NotFound, "there is no such deletion job among deletion jobs of the user")
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "something went wrong while processing the request")
+	}
+
+	jobReply := &api.ReadDeletionJobReplyV1{JobId: job.ID, CreatedAt: timestamppb.New(job.CreatedAt), Done: job.Done,
+		Results: make([]*api.DeletionResultV1, len(job.Results))}
+	for i, result := range job.Results {
+		jobReply.Results[i] = &api.DeletionResultV1{Shortened: result.ShortURL, Status: deletionStatuses[result.Status]}
+	}
+
+	return jobReply, nil
+}
+
+// restoreStatuses contains statuses of restoration in replies by their domain values.
+var restoreStatuses = map[domain.RestoreStatus]api.RestoreStatusV1{
+	domain.RestoreRestored:   api.RestoreStatusV1_RESTORE_STATUS_V1_RESTORED,
+	domain.RestoreNotDeleted: api.RestoreStatusV1_RESTORE_STATUS_V1_NOT_DELETED,
+	domain.RestoreNotOwned:   api.RestoreStatusV1_RESTORE_STATUS_V1_NOT_OWNED,
+	domain.RestoreNotFound:   api.RestoreStatusV1_RESTORE_STATUS_V1_NOT_FOUND,
+	domain.RestoreExpired:    api.RestoreStatusV1_RESTORE_STATUS_V1_EXPIRED,
+}
+
+func (h *Server) RestoreUserURLsV1(ctx context.Context, req *api.RestoreUserURLsRequestV1) (*api.RestoreUserURLsReplyV1, error) {
+	if identity, _ := domain.IdentityFromContext(ctx); identity.New {
+		return nil, status.Errorf(codes.Unauthenticated, "please use jwt from response metadata to access the handler")
+	}
+
+	results, err := h.DeletionSrv.RestoreUserURLs(ctx, req.UrlsToRestore)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "something went wrong while processing the request")
+	}
+
+	restoreReply := &api.RestoreUserURLsReplyV1{Results: make([]*api.RestoreResultV1, len(results))}
+	for i, result := range results {
+		restoreReply.Results[i] = &api.RestoreResultV1{Shortened: result.ShortURL, Status: restoreStatuses[result.Status]}
+	}
+
+	return restoreReply, nil
+}
+
+func (h *Server) ReadURLStatsV1(ctx context.Context, req *api.ReadURLStatsRequestV1) (*api.ReadURLStatsReplyV1, error) {
+	if identity, _ := domain.IdentityFromContext(ctx); identity.New {
+		return nil, status.Errorf(codes.Unauthenticated, "please use jwt from response metadata to access the handler")
+	}
+
+	bucket := domain.DefaultClickBucket
+	if req.Bucket != nil {
+		bucket = req.Bucket.AsDuration()
+	}
+
+	stats, err := h.ClickSrv.ReadStats(ctx, req.Shortened, bucket)
+	if errors.Is(err, domain.ErrInvalidBucket) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if errors.Is(err, domain.ErrURLNotFound) {
+		return nil, status.Errorf(codes.NotFound, "there is no such url among urls of the user")
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "something went wrong while processing the request")
+	}
+
+	statsReply := &api.ReadURLStatsReplyV1{Shortened: stats.ShortURL, Total: int64(stats.Total), Buckets: make([]*api.ClickBucketV1, len(stats.Buckets))}
+	for i, b := range stats.Buckets {
+		statsReply.Buckets[i] = &api.ClickBucketV1{Start: timestamppb.New(b.Start), Clicks: int64(b.Clicks)}
+	}
+
+	return statsReply, nil
+}
+
+// apiKeyReply is a function to convert API key to its representation in replies.
+func apiKeyReply(key domain.APIKey) *api.APIKeyV1 {
+	reply := &api.APIKeyV1{Id: key.ID, Name: key.Name, Prefix: key.Prefix, CreatedAt: timestamppb.New(key.CreatedAt)}
+	if key.RevokedAt != nil {
+		reply.RevokedAt = timestamppb.New(*key.RevokedAt)
+	}
+
+	return reply
+}
+
+func (h *Server) CreateAPIKeyV1(ctx context.Context, req *api.CreateAPIKeyRequestV1) (*api.CreateAPIKeyReplyV1, error) {
+	apiKey, key, err := h.APIKeySrv.Create(ctx, req.Name)
+	if errors.Is(err, domain.ErrInvalidAPIKeyName) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if errors.Is(err, domain.ErrUnauthenticated) {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "something went wrong while processing the request")
+	}
+
+	return &api.CreateAPIKeyReplyV1{ApiKey: apiKeyReply(apiKey), Key: key}, nil
+}
+
+func (h *Server) ReadAPIKeysV1(ctx context.Context, req *emptypb.Empty) (*api.ReadAPIKeysReplyV1, error) {
+	if identity, _ := domain.IdentityFromContext(ctx); identity.New {
+		return nil, status.Errorf(codes.Unauthenticated, "please use jwt from response metadata to access the handler")
+	}
+
+	keys, err := h.APIKeySrv.ReadAll(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "something went wrong while processing the request")
 	}
 
-	go func() {
-		h.Srv.DeleteUserURLs(ctx, shortURLWithID, h.ShortURLsChan, h.Once, h.Wg)
-	}()
+	keysReply := &api.ReadAPIKeysReplyV1{ApiKeys: make([]*api.APIKeyV1, len(keys))}
+	for i, key := range keys {
+		keysReply.ApiKeys[i] = apiKeyReply(key)
+	}
+
+	return keysReply, nil
+}
+
+func (h *Server) RevokeAPIKeyV1(ctx context.Context, req *api.RevokeAPIKeyRequestV1) (*emptypb.Empty, error) {
+	if identity, _ := domain.IdentityFromContext(ctx); identity.New {
+		return nil, status.Errorf(codes.Unauthenticated, "please use jwt from response metadata to access the handler")
+	}
+
+	err := h.APIKeySrv.Revoke(ctx, req.Id)
+	if errors.Is(err, domain.ErrAPIKeyNotFound) {
+		return nil, status.Errorf(codes.NotFound, "there is no such api key among api keys of the user")
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "something went wrong while processing the request")
+	}
 
 	return &emptypb.Empty{}, nil
 }
+
+func (h *Server) AddTagsV1(ctx context.Context, req *api.UpdateTagsRequestV1) (*api.UpdateTagsReplyV1, error) {
+	return h.updateTags(ctx, req, h.TagSrv.AddTags)
+}
+
+func (h *Server) RemoveTagsV1(ctx context.Context, req *api.UpdateTagsRequestV1) (*api.UpdateTagsReplyV1, error) {
+	return h.updateTags(ctx, req, h.TagSrv.RemoveTags)
+}
+
+// updateTags changes tags of URL of the user with update.
+func (h *Server) updateTags(ctx context.Context, req *api.UpdateTagsRequestV1,
+	update func(ctx context.Context, shortened string, tags []string) ([]string, error)) (*api.UpdateTagsReplyV1, error) {
+	if identity, _ := domain.IdentityFromContext(ctx); identity.New {
+		return nil, status.Errorf(codes.Unauthenticated, "please use jwt from response metadata to access the handler")
+	}
+
+	tags, err := update(ctx, req.Shortened, req.Tags)
+	if errors.Is(err, domain.ErrInvalidMetadata) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	} else if errors.Is(err, domain.ErrURLNotFound) {
+		return nil, status.Errorf(codes.NotFound, "there is no such url among urls of the user")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "something went wrong while processing the request")
+	}
+
+	return &api.UpdateTagsReplyV1{Tags: tags}, nil
+}
+
+func (h *Server) ReadTagsV1(ctx context.Context, req *emptypb.Empty) (*api.ReadTagsReplyV1, error) {
+	if identity, _ := domain.IdentityFromContext(ctx); identity.New {
+		return nil, status.Errorf(codes.Unauthenticated, "please use jwt from response metadata to access the handler")
+	}
+
+	tags, err := h.TagSrv.ReadTags(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "something went wrong while processing the request")
+	}
+
+	tagsReply := &api.ReadTagsReplyV1{Tags: make([]*api.TagCountV1, len(tags))}
+	for i, tag := range tags {
+		tagsReply.Tags[i] = &api.TagCountV1{Tag: tag.Tag, Count: int64(tag.Count)}
+	}
+
+	return tagsReply, nil
+}
@@ -3,11 +3,9 @@ package handler
 import (
 	"context"
 	"errors"
-	"net"
 	"sync"
 	"testing"
-
-	"google.golang.org/grpc/credentials/insecure"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -15,13 +13,14 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/PoorMercymain/urlshrt/internal/domain"
 	"github.com/PoorMercymain/urlshrt/internal/domain/mocks"
 	"github.com/PoorMercymain/urlshrt/internal/interceptor"
-	"github.com/PoorMercymain/urlshrt/internal/middleware"
 	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
 	"github.com/PoorMercymain/urlshrt/internal/state"
 	"github.com/PoorMercymain/urlshrt/pkg/api"
 	"github.com/PoorMercymain/urlshrt/pkg/util"
@@ -33,23 +32,27 @@ func TestGRPC(t *testing.T) {
 
 	state.InitShortAddress("addr")
 
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Log, interceptor.Authorize("abc"),
-		interceptor.CheckCIDR("127.0.0.1/32"), interceptor.ValidateRequest))
-	var wg sync.WaitGroup
-	var once sync.Once
-
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	urls := make(map[string]state.URLStringJSON)
+	// only the first original URL is read successfully, so only one click is recorded
+	cs := mocks.NewMockClickService(ctrl)
+	cs.EXPECT().Record(gomock.Any()).Times(1)
+
+	cs.EXPECT().ReadStats(gomock.Any(), "cba", domain.DefaultClickBucket).Return(domain.ClickStats{ShortURL: "cba", Total: 1,
+		Buckets: []domain.ClickBucket{{Start: time.Now().Truncate(time.Hour), Clicks: 1}}}, nil).MaxTimes(1)
+	cs.EXPECT().ReadStats(gomock.Any(), "abc", domain.DefaultClickBucket).Return(domain.ClickStats{}, domain.ErrURLNotFound).MaxTimes(1)
+	cs.EXPECT().ReadStats(gomock.Any(), "cba", time.Second).Return(domain.ClickStats{}, domain.ErrInvalidBucket).MaxTimes(1)
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Log, interceptor.Authorize(testAuthenticator),
+		interceptor.CheckCIDR("127.0.0.1/32"), interceptor.ValidateRequest, interceptor.RecordClicks(cs)))
+	var wg sync.WaitGroup
 
-	urls["abc"] = state.URLStringJSON{
+	store := state.NewStore([]state.URLStringJSON{{
 		ShortURL:    "cba",
 		OriginalURL: "abc",
 		UUID:        1,
-	}
-
-	state.InitCurrentURLs(&urls)
+	}})
 
 	ur := mocks.NewMockURLRepository(ctrl)
 	ur.EXPECT().IsURLDeleted(gomock.Any(), gomock.Any()).Return(false, nil).MaxTimes(1)
@@ -64,42 +67,26 @@ func TestGRPC(t *testing.T) {
 	ur.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(errors.New("")).MaxTimes(1)
 	ur.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(nil).MaxTimes(2)
 
-	ur.EXPECT().ReadUserURLs(gomock.Any()).Return([]state.URLStringJSON{}, nil).MaxTimes(1)
-	ur.EXPECT().ReadUserURLs(gomock.Any()).Return([]state.URLStringJSON{}, errors.New("")).MaxTimes(1)
+	ur.EXPECT().ReadUserURLs(gomock.Any(), gomock.Any()).Return([]state.URLStringJSON{}, nil).MaxTimes(1)
+	ur.EXPECT().ReadUserURLs(gomock.Any(), gomock.Any()).Return([]state.URLStringJSON{}, errors.New("")).MaxTimes(1)
 
 	ur.EXPECT().CountURLsAndUsers(gomock.Any()).Return(1, 1, nil).MaxTimes(1)
 	ur.EXPECT().CountURLsAndUsers(gomock.Any()).Return(0, 0, errors.New("")).MaxTimes(1)
 
-	ur.EXPECT().DeleteUserURLs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).MaxTimes(2)
-
-	us := service.NewURL(ur)
+	ds := mocks.NewMockDeletionService(ctrl)
+	ds.EXPECT().DeleteUserURLs(gomock.Any(), []string{"a"}).Return("0123456789abcdef", nil).Times(1)
 
-	ch := make(chan domain.URLWithID)
-	mc := domain.NewMutexChanString(ch)
+	us := service.NewURL(ur, store, shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
 
 	urlshrt := &Server{
-		Wg:            &wg,
-		Once:          &once,
-		Srv:           us,
-		ShortURLsChan: mc,
+		Wg:          &wg,
+		Srv:         us,
+		ClickSrv:    cs,
+		DeletionSrv: ds,
 	}
 	api.RegisterUrlshrtV1Server(grpcServer, urlshrt)
 
-	listener, err := net.Listen("tcp", "localhost:0")
-	require.NoError(t, err)
-
-	go func() {
-		errServe := grpcServer.Serve(listener)
-		require.NoError(t, errServe)
-	}()
-	defer grpcServer.Stop()
-
-	creds := insecure.NewCredentials()
-	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(creds))
-	require.NoError(t, err)
-	defer conn.Close()
-
-	client := api.NewUrlshrtV1Client(conn)
+	client := startGRPC(t, grpcServer)
 
 	testTableReadOriginal := []struct {
 		input      *api.ReadOriginalRequestV1
@@ -111,22 +98,30 @@ func TestGRPC(t *testing.T) {
 	}
 
 	for _, test := range testTableReadOriginal {
-		_, err := client.ReadOriginalV1(context.Background(), test.input)
+		var header metadata.MD
+		_, err := client.ReadOriginalV1(context.Background(), test.input, grpc.Header(&header))
 		s, ok := status.FromError(err)
 		require.True(t, ok)
 		require.Equal(t, test.statusCode, s.Code())
+		// reading saves nothing, so no user is registered and no jwt is sent back
+		require.Empty(t, header.Get("auth"))
 	}
 
+	jwt, err := testTokens.Issue(1)
+	require.NoError(t, err)
+
+	// original URLs are deduplicated per user, so requests without jwt (which are made by new users) always reach the repository
 	testTableCreateShortened := []struct {
 		input      *api.CreateShortenedRequestV1
 		statusCode codes.Code
 		randSeed   string
+		jwt        string
 	}{
-		{&api.CreateShortenedRequestV1{Original: "cba"}, codes.OK, ""},
-		{&api.CreateShortenedRequestV1{Original: "cba"}, codes.InvalidArgument, "ab"},
-		{&api.CreateShortenedRequestV1{Original: "cba"}, codes.OK, "0"},
-		{&api.CreateShortenedRequestV1{Original: "cba"}, codes.AlreadyExists, ""},
-		{&api.CreateShortenedRequestV1{Original: "cba"}, codes.Internal, ""},
+		{&api.CreateShortenedRequestV1{Original: "cba"}, codes.OK, "", jwt},
+		{&api.CreateShortenedRequestV1{Original: "cba"}, codes.InvalidArgument, "ab", ""},
+		{&api.CreateShortenedRequestV1{Original: "cba"}, codes.OK, "0", ""},
+		{&api.CreateShortenedRequestV1{Original: "cba"}, codes.AlreadyExists, "", ""},
+		{&api.CreateShortenedRequestV1{Original: "cba"}, codes.Internal, "", ""},
 	}
 
 	for _, test := range testTableCreateShortened {
@@ -136,22 +131,29 @@ func TestGRPC(t *testing.T) {
 			ctx = metadata.NewOutgoingContext(ctx, md)
 		}
 
-		_, err := client.CreateShortenedV1(ctx, test.input)
+		if test.jwt != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "auth", test.jwt)
+		}
+
+		var header metadata.MD
+		_, err := client.CreateShortenedV1(ctx, test.input, grpc.Header(&header))
 		s, ok := status.FromError(err)
 		require.True(t, ok)
 		require.Equal(t, test.statusCode, s.Code())
+		require.Len(t, header.Get("auth"), 1)
 	}
 
 	testTableCreateShortenedFromBatch := []struct {
 		input      *api.CreateShortenedFromBatchRequestV1
 		statusCode codes.Code
 		randSeed   string
+		jwt        string
 	}{
-		{&api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{{Original: "cba", Correlation: "123"}}}, codes.OK, ""},
-		{&api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{{Original: "c", Correlation: "123"}}}, codes.Internal, ""},
-		{&api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{{Original: "b", Correlation: "123"}}}, codes.OK, ""},
-		{&api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{{Original: "a", Correlation: "123"}}}, codes.InvalidArgument, "a"},
-		{&api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{{Original: "a", Correlation: "123"}}}, codes.OK, "0"},
+		{&api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{{Original: "cba", Correlation: "123"}}}, codes.OK, "", jwt},
+		{&api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{{Original: "c", Correlation: "123"}}}, codes.Internal, "", ""},
+		{&api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{{Original: "b", Correlation: "123"}}}, codes.OK, "", ""},
+		{&api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{{Original: "a", Correlation: "123"}}}, codes.InvalidArgument, "a", ""},
+		{&api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{{Original: "a", Correlation: "123"}}}, codes.OK, "0", ""},
 	}
 
 	for _, test := range testTableCreateShortenedFromBatch {
@@ -161,15 +163,16 @@ func TestGRPC(t *testing.T) {
 			ctx = metadata.NewOutgoingContext(ctx, md)
 		}
 
+		if test.jwt != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "auth", test.jwt)
+		}
+
 		_, err := client.CreateShortenedFromBatchV1(ctx, test.input)
 		s, ok := status.FromError(err)
 		require.True(t, ok)
 		require.Equal(t, test.statusCode, s.Code())
 	}
 
-	jwt, _, err := middleware.BuildJWTString("abc")
-	require.NoError(t, err)
-
 	testTableReadUserURLs := []struct {
 		statusCode codes.Code
 		jwt        string
@@ -219,4 +222,28 @@ func TestGRPC(t *testing.T) {
 		require.True(t, ok)
 		require.Equal(t, test.statusCode, s.Code())
 	}
+
+	testTableReadURLStats := []struct {
+		input      *api.ReadURLStatsRequestV1
+		statusCode codes.Code
+		jwt        string
+	}{
+		{&api.ReadURLStatsRequestV1{Shortened: "cba"}, codes.OK, jwt},
+		{&api.ReadURLStatsRequestV1{Shortened: "cba"}, codes.Unauthenticated, ""},
+		{&api.ReadURLStatsRequestV1{Shortened: "abc"}, codes.NotFound, jwt},
+		{&api.ReadURLStatsRequestV1{Shortened: "cba", Bucket: durationpb.New(time.Second)}, codes.InvalidArgument, jwt},
+		{&api.ReadURLStatsRequestV1{}, codes.InvalidArgument, jwt},
+	}
+
+	for _, test := range testTableReadURLStats {
+		ctx := context.Background()
+		if test.jwt != "" {
+			md := metadata.Pairs("auth", test.jwt)
+			ctx = metadata.NewOutgoingContext(ctx, md)
+		}
+		_, err := client.ReadURLStatsV1(ctx, test.input)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, test.statusCode, s.Code())
+	}
 }
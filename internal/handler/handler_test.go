@@ -11,17 +11,21 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/PoorMercymain/urlshrt/internal/auth"
 	"github.com/PoorMercymain/urlshrt/internal/domain"
 	"github.com/PoorMercymain/urlshrt/internal/domain/mocks"
+	"github.com/PoorMercymain/urlshrt/internal/keyring"
 	"github.com/PoorMercymain/urlshrt/internal/middleware"
 	"github.com/PoorMercymain/urlshrt/internal/repository"
 	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
 	"github.com/PoorMercymain/urlshrt/internal/state"
 	"github.com/PoorMercymain/urlshrt/pkg/util"
 )
@@ -35,7 +39,7 @@ func testRequest(t *testing.T, ts *httptest.Server, code int, body, method, path
 		util.GetLogger().Infoln(req)
 		if body != "no_jwt" {
 			var jwt string
-			jwt, _, err = middleware.BuildJWTString("abc")
+			jwt, err = testTokens.Issue(1)
 			require.NoError(t, err)
 			cookie := &http.Cookie{Name: "auth", Value: jwt}
 			req.AddCookie(cookie)
@@ -119,9 +123,9 @@ func router(t *testing.T) chi.Router {
 	ur.EXPECT().Create(gomock.Any(), gomock.Any()).Return("", domain.NewUniqueError(errors.New(""))).MaxTimes(2)
 	ur.EXPECT().Create(gomock.Any(), gomock.Any()).Return("", errors.New("")).MaxTimes(2)
 	ur.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(errors.New("")).MaxTimes(1)
-	ur.EXPECT().ReadUserURLs(gomock.Any()).Return([]state.URLStringJSON{}, errors.New("")).MaxTimes(1)
-	ur.EXPECT().ReadUserURLs(gomock.Any()).Return([]state.URLStringJSON{}, nil).MaxTimes(1)
-	ur.EXPECT().ReadUserURLs(gomock.Any()).Return([]state.URLStringJSON{{UUID: 1, OriginalURL: "abc", ShortURL: "cba"}}, nil).MaxTimes(1)
+	ur.EXPECT().ReadUserURLs(gomock.Any(), gomock.Any()).Return([]state.URLStringJSON{}, errors.New("")).MaxTimes(1)
+	ur.EXPECT().ReadUserURLs(gomock.Any(), gomock.Any()).Return([]state.URLStringJSON{}, nil).MaxTimes(1)
+	ur.EXPECT().ReadUserURLs(gomock.Any(), gomock.Any()).Return([]state.URLStringJSON{{UUID: 1, OriginalURL: "abc", ShortURL: "cba"}}, nil).MaxTimes(1)
 	ur.EXPECT().CountURLsAndUsers(gomock.Any()).Return(0, 0, errors.New("")).MaxTimes(1)
 	ur.EXPECT().CountURLsAndUsers(gomock.Any()).Return(1, 1, nil).MaxTimes(1)
 	ur.EXPECT().IsURLDeleted(gomock.Any(), gomock.Any()).Return(true, nil).MaxTimes(1)
@@ -144,30 +148,16 @@ func router(t *testing.T) chi.Router {
 	pg := &state.Postgres{}
 
 	ure := repository.NewURL("", pg)
-	us := service.NewURL(ur)
-	use := service.NewURL(ure)
+	store := state.NewStore(urls)
+	us := service.NewURL(ur, store, shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	use := service.NewURL(ure, store, shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
 	uh := NewURL(us)
 	uha := NewURL(use)
+	dh := NewDeletion(service.NewDeletion(ur, repository.NewMemory(), 10, time.Second, time.Hour))
 
-	urlsMap := make(map[string]state.URLStringJSON)
-	for _, u := range urls {
-		urlsMap[u.OriginalURL] = u
-	}
-
-	util.GetLogger().Infoln(urlsMap)
-	state.InitCurrentURLs(&urlsMap)
 	state.InitShortAddress(host)
 
-	u, err := state.GetCurrentURLsPtr()
-	if err != nil {
-		util.GetLogger().Infoln(err)
-	}
-	util.GetLogger().Infoln(u.Urls)
-
 	var wg sync.WaitGroup
-	ch := make(chan domain.URLWithID)
-	mc := domain.NewMutexChanString(ch)
-	var once sync.Once
 
 	r.Get("/ping", WrapHandler(uh.PingPg))
 	r.Post("/", WrapHandler(uha.CreateShortened /*, fmem*/))
@@ -179,14 +169,26 @@ func router(t *testing.T) chi.Router {
 	r.Post("/batch/", WrapHandler(uh.CreateShortenedFromBatchAdapter(&wg)))
 	r.Get("/read/", WrapHandler(uh.ReadUserURLs))
 	r.Get("/stats/", WrapHandler(uh.ReadAmountOfURLsAndUsers))
-	r.Delete("/delete/", WrapHandler(uh.DeleteUserURLsAdapter(mc, &once, &wg)))
+	r.Delete("/delete/", WrapHandler(dh.DeleteUserURLs))
 	r.Get("/read-orig/", WrapHandler(uh.ReadOriginal))
 
 	return r
 }
 
+// testTokens issues and checks JWTs of all the handler tests.
+var testTokens = auth.NewJWT(keyring.NewHMAC("abc"), time.Hour)
+
+// testUsers keeps users of all the handler tests and their API keys.
+var testUsers = repository.NewMemory()
+
+// testAPIKeys manages API keys of all the handler tests.
+var testAPIKeys = service.NewAPIKey(testUsers)
+
+// testAuthenticator is shared by all the handler tests, so ids of users are unique among them.
+var testAuthenticator = auth.NewAuthenticator(testTokens, testTokens, service.NewUser(testUsers), testAPIKeys)
+
 func WrapHandler(h http.HandlerFunc /*, fmem *os.File*/) http.HandlerFunc {
-	return middleware.GzipHandle(middleware.Authorize(middleware.WithLogging(h /*, fmem*/), "abc"))
+	return middleware.GzipHandle(middleware.Authorize(middleware.WithLogging(h /*, fmem*/), testAuthenticator))
 }
 
 func TestRouter(t *testing.T) {
@@ -342,27 +344,16 @@ func benchmarkRouter(b *testing.B) chi.Router {
 	ur.EXPECT().Create(gomock.Any(), gomock.Any()).Return("", nil).AnyTimes()
 	ur.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	ur.EXPECT().IsURLDeleted(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
-	ur.EXPECT().DeleteUserURLs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ur.EXPECT().DeleteUserURLs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	ur.EXPECT().ReadAll(gomock.Any()).Return(make([]state.URLStringJSON, 0), nil).AnyTimes()
-	ur.EXPECT().ReadUserURLs(gomock.Any()).Return(make([]state.URLStringJSON, 0), nil).AnyTimes()
+	ur.EXPECT().ReadUserURLs(gomock.Any(), gomock.Any()).Return(make([]state.URLStringJSON, 0), nil).AnyTimes()
 
-	us := service.NewURL(ur)
+	us := service.NewURL(ur, state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
 	uh := NewURL(us)
+	dh := NewDeletion(service.NewDeletion(ur, repository.NewMemory(), 10, time.Second, time.Hour))
 
-	urlsMap := make(map[string]state.URLStringJSON)
-
-	util.GetLogger().Infoln(urlsMap)
-	state.InitCurrentURLs(&urlsMap)
 	state.InitShortAddress(host)
 
-	u, err := state.GetCurrentURLsPtr()
-	if err != nil {
-		util.GetLogger().Infoln(err)
-	}
-	util.GetLogger().Infoln(u.Urls)
-
-	shortURLsChan := domain.NewMutexChanString(make(chan domain.URLWithID, 10))
-	var once sync.Once
 	var wg sync.WaitGroup
 
 	r.Post("/", WrapHandler(uh.CreateShortened))
@@ -370,7 +361,7 @@ func benchmarkRouter(b *testing.B) chi.Router {
 	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
 	r.Post("/api/shorten/batch", WrapHandler(uh.CreateShortenedFromBatchAdapter(&wg)))
 	r.Get("/api/user/urls", WrapHandler(uh.ReadUserURLs))
-	r.Delete("/api/user/urls", WrapHandler(uh.DeleteUserURLsAdapter(shortURLsChan, &once, &wg)))
+	r.Delete("/api/user/urls", WrapHandler(dh.DeleteUserURLs))
 
 	return r
 }
@@ -560,9 +551,9 @@ func GetExampleMockRepo() *mocks.MockURLRepository {
 	ur.EXPECT().Create(gomock.Any(), gomock.Any()).Return("", nil).AnyTimes()
 	ur.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	ur.EXPECT().IsURLDeleted(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
-	ur.EXPECT().DeleteUserURLs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ur.EXPECT().DeleteUserURLs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	ur.EXPECT().ReadAll(gomock.Any()).Return(make([]state.URLStringJSON, 0), nil).AnyTimes()
-	ur.EXPECT().ReadUserURLs(gomock.Any()).Return(make([]state.URLStringJSON, 0), nil).AnyTimes()
+	ur.EXPECT().ReadUserURLs(gomock.Any(), gomock.Any()).Return(make([]state.URLStringJSON, 0), nil).AnyTimes()
 
 	return ur
 }
@@ -580,15 +571,27 @@ func GetExampleMockSrv() *mocks.MockURLService {
 	usj := make([]state.URLStringJSON, 1)
 	usj = append(usj, state.URLStringJSON{UUID: 1, ShortURL: "http://localhost:8080/GqKWdrE", OriginalURL: "https://ya.ru"})
 
-	us.EXPECT().CreateShortened(gomock.Any(), gomock.Any()).Return("GqKWdrE", nil).AnyTimes()
+	us.EXPECT().CreateShortened(gomock.Any(), gomock.Any(), gomock.Any()).Return("GqKWdrE", nil).AnyTimes()
 	us.EXPECT().ReadOriginal(gomock.Any(), gomock.Any(), gomock.Any()).Return("https://ya.ru", nil).AnyTimes()
 	us.EXPECT().CreateShortenedFromBatch(gomock.Any(), gomock.Any(), gomock.Any()).Return(ber, nil).AnyTimes()
 	us.EXPECT().ReadUserURLs(gomock.Any()).Return(usj, nil).AnyTimes()
-	us.EXPECT().DeleteUserURLs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return().AnyTimes()
+	us.EXPECT().ReadUserURLsPage(gomock.Any(), gomock.Any()).Return(domain.URLPage{URLs: usj}, nil).AnyTimes()
 
 	return us
 }
 
+func GetExampleMockDeletionSrv() *mocks.MockDeletionService {
+	var tr testReporter
+	ctrl := gomock.NewController(tr)
+	defer ctrl.Finish()
+
+	ds := mocks.NewMockDeletionService(ctrl)
+
+	ds.EXPECT().DeleteUserURLs(gomock.Any(), gomock.Any()).Return("0123456789abcdef", nil).AnyTimes()
+
+	return ds
+}
+
 /*func exampleRouter() chi.Router {
 	r := chi.NewRouter()
 
@@ -607,25 +610,15 @@ func GetExampleMockSrv() *mocks.MockURLService {
 	ur.EXPECT().Create(gomock.Any(), gomock.Any()).Return("", nil).AnyTimes()
 	ur.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	ur.EXPECT().IsURLDeleted(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
-	ur.EXPECT().DeleteUserURLs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ur.EXPECT().DeleteUserURLs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	ur.EXPECT().ReadAll(gomock.Any()).Return(make([]state.URLStringJSON, 0), nil).AnyTimes()
-	ur.EXPECT().ReadUserURLs(gomock.Any()).Return(make([]state.URLStringJSON, 0), nil).AnyTimes()
+	ur.EXPECT().ReadUserURLs(gomock.Any(), gomock.Any()).Return(make([]state.URLStringJSON, 0), nil).AnyTimes()
 
-	us := service.NewURL(ur)
+	us := service.NewURL(ur, state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
 	uh := NewURL(us)
 
-	urlsMap := make(map[string]state.URLStringJSON)
-
-	util.GetLogger().Infoln(urlsMap)
-	state.InitCurrentURLs(&urlsMap)
 	state.InitShortAddress(host)
 
-	u, err := state.GetCurrentURLsPtr()
-	if err != nil {
-		util.GetLogger().Infoln(err)
-	}
-	util.GetLogger().Infoln(u.Urls)
-
 	shortURLsChan := domain.NewMutexChanString(make(chan domain.URLWithID, 10))
 	var once sync.Once
 
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/PoorMercymain/urlshrt/pkg/api"
+)
+
+// newClient is a function to get HTTP client which keeps its cookies (so it stays the same user) and doesn't follow redirects.
+func newClient(t *testing.T) *http.Client {
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+
+	return &http.Client{
+		Jar: jar,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// startGRPC is a function to serve gRPC server on a free port until the end of the test and to get the client connected to it.
+func startGRPC(t *testing.T, grpcServer *grpc.Server) api.UrlshrtV1Client {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	go func() {
+		require.NoError(t, grpcServer.Serve(listener))
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+
+	return api.NewUrlshrtV1Client(conn)
+}
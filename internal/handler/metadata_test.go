@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/interceptor"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/api"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestURLMetadata(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	us := service.NewURL(repository.NewMemory(), state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	uh := NewURL(us)
+
+	var wg sync.WaitGroup
+	r := chi.NewRouter()
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+	r.Post("/api/shorten/batch", WrapHandler(uh.CreateShortenedFromBatchAdapter(&wg)))
+	r.Get("/api/user/urls", WrapHandler(uh.ReadUserURLs))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	client := newClient(t)
+
+	var testTable = []struct {
+		path   string
+		body   string
+		status int
+	}{
+		{"/api/shorten", "{\"url\":\"https://ya.ru\",\"title\":\"Yandex\",\"note\":\"search\",\"tags\":[\" Work \",\"search\",\"work\"]}", http.StatusCreated},
+		{"/api/shorten/batch", "[{\"correlation_id\":\"1\",\"original_url\":\"https://mail.ru\",\"title\":\"Mail\"}]", http.StatusCreated},
+		{"/api/shorten", "{\"url\":\"https://hh.ru\",\"tags\":[\" \"]}", http.StatusBadRequest},
+		{"/api/shorten", "{\"url\":\"https://hh.ru\",\"title\":\"" + strings.Repeat("a", 257) + "\"}", http.StatusBadRequest},
+		{"/api/shorten/batch", "[{\"correlation_id\":\"1\",\"original_url\":\"https://hh.ru\",\"tags\":[\"" + strings.Repeat("a", 65) + "\"]}]", http.StatusBadRequest},
+	}
+
+	for _, testCase := range testTable {
+		resp, err := client.Post(ts.URL+testCase.path, "application/json", strings.NewReader(testCase.body))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, testCase.status, resp.StatusCode, testCase.body)
+	}
+
+	resp, err := client.Get(ts.URL + "/api/user/urls")
+	require.NoError(t, err)
+
+	var urls []domain.UserOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&urls))
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, urls, 2)
+
+	require.NotNil(t, urls[0].CreatedAt)
+	require.Nil(t, urls[0].UpdatedAt)
+	require.Equal(t, "Yandex", urls[0].Title)
+	require.Equal(t, "search", urls[0].Note)
+	require.Equal(t, []string{"search", "work"}, urls[0].Tags)
+
+	require.NotNil(t, urls[1].CreatedAt)
+	require.Equal(t, "Mail", urls[1].Title)
+	require.Empty(t, urls[1].Tags)
+}
+
+func TestGRPCURLMetadata(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Authorize(testAuthenticator), interceptor.ValidateRequest))
+
+	us := service.NewURL(repository.NewMemory(), state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	api.RegisterUrlshrtV1Server(grpcServer, &Server{Wg: &sync.WaitGroup{}, Srv: us})
+
+	client := startGRPC(t, grpcServer)
+
+	jwt, err := testTokens.Issue(2)
+	require.NoError(t, err)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "auth", jwt)
+
+	_, err = client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: "https://ya.ru", Title: "Yandex", Tags: []string{"Work"}})
+	require.NoError(t, err)
+
+	_, err = client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: "https://mail.ru", Tags: []string{""}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CreateShortenedFromBatchV1(ctx, &api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{
+		{Original: "https://mail.ru", Correlation: "1", Tags: []string{" "}}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	reply, err := client.ReadUserURLsV1(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, reply.OriginalWithShortened, 1)
+
+	url := reply.OriginalWithShortened[0]
+	require.NotNil(t, url.CreatedAt)
+	require.Nil(t, url.UpdatedAt)
+	require.Equal(t, "Yandex", url.Title)
+	require.Equal(t, []string{"work"}, url.Tags)
+}
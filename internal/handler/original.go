@@ -1,6 +1,14 @@
 package handler
 
+import "time"
+
 // OriginalURL is a type to represent URL in JSON.
 type OriginalURL struct {
-	URL string `json:"url"`
+	URL       string     `json:"url"`
+	Alias     string     `json:"alias,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	NotBefore *time.Time `json:"not_before,omitempty"`
+	Title     string     `json:"title,omitempty"`
+	Note      string     `json:"note,omitempty"`
+	Tags      []string   `json:"tags,omitempty"`
 }
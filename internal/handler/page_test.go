@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/interceptor"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/api"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestReadUserURLsPage(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	us := service.NewURL(repository.NewMemory(), state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	uh := NewURL(us)
+
+	r := chi.NewRouter()
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+	r.Get("/api/user/urls", WrapHandler(uh.ReadUserURLs))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	client := newClient(t)
+
+	for _, original := range []string{"https://ya.ru", "https://mail.ru", "https://music.ya.ru"} {
+		resp, err := client.Post(ts.URL+"/api/shorten", "application/json", strings.NewReader("{\"url\":\""+original+"\"}"))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	read := func(path string) (int, []domain.UserOutput, string) {
+		resp, err := client.Get(ts.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var urls []domain.UserOutput
+		if resp.StatusCode == http.StatusOK {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&urls))
+		} else {
+			_, err = io.Copy(io.Discard, resp.Body)
+			require.NoError(t, err)
+		}
+
+		return resp.StatusCode, urls, resp.Header.Get("Link")
+	}
+
+	code, urls, link := read("/api/user/urls?limit=2")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, urls, 2)
+	require.Equal(t, "https://ya.ru", urls[0].OriginalURL)
+	require.True(t, strings.HasPrefix(link, "</api/user/urls?"), link)
+	require.True(t, strings.HasSuffix(link, ">; rel=\"next\""), link)
+
+	code, urls, link = read(strings.TrimSuffix(strings.TrimPrefix(link, "<"), ">; rel=\"next\""))
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, urls, 1)
+	require.Equal(t, "https://music.ya.ru", urls[0].OriginalURL)
+	require.Empty(t, link)
+
+	code, urls, _ = read("/api/user/urls?order=desc&domain=ya.ru")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, urls, 2)
+	require.Equal(t, "https://music.ya.ru", urls[0].OriginalURL)
+
+	code, _, _ = read("/api/user/urls?contains=go.dev")
+	require.Equal(t, http.StatusNoContent, code)
+
+	for _, query := range []string{"?limit=0", "?limit=ten", "?limit=1001", "?order=up", "?cursor=nope"} {
+		code, _, _ = read("/api/user/urls" + query)
+		require.Equal(t, http.StatusBadRequest, code, query)
+	}
+
+	// user without cookie is unauthorized even if the query is invalid or matches nothing
+	for _, query := range []string{"", "?contains=go.dev", "?limit=0"} {
+		resp, err := http.Get(ts.URL + "/api/user/urls" + query)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode, query)
+	}
+}
+
+func TestGRPCStreamUserURLs(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Authorize(testAuthenticator), interceptor.ValidateRequest),
+		grpc.ChainStreamInterceptor(interceptor.AuthorizeStream(testAuthenticator), interceptor.ValidateStream))
+
+	us := service.NewURL(repository.NewMemory(), state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	api.RegisterUrlshrtV1Server(grpcServer, &Server{Wg: &sync.WaitGroup{}, Srv: us})
+
+	client := startGRPC(t, grpcServer)
+
+	jwt, err := testTokens.Issue(2)
+	require.NoError(t, err)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "auth", jwt)
+
+	for _, original := range []string{"https://ya.ru", "https://mail.ru", "https://music.ya.ru"} {
+		_, err = client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: original})
+		require.NoError(t, err)
+	}
+
+	receive := func(ctx context.Context, req *api.StreamUserURLsRequestV1) ([]string, error) {
+		stream, err := client.StreamUserURLsV1(ctx, req)
+		require.NoError(t, err)
+
+		var originals []string
+		for {
+			reply, err := stream.Recv()
+			if err == io.EOF {
+				return originals, nil
+			} else if err != nil {
+				return originals, err
+			}
+			originals = append(originals, reply.Original)
+		}
+	}
+
+	originals, err := receive(ctx, &api.StreamUserURLsRequestV1{Order: api.SortOrderV1_SORT_ORDER_V1_DESC, Domain: "ya.ru"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://music.ya.ru", "https://ya.ru"}, originals)
+
+	originals, err = receive(ctx, &api.StreamUserURLsRequestV1{Contains: "mail"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://mail.ru"}, originals)
+
+	_, err = receive(ctx, &api.StreamUserURLsRequestV1{Order: api.SortOrderV1(42)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = receive(context.Background(), &api.StreamUserURLsRequestV1{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
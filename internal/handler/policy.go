@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+type Policy struct {
+	srv domain.PolicyService
+}
+
+// NewPolicy creates object to operate handler functions of rules of destinations.
+func NewPolicy(srv domain.PolicyService) *Policy {
+	return &Policy{srv: srv}
+}
+
+// Enforce - handler to reload rules of destinations and disable saved URLs which match them, disabled short URLs are sent in response.
+func (h *Policy) Enforce(w http.ResponseWriter, r *http.Request) {
+	disabled, err := h.srv.Enforce(r.Context())
+	if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	addr := state.GetBaseShortAddress()
+	if addr[len(addr)-1] != '/' {
+		addr = addr + "/"
+	}
+
+	for i, short := range disabled {
+		disabled[i] = addr + short
+	}
+
+	writeJSON(w, http.StatusOK, struct {
+		Disabled []string `json:"disabled"`
+	}{
+		Disabled: disabled,
+	})
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/PoorMercymain/urlshrt/internal/canonical"
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/interceptor"
+	"github.com/PoorMercymain/urlshrt/internal/policy"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/api"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestPolicy(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, policy.BlocklistFile), []byte("evil.com\n"), 0600))
+
+	engine, err := policy.New(dir)
+	require.NoError(t, err)
+
+	repo := repository.NewMemory()
+	store := state.NewStore(nil)
+	us := service.NewURL(repo, store, shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength),
+		canonical.New(nil, false, false), engine, domain.DedupPerUser)
+	uh := NewURL(us)
+	ph := NewPolicy(service.NewPolicy(repo, store, engine))
+
+	var wg sync.WaitGroup
+	r := chi.NewRouter()
+	r.Post("/", WrapHandler(uh.CreateShortened))
+	r.Get("/{short}", WrapHandler(uh.ReadOriginal))
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+	r.Post("/api/shorten/batch", WrapHandler(uh.CreateShortenedFromBatchAdapter(&wg)))
+	r.Patch("/api/user/urls/{short}", WrapHandler(uh.UpdateShortened))
+	r.Post("/api/internal/policy/enforce", WrapHandler(ph.Enforce))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	client := newClient(t)
+
+	send := func(method string, path string, contentType string, body string) (int, string) {
+		req, err := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp.StatusCode, string(respBody)
+	}
+
+	code, short := send(http.MethodPost, "/", "text/plain", "https://bad.org/page")
+	require.Equal(t, http.StatusCreated, code)
+	short = strings.TrimPrefix(short, "http://localhost:8080/")
+
+	code, _ = send(http.MethodPost, "/", "text/plain", "https://good.org/")
+	require.Equal(t, http.StatusCreated, code)
+
+	var testTable = []struct {
+		method      string
+		path        string
+		contentType string
+		body        string
+		status      int
+	}{
+		{http.MethodPost, "/", "text/plain", "https://EVIL.com/", http.StatusForbidden},
+		{http.MethodPost, "/api/shorten", "application/json", "{\"url\":\"https://www.evil.com/login\"}", http.StatusForbidden},
+		{http.MethodPost, "/api/shorten/batch", "application/json", "[{\"correlation_id\":\"1\",\"original_url\":\"https://evil.com\"}]", http.StatusForbidden},
+		{http.MethodPatch, "/api/user/urls/" + short, "application/json", "{\"url\":\"https://evil.com/\"}", http.StatusForbidden},
+		{http.MethodGet, "/" + short, "text/plain", "", http.StatusTemporaryRedirect},
+	}
+
+	for _, testCase := range testTable {
+		code, _ := send(testCase.method, testCase.path, testCase.contentType, testCase.body)
+		require.Equal(t, testCase.status, code, testCase.path+" "+testCase.body)
+	}
+
+	// the URL which was saved before its domain was blocked stops redirecting after enforcement
+	require.NoError(t, os.WriteFile(filepath.Join(dir, policy.BlocklistFile), []byte("evil.com\nbad.org\n"), 0600))
+
+	code, body := send(http.MethodPost, "/api/internal/policy/enforce", "application/json", "")
+	require.Equal(t, http.StatusOK, code)
+
+	var enforced struct {
+		Disabled []string `json:"disabled"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(body), &enforced))
+	require.Equal(t, []string{"http://localhost:8080/" + short}, enforced.Disabled)
+
+	code, _ = send(http.MethodGet, "/"+short, "text/plain", "")
+	require.Equal(t, http.StatusGone, code)
+
+	code, body = send(http.MethodPost, "/api/internal/policy/enforce", "application/json", "")
+	require.Equal(t, http.StatusOK, code)
+	require.JSONEq(t, "{\"disabled\":[]}", body)
+}
+
+func TestGRPCPolicy(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, policy.RulesFile), []byte("/casino/\n"), 0600))
+
+	engine, err := policy.New(dir)
+	require.NoError(t, err)
+
+	repo := repository.NewMemory()
+	store := state.NewStore(nil)
+	us := service.NewURL(repo, store, shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength),
+		canonical.New(nil, false, false), engine, domain.DedupPerUser)
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Authorize(testAuthenticator), interceptor.CheckCIDR("127.0.0.1/32"),
+		interceptor.ValidateRequest))
+	api.RegisterUrlshrtV1Server(grpcServer, &Server{Wg: &sync.WaitGroup{}, Srv: us, PolicySrv: service.NewPolicy(repo, store, engine)})
+
+	client := startGRPC(t, grpcServer)
+
+	jwt, err := testTokens.Issue(2)
+	require.NoError(t, err)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "auth", jwt)
+
+	created, err := client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: "https://example.com/games/"})
+	require.NoError(t, err)
+	short := strings.TrimPrefix(created.Shortened, "http://localhost:8080/")
+
+	_, err = client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: "https://example.com/casino/"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.CreateShortenedFromBatchV1(ctx, &api.CreateShortenedFromBatchRequestV1{Original: []*api.OriginalWithCorrelationV1{
+		{Original: "https://example.com/casino/", Correlation: "1"}}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.UpdateShortenedV1(ctx, &api.UpdateShortenedRequestV1{Shortened: short, Original: "https://example.com/casino/"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, policy.RulesFile), []byte("/casino/\n/games/\n"), 0600))
+
+	reply, err := client.EnforcePolicyV1(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, []string{created.Shortened}, reply.Disabled)
+
+	_, err = client.ReadOriginalV1(ctx, &api.ReadOriginalRequestV1{Shortened: short})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+type Tag struct {
+	srv domain.TagService
+}
+
+// NewTag creates object to operate handler functions of tags of URLs.
+func NewTag(srv domain.TagService) *Tag {
+	return &Tag{srv: srv}
+}
+
+// AddTags - handler to add tags from JSON array to URL of the user, all tags of the URL are sent in response.
+func (h *Tag) AddTags(w http.ResponseWriter, r *http.Request) {
+	h.updateTags(w, r, h.srv.AddTags)
+}
+
+// RemoveTags - handler to remove tags from JSON array from URL of the user, tags which are left are sent in response.
+func (h *Tag) RemoveTags(w http.ResponseWriter, r *http.Request) {
+	h.updateTags(w, r, h.srv.RemoveTags)
+}
+
+// updateTags changes tags of URL of the user with update, tags are read from JSON array.
+func (h *Tag) updateTags(w http.ResponseWriter, r *http.Request, update func(ctx context.Context, shortened string, tags []string) ([]string, error)) {
+	if identity, _ := domain.IdentityFromContext(r.Context()); identity.New {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if !IsJSONContentTypeCorrect(r) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	tags := make([]string, 0, 1)
+	if err := json.NewDecoder(r.Body).Decode(&tags); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tags, err := update(r.Context(), chi.URLParam(r, "short"), tags)
+	if errors.Is(err, domain.ErrInvalidMetadata) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if errors.Is(err, domain.ErrURLNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	} else if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tags)
+}
+
+// ReadTags - handler to get tags of URLs of the user with amounts of URLs which have them.
+func (h *Tag) ReadTags(w http.ResponseWriter, r *http.Request) {
+	if identity, _ := domain.IdentityFromContext(r.Context()); identity.New {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	tags, err := h.srv.ReadTags(r.Context())
+	if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tags)
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/interceptor"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/api"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestTags(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	repo := repository.NewMemory()
+	us := service.NewURL(repo, state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	uh := NewURL(us)
+	th := NewTag(service.NewTag(repo))
+
+	r := chi.NewRouter()
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+	r.Get("/api/user/urls", WrapHandler(uh.ReadUserURLs))
+	r.Post("/api/user/urls/{short}/tags", WrapHandler(th.AddTags))
+	r.Delete("/api/user/urls/{short}/tags", WrapHandler(th.RemoveTags))
+	r.Get("/api/user/tags", WrapHandler(th.ReadTags))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	owner, stranger := newClient(t), newClient(t)
+
+	for _, created := range []struct {
+		client *http.Client
+		body   string
+	}{
+		{owner, "{\"url\":\"https://ya.ru\",\"alias\":\"tagged\"}"},
+		{owner, "{\"url\":\"https://mail.ru\",\"alias\":\"other\",\"tags\":[\"work\"]}"},
+		{stranger, "{\"url\":\"https://hh.ru\",\"alias\":\"strangers\"}"},
+	} {
+		resp, err := created.client.Post(ts.URL+"/api/shorten", "application/json", strings.NewReader(created.body))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	update := func(client *http.Client, method string, short string, body string) (int, []string) {
+		req, err := http.NewRequest(method, ts.URL+"/api/user/urls/"+short+"/tags", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var tags []string
+		if resp.StatusCode == http.StatusOK {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&tags))
+		}
+
+		return resp.StatusCode, tags
+	}
+
+	var testTable = []struct {
+		client *http.Client
+		method string
+		short  string
+		body   string
+		status int
+		tags   []string
+	}{
+		{owner, http.MethodPost, "tagged", "[\"Work\",\"news\"]", http.StatusOK, []string{"news", "work"}},
+		{owner, http.MethodPost, "tagged", "[\"personal\"]", http.StatusOK, []string{"news", "personal", "work"}},
+		{owner, http.MethodDelete, "tagged", "[\"NEWS\"]", http.StatusOK, []string{"personal", "work"}},
+		{owner, http.MethodPost, "tagged", "[]", http.StatusBadRequest, nil},
+		{owner, http.MethodPost, "tagged", "[\"#hash\"]", http.StatusBadRequest, nil},
+		{owner, http.MethodPost, "tagged", "{", http.StatusBadRequest, nil},
+		{owner, http.MethodPost, "nope", "[\"work\"]", http.StatusNotFound, nil},
+		{stranger, http.MethodPost, "tagged", "[\"work\"]", http.StatusNotFound, nil},
+		{newClient(t), http.MethodPost, "tagged", "[\"work\"]", http.StatusUnauthorized, nil},
+	}
+
+	for _, testCase := range testTable {
+		code, tags := update(testCase.client, testCase.method, testCase.short, testCase.body)
+		require.Equal(t, testCase.status, code, testCase.body)
+		require.Equal(t, testCase.tags, tags, testCase.body)
+	}
+
+	resp, err := owner.Get(ts.URL + "/api/user/tags")
+	require.NoError(t, err)
+
+	var counts []domain.TagCount
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&counts))
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []domain.TagCount{{Tag: "personal", Count: 1}, {Tag: "work", Count: 2}}, counts)
+
+	resp, err = owner.Get(ts.URL + "/api/user/urls?tag=Personal")
+	require.NoError(t, err)
+
+	var urls []domain.UserOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&urls))
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, urls, 1)
+	require.Equal(t, "http://localhost:8080/tagged", urls[0].ShortURL)
+	require.Equal(t, []string{"personal", "work"}, urls[0].Tags)
+}
+
+func TestGRPCTags(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Authorize(testAuthenticator), interceptor.ValidateRequest))
+
+	repo := repository.NewMemory()
+	us := service.NewURL(repo, state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	api.RegisterUrlshrtV1Server(grpcServer, &Server{Wg: &sync.WaitGroup{}, Srv: us, TagSrv: service.NewTag(repo)})
+
+	client := startGRPC(t, grpcServer)
+
+	jwt, err := testTokens.Issue(2)
+	require.NoError(t, err)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "auth", jwt)
+
+	_, err = client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: "https://ya.ru", Alias: "grpc-tagged"})
+	require.NoError(t, err)
+
+	reply, err := client.AddTagsV1(ctx, &api.UpdateTagsRequestV1{Shortened: "grpc-tagged", Tags: []string{"Work", "news"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"news", "work"}, reply.Tags)
+
+	reply, err = client.RemoveTagsV1(ctx, &api.UpdateTagsRequestV1{Shortened: "grpc-tagged", Tags: []string{"news"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"work"}, reply.Tags)
+
+	for _, req := range []*api.UpdateTagsRequestV1{
+		{Shortened: "grpc-tagged"},
+		{Shortened: "grpc-tagged", Tags: []string{"#hash"}},
+		{Shortened: "grpc-tagged", Tags: []string{strings.Repeat("a", 65)}},
+	} {
+		_, err = client.AddTagsV1(ctx, req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), req.Tags)
+	}
+
+	_, err = client.AddTagsV1(ctx, &api.UpdateTagsRequestV1{Shortened: "nope", Tags: []string{"work"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	counts, err := client.ReadTagsV1(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, counts.Tags, 1)
+	require.Equal(t, "work", counts.Tags[0].Tag)
+	require.Equal(t, int64(1), counts.Tags[0].Count)
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/interceptor"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/api"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestUpdateShortened(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	us := service.NewURL(repository.NewMemory(), state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	uh := NewURL(us)
+
+	r := chi.NewRouter()
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+	r.Get("/{short}", WrapHandler(uh.ReadOriginal))
+	r.Patch("/api/user/urls/{short}", WrapHandler(uh.UpdateShortened))
+	r.Get("/api/user/urls/{short}/revisions", WrapHandler(uh.ReadRevisions))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	owner, stranger := newClient(t), newClient(t)
+
+	for _, created := range []struct {
+		client *http.Client
+		body   string
+	}{
+		{owner, "{\"url\":\"https://ya.ru\",\"alias\":\"mine\"}"},
+		{owner, "{\"url\":\"https://mail.ru\",\"alias\":\"other\"}"},
+		{stranger, "{\"url\":\"https://hh.ru\",\"alias\":\"theirs\"}"},
+	} {
+		resp, err := created.client.Post(ts.URL+"/api/shorten", "application/json", strings.NewReader(created.body))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	patch := func(client *http.Client, short string, body string) (int, string) {
+		req, err := http.NewRequest(http.MethodPatch, ts.URL+"/api/user/urls/"+short, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var res struct {
+			Result string `json:"result"`
+		}
+		if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusConflict {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		}
+
+		return resp.StatusCode, res.Result
+	}
+
+	var testTable = []struct {
+		client *http.Client
+		short  string
+		body   string
+		status int
+		result string
+	}{
+		{owner, "mine", "{\"url\":\"https://go.dev\"}", http.StatusOK, "http://localhost:8080/mine"},
+		{owner, "mine", "{\"url\":\"https://go.dev\"}", http.StatusOK, "http://localhost:8080/mine"},
+		{owner, "mine", "{\"url\":\"https://mail.ru\"}", http.StatusConflict, "http://localhost:8080/other"},
+		{owner, "mine", "{\"url\":\"\"}", http.StatusBadRequest, ""},
+		{owner, "mine", "{", http.StatusBadRequest, ""},
+		{owner, "nope", "{\"url\":\"https://hh.ru\"}", http.StatusNotFound, ""},
+		{stranger, "mine", "{\"url\":\"https://go.dev\"}", http.StatusNotFound, ""},
+		{newClient(t), "mine", "{\"url\":\"https://go.dev\"}", http.StatusUnauthorized, ""},
+	}
+
+	for _, testCase := range testTable {
+		code, result := patch(testCase.client, testCase.short, testCase.body)
+		require.Equal(t, testCase.status, code, testCase.body)
+		require.Equal(t, testCase.result, result, testCase.body)
+	}
+
+	resp, err := stranger.Get(ts.URL + "/mine")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+	require.Equal(t, "https://go.dev", resp.Header.Get("Location"))
+
+	// old original URL may be shortened again
+	resp, err = owner.Post(ts.URL+"/api/shorten", "application/json", strings.NewReader("{\"url\":\"https://ya.ru\"}"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, err = owner.Get(ts.URL + "/api/user/urls/mine/revisions")
+	require.NoError(t, err)
+
+	var revisions []domain.URLRevision
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&revisions))
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, revisions, 1)
+	require.Equal(t, "https://ya.ru", revisions[0].PreviousURL)
+	require.Equal(t, "https://go.dev", revisions[0].OriginalURL)
+
+	resp, err = stranger.Get(ts.URL + "/api/user/urls/mine/revisions")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestGRPCUpdateShortened(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.Authorize(testAuthenticator), interceptor.ValidateRequest))
+
+	us := service.NewURL(repository.NewMemory(), state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	api.RegisterUrlshrtV1Server(grpcServer, &Server{Wg: &sync.WaitGroup{}, Srv: us})
+
+	client := startGRPC(t, grpcServer)
+
+	jwt, err := testTokens.Issue(2)
+	require.NoError(t, err)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "auth", jwt)
+
+	for alias, original := range map[string]string{"grpc-mine": "https://ya.ru", "grpc-other": "https://mail.ru"} {
+		_, err = client.CreateShortenedV1(ctx, &api.CreateShortenedRequestV1{Original: original, Alias: alias})
+		require.NoError(t, err)
+	}
+
+	reply, err := client.UpdateShortenedV1(ctx, &api.UpdateShortenedRequestV1{Shortened: "grpc-mine", Original: "https://go.dev"})
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8080/grpc-mine", reply.Shortened)
+
+	original, err := client.ReadOriginalV1(ctx, &api.ReadOriginalRequestV1{Shortened: "grpc-mine"})
+	require.NoError(t, err)
+	require.Equal(t, "https://go.dev", original.Original)
+
+	_, err = client.UpdateShortenedV1(ctx, &api.UpdateShortenedRequestV1{Shortened: "grpc-mine", Original: "https://mail.ru"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = client.UpdateShortenedV1(ctx, &api.UpdateShortenedRequestV1{Shortened: "grpc-mine"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	other, err := testTokens.Issue(3)
+	require.NoError(t, err)
+
+	_, err = client.UpdateShortenedV1(metadata.AppendToOutgoingContext(context.Background(), "auth", other),
+		&api.UpdateShortenedRequestV1{Shortened: "grpc-mine", Original: "https://hh.ru"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
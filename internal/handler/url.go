@@ -96,9 +96,15 @@ func (h *URL) CreateShortened(w http.ResponseWriter, r *http.Request) {
 		util.GetLogger().Infoln("RandSeed provided", randSeed)
 	}
 	util.GetLogger().Infoln(ctx)
-	shortenedURL, err := h.srv.CreateShortened(ctx, originalURL)
+	shortenedURL, err := h.srv.CreateShortened(ctx, originalURL, domain.ShortenOptions{})
 	var uErr *domain.UniqueError
-	if err != nil && errors.As(err, &uErr) {
+	if errors.Is(err, domain.ErrInvalidURL) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if errors.Is(err, domain.ErrForbiddenURL) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	} else if err != nil && errors.As(err, &uErr) {
 		w.Header().Set("Content-Type", "text/plain")
 		w.WriteHeader(http.StatusConflict)
 		_, err = w.Write([]byte(addr + shortenedURL))
@@ -138,9 +144,21 @@ func (h *URL) CreateShortenedFromJSON(w http.ResponseWriter, r *http.Request) {
 		addr = addr + "/"
 	}
 
-	shortened, err := h.srv.CreateShortened(r.Context(), orig.URL)
+	shortened, err := h.srv.CreateShortened(r.Context(), orig.URL, domain.ShortenOptions{Alias: orig.Alias,
+		ExpiresAt: orig.ExpiresAt, NotBefore: orig.NotBefore, Title: orig.Title, Note: orig.Note, Tags: orig.Tags})
 	var uErr *domain.UniqueError
-	if err != nil && errors.As(err, &uErr) {
+	var aErr *domain.AliasConflictError
+	if errors.Is(err, domain.ErrInvalidAlias) || errors.Is(err, domain.ErrInvalidWindow) || errors.Is(err, domain.ErrInvalidMetadata) ||
+		errors.Is(err, domain.ErrInvalidURL) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if errors.Is(err, domain.ErrForbiddenURL) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	} else if errors.As(err, &aErr) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	} else if err != nil && errors.As(err, &uErr) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusConflict)
 	} else if err != nil {
@@ -192,7 +210,18 @@ func (h *URL) CreateShortenedFromBatchAdapter(wg *sync.WaitGroup) http.HandlerFu
 		}
 
 		shortened, err := h.srv.CreateShortenedFromBatch(r.Context(), orig, wg)
-		if err != nil {
+		var aErr *domain.AliasConflictError
+		if errors.Is(err, domain.ErrInvalidAlias) || errors.Is(err, domain.ErrInvalidWindow) || errors.Is(err, domain.ErrInvalidMetadata) ||
+			errors.Is(err, domain.ErrInvalidURL) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		} else if errors.Is(err, domain.ErrForbiddenURL) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		} else if errors.As(err, &aErr) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		} else if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
@@ -223,31 +252,53 @@ func (h *URL) CreateShortenedFromBatchAdapter(wg *sync.WaitGroup) http.HandlerFu
 	}
 }
 
-// ReadUserURLs - handler to get all user's URLs.
+// ReadUserURLs - handler to get a page of user's URLs. Page size, order and filters may be set with limit, order (asc or desc),
+// contains, domain and tag query parameters. If there are more URLs, link to the next page is sent in Link header.
 func (h *URL) ReadUserURLs(w http.ResponseWriter, r *http.Request) {
-	UserURLs, err := h.srv.ReadUserURLs(r.Context())
-	if err != nil {
+	if identity, _ := domain.IdentityFromContext(r.Context()); identity.New {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	req := domain.URLPageRequest{URLFilter: domain.URLFilter{Contains: query.Get("contains"), Domain: query.Get("domain"), Tag: query.Get("tag")},
+		Cursor: query.Get("cursor"), Order: domain.SortOrder(query.Get("order"))}
+
+	if limit := query.Get("limit"); limit != "" {
+		var err error
+		req.Limit, err = strconv.Atoi(limit)
+		if err != nil || req.Limit == 0 {
+			http.Error(w, "limit should be a positive number", http.StatusBadRequest)
+			return
+		}
+	}
+
+	page, err := h.srv.ReadUserURLsPage(r.Context(), req)
+	if errors.Is(err, domain.ErrInvalidQuery) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		util.GetLogger().Infoln(err)
 		return
 	}
+	UserURLs := page.URLs
 
 	if len(UserURLs) == 0 {
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
-	cookie, err := r.Cookie("auth")
-	if err != nil {
-		util.GetLogger().Infoln(err)
-		return
-	} else {
+	// clients which use API keys have no cookie
+	if cookie, err := r.Cookie("auth"); err == nil {
 		http.SetCookie(w, cookie)
 	}
 
-	if unauthorized := r.Context().Value(domain.Key("unauthorized")); unauthorized != nil {
-		w.WriteHeader(http.StatusUnauthorized)
-		return
+	if page.NextCursor != "" {
+		next := *r.URL
+		query.Set("cursor", page.NextCursor)
+		next.RawQuery = query.Encode()
+		w.Header().Set("Link", "<"+next.RequestURI()+">; rel=\"next\"")
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -260,7 +311,8 @@ func (h *URL) ReadUserURLs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	for _, usrURL := range UserURLs {
-		UserURLsOutput = append(UserURLsOutput, domain.UserOutput{ShortURL: addr + usrURL.ShortURL, OriginalURL: usrURL.OriginalURL})
+		UserURLsOutput = append(UserURLsOutput, domain.UserOutput{ShortURL: addr + usrURL.ShortURL, OriginalURL: usrURL.OriginalURL,
+			CreatedAt: usrURL.CreatedAt, UpdatedAt: usrURL.UpdatedAt, Title: usrURL.Title, Note: usrURL.Note, Tags: usrURL.Tags})
 	}
 
 	var JSONBytes []byte
@@ -277,6 +329,83 @@ func (h *URL) ReadUserURLs(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// UpdateShortened - handler to change original URL of user's short URL, new original URL is in JSON.
+// If the new original URL was already saved, its short URL is returned with conflict status.
+func (h *URL) UpdateShortened(w http.ResponseWriter, r *http.Request) {
+	if identity, _ := domain.IdentityFromContext(r.Context()); identity.New {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var orig OriginalURL
+
+	if !IsJSONContentTypeCorrect(r) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&orig); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if orig.URL == "" {
+		http.Error(w, "url should not be empty", http.StatusBadRequest)
+		return
+	}
+
+	addr := state.GetBaseShortAddress()
+	if addr[len(addr)-1] != '/' {
+		addr = addr + "/"
+	}
+
+	shortened, err := h.srv.UpdateShortened(r.Context(), chi.URLParam(r, "short"), orig.URL)
+	var uErr *domain.UniqueError
+	status := http.StatusOK
+	if errors.Is(err, domain.ErrURLNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	} else if errors.Is(err, domain.ErrInvalidURL) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if errors.Is(err, domain.ErrForbiddenURL) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	} else if errors.As(err, &uErr) {
+		status = http.StatusConflict
+	} else if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, status, struct {
+		Result string `json:"result"`
+	}{
+		Result: addr + shortened,
+	})
+}
+
+// ReadRevisions - handler to get changes of original URL of user's short URL in order they were made.
+func (h *URL) ReadRevisions(w http.ResponseWriter, r *http.Request) {
+	if identity, _ := domain.IdentityFromContext(r.Context()); identity.New {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	revisions, err := h.srv.ReadRevisions(r.Context(), chi.URLParam(r, "short"))
+	if errors.Is(err, domain.ErrURLNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	} else if err != nil {
+		util.GetLogger().Infoln(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, revisions)
+}
+
 func (h *URL) ReadAmountOfURLsAndUsers(w http.ResponseWriter, r *http.Request) {
 	var amounts domain.Amounts
 	var err error
@@ -302,39 +431,19 @@ func (h *URL) ReadAmountOfURLsAndUsers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteUserURLsAdapter - adapter for closure function to mark URL as deleted.
-func (h *URL) DeleteUserURLsAdapter(shortURLsChan *domain.MutexChanString, once *sync.Once, wg *sync.WaitGroup) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		short := make([]string, 0, 1)
-
-		if !IsJSONContentTypeCorrect(r) {
-			w.WriteHeader(http.StatusBadRequest)
-			return
-		}
-
-		if err := json.NewDecoder(r.Body).Decode(&short); err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-
-		shortURLWithID := make([]domain.URLWithID, 0, len(short))
-		for _, url := range short {
-			shortURLWithID = append(shortURLWithID, domain.URLWithID{URL: url, ID: r.Context().Value(domain.Key("id")).(int64)})
-		}
-
-		util.GetLogger().Infoln("попытка удалить", short)
-		util.GetLogger().Infoln(len(short))
-
-		if len(short) == 0 {
-			w.WriteHeader(http.StatusBadRequest)
-			return
-		}
-
-		go func() {
-			h.srv.DeleteUserURLs(r.Context(), shortURLWithID, shortURLsChan, once, wg)
-		}()
+// writeJSON is a function to send value in JSON with status code.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	var JSONBytes []byte
+	buf := bytes.NewBuffer(JSONBytes)
+	if err := json.NewEncoder(buf).Encode(v); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
 
-		w.WriteHeader(http.StatusAccepted)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		util.GetLogger().Infoln(err)
 	}
 }
 
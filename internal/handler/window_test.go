@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/internal/service"
+	"github.com/PoorMercymain/urlshrt/internal/shortcode"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestWindow(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+	state.InitShortAddress("http://localhost:8080")
+
+	us := service.NewURL(repository.NewMemory(), state.NewStore(nil), shortcode.NewRandom(shortcode.RandomAlphabet, shortcode.DefaultLength), nil, nil, domain.DedupPerUser)
+	uh := NewURL(us)
+
+	var wg sync.WaitGroup
+	r := chi.NewRouter()
+	r.Post("/api/shorten", WrapHandler(uh.CreateShortenedFromJSON))
+	r.Post("/api/shorten/batch", WrapHandler(uh.CreateShortenedFromBatchAdapter(&wg)))
+	r.Get("/{short}", WrapHandler(uh.ReadOriginal))
+
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	now := time.Now()
+	soon := now.Add(time.Second).Format(time.RFC3339Nano)
+	hour := now.Add(time.Hour).Format(time.RFC3339Nano)
+	past := now.Add(-time.Hour).Format(time.RFC3339Nano)
+
+	var testTable = []struct {
+		body   string
+		create int
+		read   int
+	}{
+		{"{\"url\":\"https://ya.ru\",\"expires_at\":\"" + hour + "\"}", http.StatusCreated, http.StatusTemporaryRedirect},
+		{"{\"url\":\"https://mail.ru\",\"not_before\":\"" + hour + "\"}", http.StatusCreated, http.StatusGone},
+		{"{\"url\":\"https://hh.ru\",\"expires_at\":\"" + soon + "\"}", http.StatusCreated, http.StatusGone},
+		{"{\"url\":\"https://go.dev\",\"expires_at\":\"" + past + "\"}", http.StatusBadRequest, 0},
+		{"{\"url\":\"https://go.dev\",\"expires_at\":\"" + soon + "\",\"not_before\":\"" + hour + "\"}", http.StatusBadRequest, 0},
+	}
+
+	shorts := make([]string, len(testTable))
+	for i, testCase := range testTable {
+		resp, err := ts.Client().Post(ts.URL+"/api/shorten", "application/json", strings.NewReader(testCase.body))
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+		require.Equal(t, testCase.create, resp.StatusCode, testCase.body)
+
+		if resp.StatusCode == http.StatusCreated {
+			var res struct {
+				Result string `json:"result"`
+			}
+			require.NoError(t, json.Unmarshal(body, &res))
+			shorts[i] = strings.TrimPrefix(res.Result, "http://localhost:8080/")
+		}
+	}
+
+	resp, err := ts.Client().Post(ts.URL+"/api/shorten/batch", "application/json",
+		strings.NewReader("[{\"correlation_id\":\"1\",\"original_url\":\"https://pkg.go.dev\",\"expires_at\":\""+past+"\"}]"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// the third URL expires while the test is running
+	time.Sleep(time.Until(now.Add(time.Second)))
+
+	for i, testCase := range testTable {
+		if testCase.read == 0 {
+			continue
+		}
+
+		resp, err := client.Get(ts.URL + "/" + shorts[i])
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		require.Equal(t, testCase.read, resp.StatusCode, testCase.body)
+	}
+}
@@ -0,0 +1,54 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/api"
+)
+
+// firstValue is a function to get the first value of metadata key, empty string is returned if there is no such key.
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// RecordClicks is an interceptor which records a click for every original URL successfully read by short one.
+func RecordClicks(clicks domain.ClickService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		const readOriginalMethodName = "/api.v1.UrlshrtV1/ReadOriginalV1"
+
+		resp, err := handler(ctx, req)
+		if err != nil || info.FullMethod != readOriginalMethodName {
+			return resp, err
+		}
+
+		click := domain.Click{ShortURL: req.(*api.ReadOriginalRequestV1).Shortened, Time: time.Now()}
+
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			click.Referrer = firstValue(md, "referer")
+			click.UserAgent = firstValue(md, "user-agent")
+		}
+
+		if pr, ok := peer.FromContext(ctx); ok {
+			host, _, splitErr := net.SplitHostPort(pr.Addr.String())
+			if splitErr != nil { // that may happen if there are no port in address
+				host = pr.Addr.String()
+			}
+			click.IP = host
+		}
+
+		clicks.Record(click)
+
+		return resp, nil
+	}
+}
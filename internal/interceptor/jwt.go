@@ -2,124 +2,115 @@ package interceptor
 
 import (
 	"context"
-	"crypto/rand"
 	"errors"
-	"math/big"
 
-	"github.com/golang-jwt/jwt/v4"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/PoorMercymain/urlshrt/internal/auth"
 	"github.com/PoorMercymain/urlshrt/internal/domain"
 	"github.com/PoorMercymain/urlshrt/pkg/util"
 )
 
-func GetUserID(tokenString string, jwtKey string) (int64, error) {
-	claims := jwt.MapClaims{
-		"userid": int64(0),
-	}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
-		if t.Method != jwt.SigningMethodHS256 {
-			return nil, errors.New("wrong signing method")
-		}
-		return []byte(jwtKey), nil
-	})
-
-	if err != nil {
-		util.GetLogger().Infoln("Couldn't parse", err, tokenString)
-		return 0, err
-	}
-
-	if !token.Valid {
-		util.GetLogger().Infoln("Token is not valid")
-		return 0, err
-	}
-
-	util.GetLogger().Infoln("Token is valid")
-	util.GetLogger().Infoln(claims["userid"])
-	uid := int64(claims["userid"].(float64))
-
-	return uid, nil
+// readMethods are methods which save nothing, users without valid JWT are registered by all the other methods,
+// the same way as HTTP requests with methods other than GET and HEAD do.
+var readMethods = map[string]bool{
+	"/api.v1.UrlshrtV1/ReadOriginalV1":             true,
+	"/api.v1.UrlshrtV1/ReadUserURLsV1":             true,
+	"/api.v1.UrlshrtV1/StreamUserURLsV1":           true,
+	"/api.v1.UrlshrtV1/ReadTagsV1":                 true,
+	"/api.v1.UrlshrtV1/ReadAmountOfURLsAndUsersV1": true,
+	"/api.v1.UrlshrtV1/ReadDeletionJobV1":          true,
+	"/api.v1.UrlshrtV1/ReadURLStatsV1":             true,
+	"/api.v1.UrlshrtV1/ReadAPIKeysV1":              true,
 }
 
-func BuildJWTString(jwtKey string) (string, int64, error) {
-	id, err := rand.Int(rand.Reader, big.NewInt(1000))
-	if err != nil {
-		util.GetLogger().Infoln("could not generate", err)
-		return "", 0, err
+// authorize authenticates the user by API key from x-api-key or authorization metadata or by JWT in auth metadata,
+// and puts identity of the user to context. JWT which should be sent back is returned too, it is empty for API keys.
+func authorize(ctx context.Context, authenticator *auth.Authenticator, method string) (context.Context, string, error) {
+	var token, key string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		token = firstValue(md, "auth")
+		key = auth.APIKey(firstValue(md, "x-api-key"), firstValue(md, "authorization"))
+	} else {
+		util.GetLogger().Infoln("failed to get metadata")
 	}
 
-	claims := jwt.MapClaims{
-		"userid": id.Int64(),
-	}
+	if key != "" {
+		identity, err := authenticator.AuthenticateAPIKey(ctx, key)
+		if errors.Is(err, domain.ErrInvalidAPIKey) {
+			return nil, "", status.Errorf(codes.Unauthenticated, "invalid API key")
+		} else if err != nil {
+			util.GetLogger().Infoln("could not authenticate API key", err)
+			return nil, "", status.Errorf(codes.Internal, "failed to authenticate user")
+		}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		util.GetLogger().Infoln("id", identity.UserID, "by API key")
+		return domain.WithIdentity(ctx, identity), "", nil
+	}
 
-	tokenString, err := token.SignedString([]byte(jwtKey))
+	identity, newToken, err := authenticator.Authenticate(ctx, token, !readMethods[method])
 	if err != nil {
-		util.GetLogger().Infoln("could not create token", err)
-		return "", 0, err
+		util.GetLogger().Infoln("could not authenticate user", err)
+		return nil, "", status.Errorf(codes.Internal, "failed to authenticate user")
 	}
 
-	util.GetLogger().Infoln("id2", id)
+	if newToken != "" {
+		token = newToken
+	}
 
-	return tokenString, id.Int64(), nil
+	util.GetLogger().Infoln("id", identity.UserID)
+	return domain.WithIdentity(ctx, identity), token, nil
 }
 
-func Authorize(jwtKey string) grpc.UnaryServerInterceptor {
+// Authorize is an interceptor which authenticates the user by API key from x-api-key or authorization metadata
+// or by JWT in auth metadata, and puts identity of the user to context. API key which is not valid is rejected with Unauthenticated.
+// JWT is sent back in header metadata if there is one, it is a new one if authenticator issued it (for a new user or instead of the one which is about to expire).
+func Authorize(authenticator *auth.Authenticator) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		var needToCreateJWT bool
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			needToCreateJWT = true
-			util.GetLogger().Infoln("failed to get metadata")
-		}
-
-		var values []string
-		if !needToCreateJWT {
-			values, ok = md["auth"]
-			if !ok {
-				needToCreateJWT = true
-			}
+		ctx, token, err := authorize(ctx, authenticator, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
 
-		var auth string
-		if !needToCreateJWT {
-			auth = values[0]
-			if auth == "" {
-				needToCreateJWT = true
-			}
+		if token == "" {
+			return handler(ctx, req)
 		}
 
-		var uid int64
-		var err error
-		if !needToCreateJWT {
-			uid, err = GetUserID(auth, jwtKey)
-			if err != nil {
-				needToCreateJWT = true
-			}
+		if err = grpc.SendHeader(ctx, metadata.Pairs("auth", token)); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to send metadata back to client")
 		}
 
-		if needToCreateJWT {
-			auth, uid, err = BuildJWTString(jwtKey)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to build auth string")
-			}
+		return handler(ctx, req)
+	}
+}
 
-			ctx = context.WithValue(ctx, domain.Key("unauthorized"), true)
-		}
+// identityStream is a type which wraps server stream, so handler gets context with identity of the user.
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-		util.GetLogger().Infoln("id", uid)
-		ctx = context.WithValue(ctx, domain.Key("id"), uid)
+func (s *identityStream) Context() context.Context {
+	return s.ctx
+}
 
-		md = metadata.Pairs("auth", auth)
-		err = grpc.SendHeader(ctx, md)
+// AuthorizeStream is an interceptor which authenticates the user of a stream the same way as Authorize does.
+func AuthorizeStream(authenticator *auth.Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, token, err := authorize(ss.Context(), authenticator, info.FullMethod)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to send metadata back to client")
+			return err
 		}
 
-		return handler(ctx, req)
+		if token != "" {
+			if err = ss.SendHeader(metadata.Pairs("auth", token)); err != nil {
+				return status.Errorf(codes.Internal, "failed to send metadata back to client")
+			}
+		}
+
+		return handler(srv, &identityStream{ServerStream: ss, ctx: ctx})
 	}
 }
@@ -34,3 +34,18 @@ func Log(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handl
 
 	return resp, err
 }
+
+func LogStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+
+	s, _ := status.FromError(err)
+
+	util.GetLogger().Infoln(
+		"method", info.FullMethod,
+		"duration", time.Since(start),
+		"status", s.Code(),
+	)
+
+	return err
+}
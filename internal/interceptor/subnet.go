@@ -12,9 +12,12 @@ import (
 
 func CheckCIDR(CIDR string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		const statsMethodName = "/api.v1.UrlshrtV1/ReadAmountOfURLsAndUsersV1"
+		const (
+			statsMethodName  = "/api.v1.UrlshrtV1/ReadAmountOfURLsAndUsersV1"
+			policyMethodName = "/api.v1.UrlshrtV1/EnforcePolicyV1"
+		)
 
-		if info.FullMethod == statsMethodName {
+		if info.FullMethod == statsMethodName || info.FullMethod == policyMethodName {
 			if CIDR == "" {
 				return nil, status.Error(codes.PermissionDenied, "Forbidden")
 			}
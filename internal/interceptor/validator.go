@@ -21,3 +21,27 @@ func ValidateRequest(ctx context.Context, req interface{}, info *grpc.UnaryServe
 
 	return handler(ctx, req)
 }
+
+// validatingStream is a type which wraps server stream, so every received message is validated.
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if v, ok := m.(validator); ok {
+		if err := v.Validate(); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// ValidateStream is an interceptor which validates messages received by stream the same way as ValidateRequest does.
+func ValidateStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
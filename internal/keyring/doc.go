@@ -0,0 +1,2 @@
+// keyring is a package which contains a set of keys to sign JWTs and to check their signatures.
+package keyring
@@ -0,0 +1,184 @@
+package keyring
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// names of the algorithms which can be chosen in configuration
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// LegacyKeyID is an id of HMAC key which is set by jwt key option. Tokens which were issued before keys got ids
+// have no kid header, so their signatures are checked by this key.
+const LegacyKeyID = ""
+
+var (
+	ErrUnknownKey      = errors.New("token is signed with unknown key")
+	ErrWrongAlgorithm  = errors.New("token is signed with algorithm of another key")
+	ErrKeyCanNotSign   = errors.New("key has no private part, so it can't sign tokens")
+	ErrDuplicatedKeyID = errors.New("key id is used twice")
+	ErrNoKey           = errors.New("jwt key is not set")
+)
+
+// key is a type which represents one key of the keyring.
+type key struct {
+	method jwt.SigningMethod
+	// signKey is nil if only public part of the key is known, such key only checks signatures of tokens which were issued before
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+// Keyring is a type which signs new tokens with the active key and checks tokens signed by any of its keys,
+// so keys may be rotated without making all the users get new tokens.
+type Keyring struct {
+	activeID string
+	keys     map[string]key
+}
+
+// NewHMAC creates keyring with the only HMAC key, which is active and has no id.
+func NewHMAC(secret string) *Keyring {
+	return &Keyring{
+		activeID: LegacyKeyID,
+		keys:     map[string]key{LegacyKeyID: {method: jwt.SigningMethodHS256, signKey: []byte(secret), verifyKey: []byte(secret)}},
+	}
+}
+
+// Load creates keyring with keys described by spec. Spec is a comma separated list of keys in form id:algorithm:path,
+// where algorithm is HS256, RS256 or EdDSA. The first key of the spec signs new tokens, other keys only check tokens which were signed before rotation.
+// HMAC secret is read from file as is (without trailing newline), RSA and Ed25519 keys are read from PEM files. File of a key which doesn't sign tokens may contain public key only.
+// If spec is empty, HMAC key from secret signs new tokens. Otherwise HMAC key from secret is added only if acceptLegacy is set, and it only checks
+// tokens without kid, because anyone who knows the secret could sign a token for any user with it.
+func Load(secret string, spec string, acceptLegacy bool) (*Keyring, error) {
+	if strings.TrimSpace(spec) == "" {
+		if secret == "" {
+			return nil, ErrNoKey
+		}
+
+		return NewHMAC(secret), nil
+	}
+
+	k := &Keyring{keys: make(map[string]key)}
+	if acceptLegacy {
+		if secret == "" {
+			return nil, ErrNoKey
+		}
+
+		k.keys[LegacyKeyID] = key{method: jwt.SigningMethodHS256, verifyKey: []byte(secret)}
+	}
+
+	for i, entry := range strings.Split(spec, ",") {
+		// path is the last part, so it may contain colons
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("key %q should be set in form id:algorithm:path", entry)
+		}
+		id, algorithm, path := parts[0], parts[1], parts[2]
+
+		if _, ok := k.keys[id]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedKeyID, id)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		loaded, err := parseKey(algorithm, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load key %s: %w", id, err)
+		}
+
+		if i == 0 {
+			if loaded.signKey == nil {
+				return nil, fmt.Errorf("%w: %s", ErrKeyCanNotSign, id)
+			}
+			k.activeID = id
+		}
+		k.keys[id] = loaded
+	}
+
+	return k, nil
+}
+
+// parseKey is a function to get key of algorithm from content of its file.
+func parseKey(algorithm string, data []byte) (key, error) {
+	switch algorithm {
+	case AlgorithmHS256:
+		secret := []byte(strings.TrimRight(string(data), "\r\n"))
+		if len(secret) == 0 {
+			return key{}, errors.New("HMAC secret is empty")
+		}
+		return key{method: jwt.SigningMethodHS256, signKey: secret, verifyKey: secret}, nil
+	case AlgorithmRS256:
+		if private, err := jwt.ParseRSAPrivateKeyFromPEM(data); err == nil {
+			return key{method: jwt.SigningMethodRS256, signKey: private, verifyKey: &private.PublicKey}, nil
+		}
+
+		public, err := jwt.ParseRSAPublicKeyFromPEM(data)
+		if err != nil {
+			return key{}, err
+		}
+		return key{method: jwt.SigningMethodRS256, verifyKey: public}, nil
+	case AlgorithmEdDSA:
+		if private, err := jwt.ParseEdPrivateKeyFromPEM(data); err == nil {
+			return key{method: jwt.SigningMethodEdDSA, signKey: private, verifyKey: private.(ed25519.PrivateKey).Public()}, nil
+		}
+
+		public, err := jwt.ParseEdPublicKeyFromPEM(data)
+		if err != nil {
+			return key{}, err
+		}
+		return key{method: jwt.SigningMethodEdDSA, verifyKey: public}, nil
+	default:
+		return key{}, fmt.Errorf("unknown signing algorithm %s", algorithm)
+	}
+}
+
+// Sign creates token with claims signed by the active key, id of the key is put to kid header.
+func (k *Keyring) Sign(claims jwt.Claims) (string, error) {
+	active := k.keys[k.activeID]
+
+	token := jwt.NewWithClaims(active.method, claims)
+	if k.activeID != LegacyKeyID {
+		token.Header["kid"] = k.activeID
+	}
+
+	return token.SignedString(active.signKey)
+}
+
+// IsActive checks if token was signed by the active key. Tokens signed by other keys should be replaced, so the keys could be removed later.
+func (k *Keyring) IsActive(t *jwt.Token) bool {
+	kid, _ := t.Header["kid"].(string)
+	return kid == k.activeID
+}
+
+// Keyfunc is a function to get key which checks signature of token, it should be passed to jwt parsing functions.
+// Token should be signed with the algorithm of its key, so the key can't be used in a way it was not meant for.
+func (k *Keyring) Keyfunc(t *jwt.Token) (interface{}, error) {
+	id := LegacyKeyID
+	if kid, ok := t.Header["kid"]; ok {
+		if id, ok = kid.(string); !ok {
+			return nil, ErrUnknownKey
+		}
+	}
+
+	found, ok := k.keys[id]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	if t.Method.Alg() != found.method.Alg() {
+		return nil, ErrWrongAlgorithm
+	}
+
+	return found.verifyKey, nil
+}
@@ -0,0 +1,153 @@
+package keyring
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func writePEM(t *testing.T, path string, blockType string, der []byte) {
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+}
+
+func TestKeyring(t *testing.T) {
+	dir := t.TempDir()
+
+	_, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(edPrivate)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, "ed.pem"), "PRIVATE KEY", der)
+
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err = x509.MarshalPKIXPublicKey(&rsaPrivate.PublicKey)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, "rsa.pub"), "PUBLIC KEY", der)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "hmac"), []byte("older secret\n"), 0600))
+
+	spec := "new:EdDSA:" + filepath.Join(dir, "ed.pem") + ", old:RS256:" + filepath.Join(dir, "rsa.pub") + ",older:HS256:" + filepath.Join(dir, "hmac")
+	k, err := Load("legacy secret", spec, false)
+	require.NoError(t, err)
+
+	parse := func(tokenString string) (*jwt.Token, error) {
+		return jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{}, k.Keyfunc)
+	}
+
+	sign := func(method jwt.SigningMethod, kid string, key interface{}) string {
+		token := jwt.NewWithClaims(method, jwt.RegisteredClaims{Subject: "1"})
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+
+		tokenString, err := token.SignedString(key)
+		require.NoError(t, err)
+		return tokenString
+	}
+
+	tokenString, err := k.Sign(jwt.RegisteredClaims{Subject: "1"})
+	require.NoError(t, err)
+	token, err := parse(tokenString)
+	require.NoError(t, err)
+	require.Equal(t, "new", token.Header["kid"])
+	require.Equal(t, jwt.SigningMethodEdDSA, token.Method)
+	require.True(t, k.IsActive(token))
+
+	var testTable = []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"rotated RSA key", sign(jwt.SigningMethodRS256, "old", rsaPrivate), true},
+		{"rotated HMAC key", sign(jwt.SigningMethodHS256, "older", []byte("older secret")), true},
+		// legacy key is not accepted unless it was asked for, so the secret can't be used to forge tokens
+		{"token without kid", sign(jwt.SigningMethodHS256, "", []byte("legacy secret")), false},
+		{"unknown key", sign(jwt.SigningMethodHS256, "unknown", []byte("legacy secret")), false},
+		{"wrong secret", sign(jwt.SigningMethodHS256, "older", []byte("legacy secret")), false},
+		// public RSA key should never be used as HMAC secret
+		{"algorithm of another key", sign(jwt.SigningMethodHS256, "old", x509.MarshalPKCS1PublicKey(&rsaPrivate.PublicKey)), false},
+	}
+
+	for _, testCase := range testTable {
+		token, err = parse(testCase.token)
+		if !testCase.valid {
+			require.Error(t, err, testCase.name)
+			continue
+		}
+
+		require.NoError(t, err, testCase.name)
+		require.False(t, k.IsActive(token), testCase.name)
+	}
+
+	// legacy key only checks tokens without kid if it was asked for
+	k, err = Load("legacy secret", spec, true)
+	require.NoError(t, err)
+	token, err = parse(sign(jwt.SigningMethodHS256, "", []byte("legacy secret")))
+	require.NoError(t, err)
+	require.False(t, k.IsActive(token))
+	_, err = parse(sign(jwt.SigningMethodHS256, "", []byte("wrong secret")))
+	require.Error(t, err)
+	tokenString, err = k.Sign(jwt.RegisteredClaims{Subject: "1"})
+	require.NoError(t, err)
+	token, err = parse(tokenString)
+	require.NoError(t, err)
+	require.Equal(t, "new", token.Header["kid"])
+
+	// without spec HMAC key signs tokens without kid, as it was before keys got ids
+	k = NewHMAC("legacy secret")
+	tokenString, err = k.Sign(jwt.RegisteredClaims{Subject: "1"})
+	require.NoError(t, err)
+	token, err = parse(tokenString)
+	require.NoError(t, err)
+	require.NotContains(t, token.Header, "kid")
+	require.True(t, k.IsActive(token))
+}
+
+func TestLoadErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, "rsa.pem"), "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaPrivate))
+	der, err := x509.MarshalPKIXPublicKey(&rsaPrivate.PublicKey)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, "rsa.pub"), "PUBLIC KEY", der)
+
+	_, err = Load("secret", "a:RS256:"+filepath.Join(dir, "rsa.pem")+",b:RS256:"+filepath.Join(dir, "rsa.pub"), false)
+	require.NoError(t, err)
+
+	_, err = Load("", "a:RS256:"+filepath.Join(dir, "rsa.pem"), false)
+	require.NoError(t, err)
+
+	_, err = Load("", "", false)
+	require.ErrorIs(t, err, ErrNoKey)
+
+	_, err = Load("", "a:RS256:"+filepath.Join(dir, "rsa.pem"), true)
+	require.ErrorIs(t, err, ErrNoKey)
+
+	_, err = Load("secret", "a:RS256:"+filepath.Join(dir, "rsa.pub"), false)
+	require.ErrorIs(t, err, ErrKeyCanNotSign)
+
+	_, err = Load("secret", "a:RS256:"+filepath.Join(dir, "rsa.pem")+",a:RS256:"+filepath.Join(dir, "rsa.pub"), false)
+	require.ErrorIs(t, err, ErrDuplicatedKeyID)
+
+	for _, spec := range []string{
+		"a:RS256",
+		":RS256:" + filepath.Join(dir, "rsa.pem"),
+		"a:ES256:" + filepath.Join(dir, "rsa.pem"),
+		"a:EdDSA:" + filepath.Join(dir, "rsa.pem"),
+		"a:RS256:" + filepath.Join(dir, "nope.pem"),
+	} {
+		_, err = Load("secret", spec, false)
+		require.Error(t, err, spec)
+	}
+}
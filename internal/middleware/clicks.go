@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusResponseWriter) WriteHeader(statusCode int) {
+	w.ResponseWriter.WriteHeader(statusCode)
+	w.status = statusCode
+}
+
+// clientIP is a function to get IP of the client, X-Real-IP header is used if it is set.
+func clientIP(r *http.Request) string {
+	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
+		return realIP
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil { // that may happen if there are no port in address
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// RecordClicks is a middleware which records a click for every redirect made by short URL.
+func RecordClicks(h http.Handler, clicks domain.ClickService) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sw := &statusResponseWriter{ResponseWriter: w}
+
+		h.ServeHTTP(sw, r)
+
+		if sw.status != http.StatusTemporaryRedirect {
+			return
+		}
+
+		clicks.Record(domain.Click{
+			ShortURL:  chi.URLParam(r, "short"),
+			Time:      time.Now(),
+			Referrer:  r.Referer(),
+			UserAgent: r.UserAgent(),
+			IP:        clientIP(r),
+		})
+	})
+}
@@ -1,110 +1,59 @@
 package middleware
 
 import (
-	"context"
-	"crypto/rand"
 	"errors"
-	"math/big"
 	"net/http"
-	"strings"
-
-	"github.com/golang-jwt/jwt/v4"
 
+	"github.com/PoorMercymain/urlshrt/internal/auth"
 	"github.com/PoorMercymain/urlshrt/internal/domain"
 	"github.com/PoorMercymain/urlshrt/pkg/util"
 )
 
-// GetUserID function is used to get id from JWT string.
-func GetUserID(tokenString string, jwtKey string) int64 {
-	claims := jwt.MapClaims{
-		"userid": int64(-1),
-	}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
-		return []byte(jwtKey), nil
-	})
-
-	if err != nil {
-		util.GetLogger().Infoln("Couldn't parse", err)
-		return -1
-	}
-
-	if !token.Valid {
-		util.GetLogger().Infoln("Token is not valid")
-		return -1
-	}
-
-	util.GetLogger().Infoln("Token is valid")
-	util.GetLogger().Infoln(claims["userid"])
-	uid := int64(claims["userid"].(float64))
-	return uid
-}
-
-// BuildJWTString is a function to generate id and create JWT string which will contain it.
-func BuildJWTString(jwtKey string) (string, int64, error) {
-	id, err := rand.Int(rand.Reader, big.NewInt(1000))
-	if err != nil {
-		util.GetLogger().Infoln("could not generate", err)
-		return "", -1, err
-	}
-
-	claims := jwt.MapClaims{
-		"userid": id,
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	tokenString, err := token.SignedString([]byte(jwtKey))
-	if err != nil {
-		util.GetLogger().Infoln("could not create token", err)
-		return "", -1, err
-	}
-
-	util.GetLogger().Infoln("id2", id)
+// Authorize is a middleware which authenticates the user by API key from X-API-Key or Authorization header
+// or by JWT in cookie, and puts identity of the user to context. API key which is not valid is rejected with 401.
+// If authenticator issues a new JWT (for a new user or instead of the one which is about to expire), it is set to cookie.
+// Users without valid JWT are registered only by requests with unsafe methods, since GET and HEAD requests save nothing.
+func Authorize(h http.Handler, authenticator *auth.Authenticator) http.HandlerFunc {
+	jwtFn := func(w http.ResponseWriter, r *http.Request) {
+		if key := auth.APIKey(r.Header.Get("X-API-Key"), r.Header.Get("Authorization")); key != "" {
+			identity, err := authenticator.AuthenticateAPIKey(r.Context(), key)
+			if errors.Is(err, domain.ErrInvalidAPIKey) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			} else if err != nil {
+				util.GetLogger().Infoln("could not authenticate API key", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
 
-	return tokenString, id.Int64(), nil
-}
+			util.GetLogger().Infoln("id", identity.UserID, "by API key")
+			h.ServeHTTP(w, r.WithContext(domain.WithIdentity(r.Context(), identity)))
+			return
+		}
 
-// Authorize is a middleware which checks JWT in cookie and creates it if it does not exist or if it is not correct.
-func Authorize(h http.Handler, jwtKey string) http.HandlerFunc {
-	jwtFn := func(w http.ResponseWriter, r *http.Request) {
+		var token string
 		cookie, err := r.Cookie("auth")
 		if err != nil && !errors.Is(err, http.ErrNoCookie) {
 			w.WriteHeader(http.StatusBadRequest)
 			return
+		} else if err == nil {
+			token = cookie.Value
 		}
 
-		var id int64
-		var hasCookie bool
-		var cookieString string
-		if !errors.Is(err, http.ErrNoCookie) {
-			hasCookie = true
-			cookieString = cookie.String()
+		register := r.Method != http.MethodGet && r.Method != http.MethodHead
+		identity, newToken, err := authenticator.Authenticate(r.Context(), token, register)
+		if err != nil {
+			util.GetLogger().Infoln("could not authenticate user", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
 		}
 
-		ctx := r.Context()
-
-		cookieString = strings.TrimPrefix(cookieString, "auth=")
-
-		if id = GetUserID(cookieString, jwtKey); id == -1 || !hasCookie {
-			cookieString, id, err = BuildJWTString(jwtKey)
-			if err != nil {
-				w.WriteHeader(http.StatusInternalServerError)
-				return
-			}
-
-			http.SetCookie(w, &http.Cookie{Name: "auth", Value: cookieString})
-			ctx = context.WithValue(ctx, domain.Key("unauthorized"), true)
+		if newToken != "" {
+			http.SetCookie(w, &http.Cookie{Name: "auth", Value: newToken})
 		}
 
-		util.GetLogger().Infoln("id", id)
-		ctx = context.WithValue(ctx, domain.Key("id"), id)
-
-		util.GetLogger().Infoln(ctx.Value(domain.Key("id")).(int64))
-
-		r = r.WithContext(ctx)
-		util.GetLogger().Infoln(r.Context().Value(domain.Key("id")))
-
-		h.ServeHTTP(w, r)
+		util.GetLogger().Infoln("id", identity.UserID)
+		h.ServeHTTP(w, r.WithContext(domain.WithIdentity(r.Context(), identity)))
 	}
 
 	return jwtFn
@@ -0,0 +1,304 @@
+// policy is a package which contains rules of destinations which can't be shortened.
+package policy
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PoorMercymain/urlshrt/internal/canonical"
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+// names of the files with rules in the policy directory, missing files are treated as empty ones
+const (
+	// BlocklistFile contains domains which can't be shortened, every domain blocks its subdomains too
+	BlocklistFile = "blocklist.txt"
+	// AllowlistFile contains domains which are not checked by the blocklist and regex rules, every domain allows its subdomains too
+	AllowlistFile = "allowlist.txt"
+	// RulesFile contains regular expressions which are matched against the whole original URLs
+	RulesFile = "rules.txt"
+	// HashesFile contains hex encoded SHA-256 hashes of original URLs or their hosts, hashes are checked even for allowed domains
+	HashesFile = "hashes.txt"
+)
+
+// rules is a type which contains rules read from the files at once.
+type rules struct {
+	blocked  map[string]struct{}
+	allowed  map[string]struct{}
+	patterns []*regexp.Regexp
+	hashes   map[string]struct{}
+}
+
+// version is a type which is used to find out if a file was changed since it was read.
+type version struct {
+	exists  bool
+	size    int64
+	modTime time.Time
+}
+
+// Engine checks original URLs against the rules from the files of the policy directory. The files are read again
+// when they are changed, if new rules can't be read, the old ones are kept.
+type Engine struct {
+	dir      string
+	rules    *rules
+	versions map[string]version
+	mu       *sync.RWMutex
+}
+
+// New creates Engine with the rules from the files of dir. If dir is empty, there are no rules and every URL may be shortened.
+func New(dir string) (*Engine, error) {
+	if dir != "" {
+		if info, err := os.Stat(dir); err != nil {
+			return nil, err
+		} else if !info.IsDir() {
+			return nil, fmt.Errorf("%s is not a directory", dir)
+		}
+	}
+
+	e := &Engine{dir: dir, rules: &rules{}, mu: &sync.RWMutex{}}
+	if err := e.Reload(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Check returns error which wraps domain.ErrForbiddenURL and tells which rule original URL matches. Original URL should be
+// in the canonical form, otherwise its host may be not found in the lists.
+func (e *Engine) Check(original string) error {
+	e.mu.RLock()
+	r := e.rules
+	e.mu.RUnlock()
+
+	u, err := url.Parse(original)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidURL, err)
+	}
+	host := strings.ToLower(u.Hostname())
+
+	for _, hashed := range []string{original, host} {
+		sum := sha256.Sum256([]byte(hashed))
+		if _, ok := r.hashes[hex.EncodeToString(sum[:])]; ok {
+			return fmt.Errorf("%w: %s is in the hash list", domain.ErrForbiddenURL, hashed)
+		}
+	}
+
+	if matchDomain(r.allowed, host) != "" {
+		return nil
+	}
+
+	if blocked := matchDomain(r.blocked, host); blocked != "" {
+		return fmt.Errorf("%w: domain %s is blocked", domain.ErrForbiddenURL, blocked)
+	}
+
+	for _, pattern := range r.patterns {
+		if pattern.MatchString(original) {
+			return fmt.Errorf("%w: url matches rule %s", domain.ErrForbiddenURL, pattern)
+		}
+	}
+
+	return nil
+}
+
+// matchDomain finds host or the closest of its parent domains in domains, empty string is returned if there is none of them.
+func matchDomain(domains map[string]struct{}, host string) string {
+	if len(domains) == 0 {
+		return ""
+	}
+
+	for {
+		if _, ok := domains[host]; ok {
+			return host
+		}
+
+		dot := strings.IndexByte(host, '.')
+		if dot < 0 {
+			return ""
+		}
+		host = host[dot+1:]
+	}
+}
+
+// Reload reads the rules from the files again if any of them was changed since they were read.
+func (e *Engine) Reload() error {
+	if e.dir == "" {
+		return nil
+	}
+
+	versions := make(map[string]version, 4)
+	for _, name := range []string{BlocklistFile, AllowlistFile, RulesFile, HashesFile} {
+		v, err := stat(filepath.Join(e.dir, name))
+		if err != nil {
+			return err
+		}
+		versions[name] = v
+	}
+
+	e.mu.RLock()
+	changed := false
+	for name, v := range versions {
+		if e.versions[name] != v {
+			changed = true
+		}
+	}
+	e.mu.RUnlock()
+
+	if !changed {
+		return nil
+	}
+
+	r, err := load(e.dir)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	e.rules, e.versions = r, versions
+	e.mu.Unlock()
+
+	util.GetLogger().Infoln("policy rules loaded:", len(r.blocked), "blocked domains,", len(r.allowed), "allowed domains,",
+		len(r.patterns), "regex rules,", len(r.hashes), "hashes")
+
+	return nil
+}
+
+// Run reloads the rules every interval until the context is done.
+func (e *Engine) Run(ctx context.Context, interval time.Duration) {
+	if e.dir == "" {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.Reload(); err != nil {
+				util.GetLogger().Infoln("policy reload", err)
+			}
+		}
+	}
+}
+
+// stat is a function to get version of a file, version of missing file is empty.
+func stat(location string) (version, error) {
+	info, err := os.Stat(location)
+	if errors.Is(err, os.ErrNotExist) {
+		return version{}, nil
+	} else if err != nil {
+		return version{}, err
+	}
+
+	return version{exists: true, size: info.Size(), modTime: info.ModTime()}, nil
+}
+
+// load is a function to read all the rules from the files of dir.
+func load(dir string) (*rules, error) {
+	r := &rules{}
+	var err error
+
+	if r.blocked, err = readSet(filepath.Join(dir, BlocklistFile), parseDomain); err != nil {
+		return nil, err
+	}
+
+	if r.allowed, err = readSet(filepath.Join(dir, AllowlistFile), parseDomain); err != nil {
+		return nil, err
+	}
+
+	if r.hashes, err = readSet(filepath.Join(dir, HashesFile), parseHash); err != nil {
+		return nil, err
+	}
+
+	err = readLines(filepath.Join(dir, RulesFile), func(line string) error {
+		pattern, err := regexp.Compile(line)
+		if err != nil {
+			return err
+		}
+
+		r.patterns = append(r.patterns, pattern)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// readSet is a function to read a file where every line is an element of set, lines are brought to the form of elements by parse.
+func readSet(location string, parse func(line string) (string, error)) (map[string]struct{}, error) {
+	set := make(map[string]struct{})
+	err := readLines(location, func(line string) error {
+		elem, err := parse(line)
+		if err != nil {
+			return err
+		}
+
+		set[elem] = struct{}{}
+		return nil
+	})
+
+	return set, err
+}
+
+// readLines is a function to call fn for every line of a file except empty lines and comments (lines which start with #).
+// Missing file is treated as an empty one.
+func readLines(location string, fn func(line string) error) error {
+	file, err := os.Open(location)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if err = fn(line); err != nil {
+			return fmt.Errorf("%s:%d: %w", location, n, err)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// parseDomain is a function to bring domain from a list to the form of hosts of canonical URLs.
+// Domains may be written with leading "*." or ".", it means the same as the domain itself.
+func parseDomain(line string) (string, error) {
+	host, err := canonical.Host(strings.TrimPrefix(strings.TrimPrefix(line, "*"), "."))
+	if err != nil {
+		return "", fmt.Errorf("invalid domain %s", line)
+	}
+
+	return host, nil
+}
+
+// parseHash is a function to check that line is hex encoded SHA-256 hash.
+func parseHash(line string) (string, error) {
+	line = strings.ToLower(line)
+	if b, err := hex.DecodeString(line); err != nil || len(b) != sha256.Size {
+		return "", fmt.Errorf("invalid sha-256 hash %s", line)
+	}
+
+	return line, nil
+}
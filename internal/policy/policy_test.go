@@ -0,0 +1,102 @@
+package policy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func writeRules(t *testing.T, dir string, name string, content string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+}
+
+func hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestCheck(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+
+	dir := t.TempDir()
+	writeRules(t, dir, BlocklistFile, "# phishing\nevil.com\n*.Bad.ORG\n\nпример.рф\n")
+	writeRules(t, dir, AllowlistFile, "good.evil.com\n")
+	writeRules(t, dir, RulesFile, "^https?://[^/]+/download/.*\\.exe$\n")
+	writeRules(t, dir, HashesFile, hash("https://good.evil.com/secret")+"\n"+hash("malware.net")+"\n")
+
+	e, err := New(dir)
+	require.NoError(t, err)
+
+	var testTable = []struct {
+		original  string
+		forbidden bool
+	}{
+		{"https://example.com/", false},
+		{"https://evil.com/", true},
+		{"https://www.evil.com/path", true},
+		{"https://notevil.com/", false},
+		{"https://bad.org/", true},
+		{"https://a.b.bad.org/", true},
+		{"https://xn--e1afmkfd.xn--p1ai/", true},
+		{"https://good.evil.com/", false},
+		{"https://sub.good.evil.com/download/app.exe", false},
+		{"https://good.evil.com/secret", true},
+		{"https://malware.net/anything", true},
+		{"https://example.com/download/app.exe", true},
+		{"https://example.com/download/app.exe.txt", false},
+	}
+
+	for _, testCase := range testTable {
+		err = e.Check(testCase.original)
+		if testCase.forbidden {
+			require.ErrorIs(t, err, domain.ErrForbiddenURL, testCase.original)
+		} else {
+			require.NoError(t, err, testCase.original)
+		}
+	}
+}
+
+func TestReload(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+
+	dir := t.TempDir()
+	e, err := New(dir)
+	require.NoError(t, err)
+	require.NoError(t, e.Check("https://evil.com/"))
+
+	writeRules(t, dir, BlocklistFile, "evil.com\n")
+	require.NoError(t, e.Reload())
+	require.ErrorIs(t, e.Check("https://evil.com/"), domain.ErrForbiddenURL)
+
+	// files are rewritten with content of other size, so changes are found even if modification time is the same
+	// invalid rules are not applied, the old ones are kept
+	writeRules(t, dir, RulesFile, "([a-z]\n")
+	require.ErrorContains(t, e.Reload(), RulesFile+":1")
+	require.ErrorIs(t, e.Check("https://evil.com/"), domain.ErrForbiddenURL)
+
+	writeRules(t, dir, RulesFile, "")
+	require.NoError(t, os.Remove(filepath.Join(dir, BlocklistFile)))
+	require.NoError(t, e.Reload())
+	require.NoError(t, e.Check("https://evil.com/"))
+
+	for name, content := range map[string]string{BlocklistFile: "exa mple.com\n", HashesFile: "abc\n"} {
+		invalid := t.TempDir()
+		writeRules(t, invalid, name, content)
+		_, err = New(invalid)
+		require.Error(t, err, name)
+	}
+
+	_, err = New(filepath.Join(dir, "nope"))
+	require.Error(t, err)
+
+	e, err = New("")
+	require.NoError(t, err)
+	require.NoError(t, e.Check("https://evil.com/"))
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+func TestAPIKeys(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	first := domain.APIKey{ID: "1", UserID: 1, Name: "first", Prefix: "urlshrt_abc", Hash: "hash1", CreatedAt: now}
+	second := domain.APIKey{ID: "2", UserID: 1, Name: "second", Prefix: "urlshrt_cba", Hash: "hash2", CreatedAt: now.Add(time.Second)}
+	foreign := domain.APIKey{ID: "3", UserID: 2, Prefix: "urlshrt_bca", Hash: "hash3", CreatedAt: now}
+
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		for _, key := range []domain.APIKey{second, foreign, first} {
+			require.NoError(t, r.CreateAPIKey(context.Background(), key))
+		}
+
+		keys, err := r.ReadAPIKeys(context.Background(), 1)
+		require.NoError(t, err)
+		require.Equal(t, []domain.APIKey{first, second}, keys)
+
+		key, err := r.ReadAPIKeyByHash(context.Background(), "hash3")
+		require.NoError(t, err)
+		require.Equal(t, foreign, key)
+
+		_, err = r.ReadAPIKeyByHash(context.Background(), "nope")
+		require.ErrorIs(t, err, domain.ErrAPIKeyNotFound)
+
+		// keys of other users can't be revoked
+		require.ErrorIs(t, r.RevokeAPIKey(context.Background(), 1, "3", now), domain.ErrAPIKeyNotFound)
+
+		require.NoError(t, r.RevokeAPIKey(context.Background(), 1, "1", now))
+		// the key which was already revoked keeps its revocation time
+		require.NoError(t, r.RevokeAPIKey(context.Background(), 1, "1", now.Add(time.Hour)))
+
+		key, err = r.ReadAPIKeyByHash(context.Background(), "hash1")
+		require.NoError(t, err)
+		require.NotNil(t, key.RevokedAt)
+		require.True(t, now.Equal(*key.RevokedAt))
+
+		// keys and their revocations are kept after reopening
+		keys, err = reopen(t, r).ReadAPIKeys(context.Background(), 1)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		require.NotNil(t, keys[0].RevokedAt)
+		require.True(t, now.Equal(*keys[0].RevokedAt))
+		require.Nil(t, keys[1].RevokedAt)
+	})
+}
@@ -0,0 +1,1318 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+var (
+	// urlsBucket contains URLs in JSON by their short versions.
+	urlsBucket = []byte("urls")
+	// ownersBucket contains short versions of URLs by keys which consist of user id and original URL,
+	// so every user has only one short URL for an original URL.
+	ownersBucket = []byte("owners")
+	// legacyOriginalsBucket contained short versions of URLs by original ones, when original URLs were unique for all the users.
+	legacyOriginalsBucket = []byte("originals")
+	// usersBucket contains keys which consist of user id and short URL, so URLs of a user could be found by prefix.
+	usersBucket = []byte("users")
+	// clicksBucket contains a bucket for every short URL which was used, clicks in JSON are kept there by their sequence numbers.
+	clicksBucket = []byte("clicks")
+	// registeredUsersBucket contains registered users in JSON by their ids, its sequence is the greatest id which was given.
+	registeredUsersBucket = []byte("registered_users")
+	// apiKeysBucket contains API keys in JSON by their hashes.
+	apiKeysBucket = []byte("api_keys")
+	// deletionsBucket contains URLs waiting to be deleted in JSON by their sequence numbers, so they are read in order they were queued.
+	deletionsBucket = []byte("deletions")
+	// revisionsBucket contains a bucket for every short URL whose original URL was changed, revisions in JSON are kept there by their numbers.
+	revisionsBucket = []byte("revisions")
+	// originalsIndexBucket contains keys which consist of original URL and short URL, so URLs of all the users could be found
+	// by original URL when it is deduplicated globally.
+	originalsIndexBucket = []byte("originals_index")
+)
+
+// Bolt is a type which stores URL data in embedded bbolt database, which is a single file.
+type Bolt struct {
+	db *bolt.DB
+}
+
+// NewBolt opens (or creates) bbolt database file and prepares it to store URLs.
+func NewBolt(path string) (*Bolt, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		// database may be created before the index of original URLs existed, then the index is filled from URLs
+		fillOriginals := tx.Bucket(originalsIndexBucket) == nil
+
+		for _, name := range [][]byte{urlsBucket, ownersBucket, usersBucket, clicksBucket, registeredUsersBucket, apiKeysBucket, deletionsBucket,
+			revisionsBucket, originalsIndexBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+
+		if fillOriginals {
+			err := tx.Bucket(urlsBucket).ForEach(func(k, v []byte) error {
+				var url state.URLStringJSON
+				if err := json.Unmarshal(v, &url); err != nil {
+					return err
+				}
+
+				return tx.Bucket(originalsIndexBucket).Put(originalKey(url.OriginalURL, url.ShortURL), nil)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := initUserSequence(tx); err != nil {
+			return err
+		}
+
+		if err := backfillDeletedAt(tx, time.Now()); err != nil {
+			return err
+		}
+
+		if tx.Bucket(legacyOriginalsBucket) == nil {
+			return nil
+		}
+
+		// database was created when original URLs were unique for all the users, so owners bucket is filled from URLs
+		err := tx.Bucket(urlsBucket).ForEach(func(k, v []byte) error {
+			var url state.URLStringJSON
+			if err := json.Unmarshal(v, &url); err != nil {
+				return err
+			}
+
+			return tx.Bucket(ownersBucket).Put(userKey(url.UserID, url.OriginalURL), k)
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.DeleteBucket(legacyOriginalsBucket)
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Bolt{db: db}, nil
+}
+
+// initUserSequence makes sure that ids of registered users are greater than ids of users who got random ids
+// and ids of users whose URLs are already saved (which may happen if database was created before the registry existed).
+func initUserSequence(tx *bolt.Tx) error {
+	registered := tx.Bucket(registeredUsersBucket)
+	if registered.Sequence() >= uint64(domain.FirstUserID-1) {
+		return nil
+	}
+
+	last := domain.FirstUserID - 1
+	err := tx.Bucket(usersBucket).ForEach(func(k, v []byte) error {
+		if id := int64(binary.BigEndian.Uint64(k[:8])); id > last {
+			last = id
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return registered.SetSequence(uint64(last))
+}
+
+// backfillDeletedAt gives time of deletion to URLs which were deleted before it was saved, so they may be restored
+// during grace period and purged after it, like in database migration.
+func backfillDeletedAt(tx *bolt.Tx, now time.Time) error {
+	urls := make([]state.URLStringJSON, 0)
+	err := tx.Bucket(urlsBucket).ForEach(func(k, v []byte) error {
+		var url state.URLStringJSON
+		if err := json.Unmarshal(v, &url); err != nil {
+			return err
+		}
+
+		if url.IsDeleted && url.DeletedAt == nil {
+			url.DeletedAt = &now
+			urls = append(urls, url)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// bucket can't be changed while it is iterated, so URLs are saved after iteration
+	for _, url := range urls {
+		if err = putBoltURL(tx, url); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Bolt) Close() error {
+	return r.db.Close()
+}
+
+// userKey is a function to build key for users and owners buckets, the key consists of user id and short or original URL.
+func userKey(id int64, url string) []byte {
+	key := make([]byte, 8, 8+len(url))
+	binary.BigEndian.PutUint64(key, uint64(id))
+	return append(key, url...)
+}
+
+// originalKey is a function to build key for the index of original URLs, original URL is separated from short URL by zero byte,
+// which canonical URLs never contain.
+func originalKey(original string, short string) []byte {
+	key := make([]byte, 0, len(original)+1+len(short))
+	key = append(append(key, original...), 0)
+	return append(key, short...)
+}
+
+// findBoltOriginal looks for short URL of the original URL among URLs of the user, or among URLs of all the users
+// if the original URL is deduplicated globally (the smallest short URL is returned then).
+func findBoltOriginal(tx *bolt.Tx, scope domain.DedupScope, uid int64, original string) (string, bool) {
+	if scope != domain.DedupGlobal {
+		shrt := tx.Bucket(ownersBucket).Get(userKey(uid, original))
+		return string(shrt), shrt != nil
+	}
+
+	prefix := originalKey(original, "")
+	k, _ := tx.Bucket(originalsIndexBucket).Cursor().Seek(prefix)
+	if k == nil || !bytes.HasPrefix(k, prefix) {
+		return "", false
+	}
+
+	return string(k[len(prefix):]), true
+}
+
+func getBoltURL(tx *bolt.Tx, short string) (state.URLStringJSON, bool, error) {
+	var url state.URLStringJSON
+
+	data := tx.Bucket(urlsBucket).Get([]byte(short))
+	if data == nil {
+		return url, false, nil
+	}
+
+	err := json.Unmarshal(data, &url)
+	return url, true, err
+}
+
+func putBoltURL(tx *bolt.Tx, url state.URLStringJSON) error {
+	data, err := json.Marshal(url)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Bucket(urlsBucket).Put([]byte(url.ShortURL), data); err != nil {
+		return err
+	}
+
+	if err = tx.Bucket(ownersBucket).Put(userKey(url.UserID, url.OriginalURL), []byte(url.ShortURL)); err != nil {
+		return err
+	}
+
+	if err = tx.Bucket(originalsIndexBucket).Put(originalKey(url.OriginalURL, url.ShortURL), nil); err != nil {
+		return err
+	}
+
+	if err = tx.Bucket(usersBucket).Put(userKey(url.UserID, url.ShortURL), nil); err != nil {
+		return err
+	}
+
+	// ids of users whose URLs are saved (e.g. imported ones) should not be given to new users
+	if registered := tx.Bucket(registeredUsersBucket); url.UserID > int64(registered.Sequence()) {
+		return registered.SetSequence(uint64(url.UserID))
+	}
+
+	return nil
+}
+
+// checkBoltURL checks if URL can be saved. If its original URL was already saved in the scope, short version is returned with UniqueError.
+func checkBoltURL(tx *bolt.Tx, url state.URLStringJSON, scope domain.DedupScope) (string, error) {
+	if shrt, ok := findBoltOriginal(tx, scope, url.UserID, url.OriginalURL); ok {
+		return shrt, domain.NewUniqueError(errors.New("original url already exists"))
+	}
+
+	if tx.Bucket(urlsBucket).Get([]byte(url.ShortURL)) != nil {
+		return "", domain.ErrShortURLExists
+	}
+
+	return "", nil
+}
+
+func (r *Bolt) PingPg(ctx context.Context) error {
+	// bbolt returns an error if the database is closed
+	return r.db.View(func(tx *bolt.Tx) error {
+		return nil
+	})
+}
+
+// ReadAll gets all the URLs from the database.
+func (r *Bolt) ReadAll(ctx context.Context) ([]state.URLStringJSON, error) {
+	urls := make([]state.URLStringJSON, 0)
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(urlsBucket).ForEach(func(k, v []byte) error {
+			var url state.URLStringJSON
+			if err := json.Unmarshal(v, &url); err != nil {
+				return err
+			}
+
+			urls = append(urls, url)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortByUUID(urls)
+
+	return urls, nil
+}
+
+// Create saves URLs to the database. If an original URL was already saved in the scope from context, its short version is returned with UniqueError.
+func (r *Bolt) Create(ctx context.Context, urls []state.URLStringJSON) (string, error) {
+	id := domain.UserIDFromContext(ctx)
+	scope := domain.DedupScopeFromContext(ctx)
+
+	var shrt string
+	var checkErr error
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		for _, url := range urls {
+			url.UserID = id
+			url.IsDeleted, url.DeletedAt = false, nil
+
+			if shrt, checkErr = checkBoltURL(tx, url, scope); checkErr != nil {
+				// URLs which were checked before should be saved anyway
+				return nil
+			}
+
+			if err := putBoltURL(tx, url); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return shrt, checkErr
+}
+
+// CreateBatch saves URLs from batch to the database in one transaction. Nothing is saved if any of the URLs already exist.
+func (r *Bolt) CreateBatch(ctx context.Context, batch []*state.URLStringJSON) error {
+	id := domain.UserIDFromContext(ctx)
+	scope := domain.DedupScopeFromContext(ctx)
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		for _, url := range batch {
+			u := *url
+			u.UserID = id
+			u.IsDeleted, u.DeletedAt = false, nil
+
+			if _, err := checkBoltURL(tx, u, scope); err != nil {
+				return domain.NewUniqueError(err)
+			}
+
+			if err := putBoltURL(tx, u); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReadUserURLs gets URLs created by user whose id is in context which match query.
+// All the URLs of the user are read, because they are kept in order of their short URLs.
+func (r *Bolt) ReadUserURLs(ctx context.Context, query domain.URLQuery) ([]state.URLStringJSON, error) {
+	id := domain.UserIDFromContext(ctx)
+	urls := make([]state.URLStringJSON, 0)
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		prefix := userKey(id, "")
+		c := tx.Bucket(usersBucket).Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			url, ok, err := getBoltURL(tx, string(k[len(prefix):]))
+			if err != nil {
+				return err
+			}
+
+			if ok {
+				urls = append(urls, url)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortByCreation(urls)
+
+	return pageURLs(urls, query), nil
+}
+
+// ReadOwner gets id of the user who saved URL (-1 if the URL has no owner), ErrURLNotFound is returned if there is no such URL.
+func (r *Bolt) ReadOwner(ctx context.Context, shortened string) (int64, error) {
+	owner := int64(-1)
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		url, ok, err := getBoltURL(tx, shortened)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return domain.ErrURLNotFound
+		}
+
+		owner = url.UserID
+		return nil
+	})
+
+	return owner, err
+}
+
+// DeleteUserURLs marks URLs as deleted, every URL is marked only if it belongs to the user with id of the same index.
+// Statuses of the URLs are returned in the same order.
+func (r *Bolt) DeleteUserURLs(ctx context.Context, shortURLs []string, uid []int64) ([]domain.DeletionStatus, error) {
+	if len(shortURLs) != len(uid) {
+		return nil, errors.New("amounts of urls and user ids are not equal")
+	}
+
+	now := time.Now()
+	statuses := make([]domain.DeletionStatus, len(shortURLs))
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		for i, shrt := range shortURLs {
+			url, ok, err := getBoltURL(tx, shrt)
+			if err != nil {
+				return err
+			}
+
+			statuses[i] = deletionStatus(ok, url.UserID, uid[i])
+			if statuses[i] != domain.DeletionDeleted || url.IsDeleted {
+				continue
+			}
+
+			url.IsDeleted, url.DeletedAt = true, &now
+			if err = putBoltURL(tx, url); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// DeleteExpiredURLs marks URLs whose expiration time has come as deleted, amount of marked URLs is returned.
+func (r *Bolt) DeleteExpiredURLs(ctx context.Context, now time.Time) (int, error) {
+	var marked int
+
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		expired := make([]state.URLStringJSON, 0)
+		err := tx.Bucket(urlsBucket).ForEach(func(k, v []byte) error {
+			var url state.URLStringJSON
+			if err := json.Unmarshal(v, &url); err != nil {
+				return err
+			}
+
+			if !url.IsDeleted && url.IsExpired(now) {
+				expired = append(expired, url)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// bucket can't be changed while it is iterated, so URLs are marked after iteration
+		for _, url := range expired {
+			url.IsDeleted, url.DeletedAt = true, &now
+			if err = putBoltURL(tx, url); err != nil {
+				return err
+			}
+		}
+
+		marked = len(expired)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return marked, nil
+}
+
+// RestoreUserURLs unmarks deleted URLs of the user whose id is in context if they were deleted after deletedAfter.
+// Statuses of the URLs are returned in the same order.
+func (r *Bolt) RestoreUserURLs(ctx context.Context, shortURLs []string, deletedAfter time.Time) ([]domain.RestoreStatus, error) {
+	id := domain.UserIDFromContext(ctx)
+	now := time.Now()
+
+	statuses := make([]domain.RestoreStatus, len(shortURLs))
+	// the same URL may be repeated in the request, but it is restored only once
+	seen := make(map[string]struct{}, len(shortURLs))
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		for i, shrt := range shortURLs {
+			if _, ok := seen[shrt]; ok {
+				statuses[i] = domain.RestoreRestored
+				continue
+			}
+
+			url, ok, err := getBoltURL(tx, shrt)
+			if err != nil {
+				return err
+			}
+
+			statuses[i] = restoreStatus(url, ok, id, deletedAfter, now)
+			if statuses[i] != domain.RestoreRestored {
+				continue
+			}
+
+			url.IsDeleted, url.DeletedAt = false, nil
+			if err = putBoltURL(tx, url); err != nil {
+				return err
+			}
+			seen[shrt] = struct{}{}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// PurgeDeletedURLs removes URLs which were deleted before deletedBefore with their clicks, short versions of removed URLs are returned.
+func (r *Bolt) PurgeDeletedURLs(ctx context.Context, deletedBefore time.Time) ([]string, error) {
+	purged := make([]string, 0)
+
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		urls := make([]state.URLStringJSON, 0)
+		err := tx.Bucket(urlsBucket).ForEach(func(k, v []byte) error {
+			var url state.URLStringJSON
+			if err := json.Unmarshal(v, &url); err != nil {
+				return err
+			}
+
+			if url.IsDeleted && url.DeletedAt != nil && url.DeletedAt.Before(deletedBefore) {
+				urls = append(urls, url)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// bucket can't be changed while it is iterated, so URLs are removed after iteration
+		for _, url := range urls {
+			if err = deleteBoltURL(tx, url); err != nil {
+				return err
+			}
+			purged = append(purged, url.ShortURL)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return purged, nil
+}
+
+// deleteBoltURL removes URL from all the buckets with its clicks and revisions, so its short URL may be used again.
+func deleteBoltURL(tx *bolt.Tx, url state.URLStringJSON) error {
+	if err := tx.Bucket(urlsBucket).Delete([]byte(url.ShortURL)); err != nil {
+		return err
+	}
+
+	owners := tx.Bucket(ownersBucket)
+	key := userKey(url.UserID, url.OriginalURL)
+	if bytes.Equal(owners.Get(key), []byte(url.ShortURL)) {
+		if err := owners.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Bucket(usersBucket).Delete(userKey(url.UserID, url.ShortURL)); err != nil {
+		return err
+	}
+
+	if err := tx.Bucket(originalsIndexBucket).Delete(originalKey(url.OriginalURL, url.ShortURL)); err != nil {
+		return err
+	}
+
+	for _, name := range [][]byte{clicksBucket, revisionsBucket} {
+		b := tx.Bucket(name)
+		if b.Bucket([]byte(url.ShortURL)) == nil {
+			continue
+		}
+
+		if err := b.DeleteBucket([]byte(url.ShortURL)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateOriginal changes original URL of URL of the user whose id is in context and saves revision of the change in one transaction.
+// If the original URL was already saved in the scope from context with another short URL, that short URL is returned with UniqueError.
+func (r *Bolt) UpdateOriginal(ctx context.Context, shortened string, original string, at time.Time) (string, error) {
+	id := domain.UserIDFromContext(ctx)
+	scope := domain.DedupScopeFromContext(ctx)
+
+	var shrt string
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		url, ok, err := getBoltURL(tx, shortened)
+		if err != nil {
+			return err
+		}
+
+		if !revisable(url, ok, id) {
+			return domain.ErrURLNotFound
+		}
+
+		if url.OriginalURL == original {
+			return nil
+		}
+
+		if saved, ok := findBoltOriginal(tx, scope, id, original); ok {
+			shrt = saved
+			return domain.NewUniqueError(errors.New("original url already exists"))
+		}
+
+		owners := tx.Bucket(ownersBucket)
+		if key := userKey(id, url.OriginalURL); bytes.Equal(owners.Get(key), []byte(shortened)) {
+			if err = owners.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		if err = tx.Bucket(originalsIndexBucket).Delete(originalKey(url.OriginalURL, shortened)); err != nil {
+			return err
+		}
+
+		rev := domain.URLRevision{ShortURL: shortened, PreviousURL: url.OriginalURL, OriginalURL: original, ChangedAt: at}
+		url.OriginalURL, url.UpdatedAt = original, &at
+		if err = putBoltURL(tx, url); err != nil {
+			return err
+		}
+
+		b, err := tx.Bucket(revisionsBucket).CreateBucketIfNotExists([]byte(shortened))
+		if err != nil {
+			return err
+		}
+
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		rev.Revision = int(seq)
+
+		data, err := json.Marshal(rev)
+		if err != nil {
+			return err
+		}
+
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, seq)
+		return b.Put(key, data)
+	})
+
+	return shrt, err
+}
+
+// ReadRevisions gets revisions of URL of the user whose id is in context in order they were made.
+func (r *Bolt) ReadRevisions(ctx context.Context, shortened string) ([]domain.URLRevision, error) {
+	id := domain.UserIDFromContext(ctx)
+	revisions := make([]domain.URLRevision, 0)
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		url, ok, err := getBoltURL(tx, shortened)
+		if err != nil {
+			return err
+		}
+
+		if !ok || url.UserID != id {
+			return domain.ErrURLNotFound
+		}
+
+		b := tx.Bucket(revisionsBucket).Bucket([]byte(shortened))
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			var rev domain.URLRevision
+			if err := json.Unmarshal(v, &rev); err != nil {
+				return err
+			}
+
+			revisions = append(revisions, rev)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// UpdateTags adds tags to URL of the user whose id is in context and removes tags from it, tags of the URL after the change are returned.
+func (r *Bolt) UpdateTags(ctx context.Context, shortened string, added []string, removed []string) ([]string, error) {
+	id := domain.UserIDFromContext(ctx)
+
+	var tags []string
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		url, ok, err := getBoltURL(tx, shortened)
+		if err != nil {
+			return err
+		}
+
+		if !revisable(url, ok, id) {
+			return domain.ErrURLNotFound
+		}
+
+		if tags, err = mergeTags(url.Tags, added, removed); err != nil {
+			return err
+		}
+
+		url.Tags = tags
+		return putBoltURL(tx, url)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// ReadTags counts URLs of the user whose id is in context by their tags.
+func (r *Bolt) ReadTags(ctx context.Context) ([]domain.TagCount, error) {
+	urls, err := r.ReadUserURLs(ctx, domain.URLQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	return countTags(urls), nil
+}
+
+// DisableURLs marks URLs as disabled, so they don't redirect anywhere. Unknown short URLs are skipped.
+func (r *Bolt) DisableURLs(ctx context.Context, shortURLs []string) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		for _, short := range shortURLs {
+			url, ok, err := getBoltURL(tx, short)
+			if err != nil {
+				return err
+			}
+
+			if !ok || url.IsDisabled {
+				continue
+			}
+
+			url.IsDisabled = true
+			if err = putBoltURL(tx, url); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *Bolt) IsURLDeleted(ctx context.Context, shortened string) (bool, error) {
+	var url state.URLStringJSON
+	var ok bool
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		url, ok, err = getBoltURL(tx, shortened)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !ok {
+		return false, errURLNotFound
+	}
+
+	return url.IsDeleted, nil
+}
+
+// CountURLsAndUsers counts URLs which are not deleted and users who have at least one such URL.
+func (r *Bolt) CountURLsAndUsers(ctx context.Context) (int, int, error) {
+	var totalURLs int
+	users := make(map[int64]struct{})
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(urlsBucket).ForEach(func(k, v []byte) error {
+			var url state.URLStringJSON
+			if err := json.Unmarshal(v, &url); err != nil {
+				return err
+			}
+
+			if url.IsDeleted {
+				return nil
+			}
+
+			totalURLs++
+			if url.UserID >= 0 {
+				users[url.UserID] = struct{}{}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return totalURLs, len(users), nil
+}
+
+// Export calls fn for every URL in order of their creation.
+func (r *Bolt) Export(ctx context.Context, fn func(url state.URLStringJSON) error) error {
+	urls, err := r.ReadAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, url := range urls {
+		if err = fn(url); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Import saves URLs keeping their owners and deletion flags in one transaction. URLs which were already saved are skipped.
+func (r *Bolt) Import(ctx context.Context, urls []state.URLStringJSON) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		for _, url := range urls {
+			if tx.Bucket(urlsBucket).Get([]byte(url.ShortURL)) != nil {
+				continue
+			}
+
+			if shrt := tx.Bucket(ownersBucket).Get(userKey(url.UserID, url.OriginalURL)); shrt != nil {
+				return fmt.Errorf("original url %s of user %d is already saved with short url %s", url.OriginalURL, url.UserID, shrt)
+			}
+
+			if err := putBoltURL(tx, url); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ExportUsers calls fn for every registered user in order of their ids.
+func (r *Bolt) ExportUsers(ctx context.Context, fn func(user domain.User) error) error {
+	users := make([]domain.User, 0)
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(registeredUsersBucket).ForEach(func(k, v []byte) error {
+			var user userRecord
+			if err := json.Unmarshal(v, &user); err != nil {
+				return err
+			}
+
+			users = append(users, domain.User(user))
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		if err = fn(user); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImportUsers saves users keeping their ids in one transaction, users which were already saved are skipped.
+// Sequence of registered users bucket is moved forward, so ids of imported users are never given to new users.
+func (r *Bolt) ImportUsers(ctx context.Context, users []domain.User) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		registered := tx.Bucket(registeredUsersBucket)
+
+		for _, user := range users {
+			key := make([]byte, 8)
+			binary.BigEndian.PutUint64(key, uint64(user.ID))
+			if registered.Get(key) != nil {
+				continue
+			}
+
+			data, err := json.Marshal(userRecord(user))
+			if err != nil {
+				return err
+			}
+
+			if err = registered.Put(key, data); err != nil {
+				return err
+			}
+
+			if uint64(user.ID) > registered.Sequence() {
+				if err = registered.SetSequence(uint64(user.ID)); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// ExportAPIKeys calls fn for every API key (including revoked ones) in order of their ids.
+func (r *Bolt) ExportAPIKeys(ctx context.Context, fn func(key domain.APIKey) error) error {
+	keys := make([]domain.APIKey, 0)
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(apiKeysBucket).ForEach(func(k, v []byte) error {
+			var rec apiKeyRecord
+			if err := json.Unmarshal(v, &rec); err != nil {
+				return err
+			}
+
+			keys = append(keys, rec.apiKey())
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ID < keys[j].ID
+	})
+
+	for _, key := range keys {
+		if err = fn(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImportAPIKeys saves API keys keeping their ids and revocation times in one transaction, keys which were already saved are skipped.
+func (r *Bolt) ImportAPIKeys(ctx context.Context, keys []domain.APIKey) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(apiKeysBucket)
+
+		ids := make(map[string]struct{})
+		err := b.ForEach(func(k, v []byte) error {
+			var rec apiKeyRecord
+			if err := json.Unmarshal(v, &rec); err != nil {
+				return err
+			}
+
+			ids[rec.ID] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if _, ok := ids[key.ID]; ok || b.Get([]byte(key.Hash)) != nil {
+				continue
+			}
+
+			data, err := json.Marshal(newAPIKeyRecord(key))
+			if err != nil {
+				return err
+			}
+
+			if err = b.Put([]byte(key.Hash), data); err != nil {
+				return err
+			}
+			ids[key.ID] = struct{}{}
+		}
+
+		return nil
+	})
+}
+
+// ExportRevisions calls fn for every revision of original URLs in order of short URLs and numbers of revisions.
+func (r *Bolt) ExportRevisions(ctx context.Context, fn func(rev domain.URLRevision) error) error {
+	revisions := make([]domain.URLRevision, 0)
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		parent := tx.Bucket(revisionsBucket)
+
+		return parent.ForEach(func(k, v []byte) error {
+			b := parent.Bucket(k)
+			if b == nil {
+				return nil
+			}
+
+			return b.ForEach(func(k, v []byte) error {
+				var rev domain.URLRevision
+				if err := json.Unmarshal(v, &rev); err != nil {
+					return err
+				}
+
+				revisions = append(revisions, rev)
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, rev := range revisions {
+		if err = fn(rev); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImportRevisions saves revisions keeping their numbers in one transaction. Revisions which were already saved
+// and revisions of unknown URLs are skipped. Sequences of buckets of revisions are moved forward, so numbers of imported
+// revisions are never given to new ones.
+func (r *Bolt) ImportRevisions(ctx context.Context, revisions []domain.URLRevision) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		for _, rev := range revisions {
+			if tx.Bucket(urlsBucket).Get([]byte(rev.ShortURL)) == nil {
+				continue
+			}
+
+			b, err := tx.Bucket(revisionsBucket).CreateBucketIfNotExists([]byte(rev.ShortURL))
+			if err != nil {
+				return err
+			}
+
+			key := make([]byte, 8)
+			binary.BigEndian.PutUint64(key, uint64(rev.Revision))
+			if b.Get(key) != nil {
+				continue
+			}
+
+			data, err := json.Marshal(rev)
+			if err != nil {
+				return err
+			}
+
+			if err = b.Put(key, data); err != nil {
+				return err
+			}
+
+			if uint64(rev.Revision) > b.Sequence() {
+				if err = b.SetSequence(uint64(rev.Revision)); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// CreateClicks saves clicks to the bucket of their short URLs.
+func (r *Bolt) CreateClicks(ctx context.Context, clicks []domain.Click) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		for _, click := range clicks {
+			b, err := tx.Bucket(clicksBucket).CreateBucketIfNotExists([]byte(click.ShortURL))
+			if err != nil {
+				return err
+			}
+
+			seq, err := b.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			data, err := json.Marshal(click)
+			if err != nil {
+				return err
+			}
+
+			key := make([]byte, 8)
+			binary.BigEndian.PutUint64(key, seq)
+			if err = b.Put(key, data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReadClicks gets all the clicks made by short URL in order they were saved.
+func (r *Bolt) ReadClicks(ctx context.Context, shortened string) ([]domain.Click, error) {
+	clicks := make([]domain.Click, 0)
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(clicksBucket).Bucket([]byte(shortened))
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			var click domain.Click
+			if err := json.Unmarshal(v, &click); err != nil {
+				return err
+			}
+
+			clicks = append(clicks, click)
+			return nil
+		})
+	})
+
+	return clicks, err
+}
+
+// CreateUser allocates id of a new user by sequence of registered users bucket and saves the user there.
+func (r *Bolt) CreateUser(ctx context.Context) (int64, error) {
+	var id int64
+
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		registered := tx.Bucket(registeredUsersBucket)
+
+		seq, err := registered.NextSequence()
+		if err != nil {
+			return err
+		}
+		id = int64(seq)
+
+		data, err := json.Marshal(userRecord{ID: id, CreatedAt: time.Now()})
+		if err != nil {
+			return err
+		}
+
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, seq)
+		return registered.Put(key, data)
+	})
+	if err != nil {
+		return -1, err
+	}
+
+	return id, nil
+}
+
+// CreateLegacyUser saves the user who got random id before the registry of users existed to registered users bucket,
+// sequence of the bucket is not changed, since it is already greater than such ids.
+func (r *Bolt) CreateLegacyUser(ctx context.Context, id int64) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		registered := tx.Bucket(registeredUsersBucket)
+
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, uint64(id))
+		if registered.Get(key) != nil {
+			return nil
+		}
+
+		data, err := json.Marshal(userRecord{ID: id, CreatedAt: time.Now()})
+		if err != nil {
+			return err
+		}
+
+		return registered.Put(key, data)
+	})
+}
+
+// CreateAPIKey saves API key in JSON by its hash.
+func (r *Bolt) CreateAPIKey(ctx context.Context, key domain.APIKey) error {
+	data, err := json.Marshal(newAPIKeyRecord(key))
+	if err != nil {
+		return err
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(apiKeysBucket).Put([]byte(key.Hash), data)
+	})
+}
+
+// ReadAPIKeys gets all API keys of the user (including revoked ones) in order of their creation.
+func (r *Bolt) ReadAPIKeys(ctx context.Context, userID int64) ([]domain.APIKey, error) {
+	keys := make([]domain.APIKey, 0)
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(apiKeysBucket).ForEach(func(k, v []byte) error {
+			var rec apiKeyRecord
+			if err := json.Unmarshal(v, &rec); err != nil {
+				return err
+			}
+
+			if rec.UserID == userID {
+				keys = append(keys, rec.apiKey())
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+
+	return keys, nil
+}
+
+// ReadAPIKeyByHash gets API key by its hash, ErrAPIKeyNotFound is returned if there is no such key.
+func (r *Bolt) ReadAPIKeyByHash(ctx context.Context, hash string) (domain.APIKey, error) {
+	var rec apiKeyRecord
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(apiKeysBucket).Get([]byte(hash))
+		if data == nil {
+			return domain.ErrAPIKeyNotFound
+		}
+
+		return json.Unmarshal(data, &rec)
+	})
+	if err != nil {
+		return domain.APIKey{}, err
+	}
+
+	return rec.apiKey(), nil
+}
+
+// RevokeAPIKey marks API key of the user as revoked, ErrAPIKeyNotFound is returned if the user has no such key.
+// Key which was already revoked keeps its revocation time.
+func (r *Bolt) RevokeAPIKey(ctx context.Context, userID int64, id string, at time.Time) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(apiKeysBucket)
+		c := b.Cursor()
+
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var rec apiKeyRecord
+			if err := json.Unmarshal(v, &rec); err != nil {
+				return err
+			}
+
+			if rec.UserID != userID || rec.ID != id {
+				continue
+			}
+
+			if rec.RevokedAt != nil {
+				return nil
+			}
+
+			rec.RevokedAt = &at
+			data, err := json.Marshal(rec)
+			if err != nil {
+				return err
+			}
+
+			// the key is copied, because the cursor's memory is not valid after Put
+			return b.Put(append([]byte(nil), k...), data)
+		}
+
+		return domain.ErrAPIKeyNotFound
+	})
+}
+
+// EnqueueDeletions saves URLs waiting to be deleted by sequence of deletions bucket, the sequence is also used as id.
+func (r *Bolt) EnqueueDeletions(ctx context.Context, tasks []domain.DeletionTask) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(deletionsBucket)
+		for _, task := range tasks {
+			seq, err := b.NextSequence()
+			if err != nil {
+				return err
+			}
+			task.ID = int64(seq)
+
+			data, err := json.Marshal(task)
+			if err != nil {
+				return err
+			}
+
+			key := make([]byte, 8)
+			binary.BigEndian.PutUint64(key, seq)
+			if err = b.Put(key, data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReadDeletions gets up to limit URLs waiting to be deleted, the ones which were queued first are returned first.
+func (r *Bolt) ReadDeletions(ctx context.Context, limit int) ([]domain.DeletionTask, error) {
+	tasks := make([]domain.DeletionTask, 0)
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(deletionsBucket).Cursor()
+		for k, v := c.First(); k != nil && len(tasks) < limit; k, v = c.Next() {
+			var task domain.DeletionTask
+			if err := json.Unmarshal(v, &task); err != nil {
+				return err
+			}
+
+			tasks = append(tasks, task)
+		}
+
+		return nil
+	})
+
+	return tasks, err
+}
+
+// AckDeletions removes URLs which were deleted from deletions bucket.
+func (r *Bolt) AckDeletions(ctx context.Context, ids []int64) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(deletionsBucket)
+		for _, id := range ids {
+			key := make([]byte, 8)
+			binary.BigEndian.PutUint64(key, uint64(id))
+			if err := b.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+func TestBolt(t *testing.T) {
+	location := filepath.Join(t.TempDir(), "urlshrt.db")
+
+	r, err := NewBolt(location)
+	require.NoError(t, err)
+	require.NoError(t, r.PingPg(context.Background()))
+
+	ctx1 := domain.WithIdentity(context.Background(), domain.Identity{UserID: 1})
+	ctx2 := domain.WithIdentity(context.Background(), domain.Identity{UserID: 2})
+
+	_, err = r.Create(ctx1, []state.URLStringJSON{{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru"}})
+	require.NoError(t, err)
+
+	shrt, err := r.Create(ctx1, []state.URLStringJSON{{UUID: 2, ShortURL: "cba", OriginalURL: "https://ya.ru"}})
+	var uErr *domain.UniqueError
+	require.True(t, errors.As(err, &uErr))
+	require.Equal(t, "abc", shrt)
+
+	// another user may save the same original URL
+	_, err = r.Create(ctx2, []state.URLStringJSON{{UUID: 2, ShortURL: "xyz", OriginalURL: "https://ya.ru"}})
+	require.NoError(t, err)
+
+	// the whole batch should be rolled back because of the existing short URL
+	err = r.CreateBatch(ctx2, []*state.URLStringJSON{{UUID: 2, ShortURL: "bca", OriginalURL: "https://mail.ru"}, {UUID: 3, ShortURL: "abc", OriginalURL: "https://hh.ru"}})
+	require.Error(t, err)
+
+	err = r.CreateBatch(ctx2, []*state.URLStringJSON{{UUID: 2, ShortURL: "bca", OriginalURL: "https://mail.ru"}, {UUID: 3, ShortURL: "cab", OriginalURL: "https://hh.ru"}})
+	require.NoError(t, err)
+
+	all, err := r.ReadAll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+
+	userURLs, err := r.ReadUserURLs(ctx2, domain.URLQuery{})
+	require.NoError(t, err)
+	require.Len(t, userURLs, 3)
+	require.Equal(t, "bca", userURLs[0].ShortURL)
+
+	statuses, err := r.DeleteUserURLs(context.Background(), []string{"abc", "bca", "nope"}, []int64{2, 2, 2})
+	require.NoError(t, err)
+	require.Equal(t, []domain.DeletionStatus{domain.DeletionNotOwned, domain.DeletionDeleted, domain.DeletionNotFound}, statuses)
+
+	// data should be kept after reopening the database
+	require.NoError(t, r.Close())
+	r, err = NewBolt(location)
+	require.NoError(t, err)
+	defer r.Close()
+
+	deleted, err := r.IsURLDeleted(context.Background(), "bca")
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	deleted, err = r.IsURLDeleted(context.Background(), "abc")
+	require.NoError(t, err)
+	require.False(t, deleted)
+
+	_, err = r.IsURLDeleted(context.Background(), "nope")
+	require.Error(t, err)
+
+	urlsAmount, usersAmount, err := r.CountURLsAndUsers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, urlsAmount)
+	require.Equal(t, 2, usersAmount)
+}
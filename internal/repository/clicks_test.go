@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+func TestClicks(t *testing.T) {
+	now := time.Now().UTC()
+	clicks := []domain.Click{
+		{ShortURL: "abc", Time: now, Referrer: "https://ya.ru", UserAgent: "curl/8.0", IP: "127.0.0.1"},
+		{ShortURL: "cba", Time: now},
+		{ShortURL: "abc", Time: now.Add(time.Minute)},
+	}
+
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		require.NoError(t, r.CreateClicks(context.Background(), clicks[:2]))
+		require.NoError(t, r.CreateClicks(context.Background(), clicks[2:]))
+
+		saved, err := r.ReadClicks(context.Background(), "abc")
+		require.NoError(t, err)
+		require.Len(t, saved, 2)
+		require.Equal(t, clicks[0].Referrer, saved[0].Referrer)
+		require.Equal(t, clicks[0].IP, saved[0].IP)
+		require.True(t, clicks[2].Time.Equal(saved[1].Time))
+
+		saved, err = r.ReadClicks(context.Background(), "nope")
+		require.NoError(t, err)
+		require.Empty(t, saved)
+	})
+}
+
+func TestReadOwner(t *testing.T) {
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		_, err := r.Create(userInScope(1, domain.DedupPerUser), []state.URLStringJSON{{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru/"}})
+		require.NoError(t, err)
+
+		owner, err := r.ReadOwner(context.Background(), "abc")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), owner)
+
+		_, err = r.ReadOwner(context.Background(), "nope")
+		require.ErrorIs(t, err, domain.ErrURLNotFound)
+	})
+}
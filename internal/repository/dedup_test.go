@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+// userInScope is a function to get context of the user whose original URLs are checked in the scope.
+func userInScope(uid int64, scope domain.DedupScope) context.Context {
+	return domain.WithDedupScope(domain.WithIdentity(context.Background(), domain.Identity{UserID: uid}), scope)
+}
+
+func TestGlobalDedup(t *testing.T) {
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		_, err := r.Create(userInScope(1, domain.DedupGlobal), []state.URLStringJSON{{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru/"}})
+		require.NoError(t, err)
+
+		// another user gets the URL which was already saved
+		shrt, err := r.Create(userInScope(2, domain.DedupGlobal), []state.URLStringJSON{{UUID: 2, ShortURL: "cba", OriginalURL: "https://ya.ru/"}})
+		var uErr *domain.UniqueError
+		require.ErrorAs(t, err, &uErr)
+		require.Equal(t, "abc", shrt)
+
+		err = r.CreateBatch(userInScope(2, domain.DedupGlobal), []*state.URLStringJSON{{UUID: 2, ShortURL: "cba", OriginalURL: "https://ya.ru/"}})
+		require.ErrorAs(t, err, &uErr)
+
+		_, err = r.Create(userInScope(2, domain.DedupGlobal), []state.URLStringJSON{{UUID: 2, ShortURL: "bca", OriginalURL: "https://mail.ru/"}})
+		require.NoError(t, err)
+
+		shrt, err = r.UpdateOriginal(userInScope(2, domain.DedupGlobal), "bca", "https://ya.ru/", time.Now())
+		require.ErrorAs(t, err, &uErr)
+		require.Equal(t, "abc", shrt)
+
+		// original URL which was changed is not found anymore
+		_, err = r.UpdateOriginal(userInScope(1, domain.DedupGlobal), "abc", "https://go.dev/", time.Now())
+		require.NoError(t, err)
+		_, err = r.UpdateOriginal(userInScope(2, domain.DedupGlobal), "bca", "https://ya.ru/", time.Now())
+		require.NoError(t, err)
+
+		// every user has own short URL in per user scope
+		_, err = r.Create(userInScope(3, domain.DedupPerUser), []state.URLStringJSON{{UUID: 3, ShortURL: "acb", OriginalURL: "https://go.dev/"}})
+		require.NoError(t, err)
+	})
+
+	// only one of the users who save the same original URL at once gets it saved
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		shorts := make(map[string]struct{})
+
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				short := string(rune('a'+i)) + "concurrent"
+				shrt, err := r.Create(userInScope(int64(10+i), domain.DedupGlobal),
+					[]state.URLStringJSON{{UUID: 10 + i, ShortURL: short, OriginalURL: "https://hh.ru/"}})
+				if err == nil {
+					shrt = short
+				}
+
+				mu.Lock()
+				shorts[shrt] = struct{}{}
+				mu.Unlock()
+			}(i)
+		}
+		wg.Wait()
+
+		require.Len(t, shorts, 1)
+	})
+}
+
+func TestBoltFillOriginalsIndex(t *testing.T) {
+	location := filepath.Join(t.TempDir(), "urlshrt.db")
+
+	b, err := NewBolt(location)
+	require.NoError(t, err)
+
+	_, err = b.Create(userInScope(1, domain.DedupPerUser), []state.URLStringJSON{{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru/"}})
+	require.NoError(t, err)
+
+	// database was created before the index of original URLs existed
+	require.NoError(t, b.db.Update(func(tx *bolt.Tx) error {
+		return tx.DeleteBucket(originalsIndexBucket)
+	}))
+	require.NoError(t, b.Close())
+
+	b, err = NewBolt(location)
+	require.NoError(t, err)
+	defer b.Close()
+
+	shrt, err := b.Create(userInScope(2, domain.DedupGlobal), []state.URLStringJSON{{UUID: 2, ShortURL: "cba", OriginalURL: "https://ya.ru/"}})
+	var uErr *domain.UniqueError
+	require.ErrorAs(t, err, &uErr)
+	require.Equal(t, "abc", shrt)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+func TestDeleteURLsWithoutOwner(t *testing.T) {
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		// URL saved before it got owner has no user id
+		_, err := r.Create(context.Background(), []state.URLStringJSON{{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru"}})
+		require.NoError(t, err)
+
+		ctx := domain.WithIdentity(context.Background(), domain.Identity{UserID: domain.FirstUserID})
+		_, err = r.Create(ctx, []state.URLStringJSON{{UUID: 2, ShortURL: "cba", OriginalURL: "https://mail.ru"}})
+		require.NoError(t, err)
+
+		// nobody owns URL without owner, user without id owns nothing
+		statuses, err := r.DeleteUserURLs(context.Background(), []string{"abc", "cba", "abc"}, []int64{-1, -1, domain.FirstUserID})
+		require.NoError(t, err)
+		require.Equal(t, []domain.DeletionStatus{domain.DeletionNotOwned, domain.DeletionNotOwned, domain.DeletionNotOwned}, statuses)
+
+		for _, short := range []string{"abc", "cba"} {
+			deleted, err := r.IsURLDeleted(context.Background(), short)
+			require.NoError(t, err)
+			require.False(t, deleted, short)
+		}
+	})
+}
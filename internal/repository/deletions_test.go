@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+func TestDeletionOutbox(t *testing.T) {
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		require.NoError(t, r.EnqueueDeletions(context.Background(), []domain.DeletionTask{
+			{ShortURL: "abc", UserID: 1, JobID: "job1"},
+			{ShortURL: "cba", UserID: 1, JobID: "job1"},
+		}))
+		require.NoError(t, r.EnqueueDeletions(context.Background(), []domain.DeletionTask{
+			{ShortURL: "bca", UserID: 2, JobID: "job2"},
+		}))
+
+		// URLs are read in order they were queued
+		tasks, err := r.ReadDeletions(context.Background(), 2)
+		require.NoError(t, err)
+		require.Len(t, tasks, 2)
+		require.Equal(t, "abc", tasks[0].ShortURL)
+		require.Equal(t, "cba", tasks[1].ShortURL)
+		require.Less(t, tasks[0].ID, tasks[1].ID)
+
+		require.NoError(t, r.AckDeletions(context.Background(), []int64{tasks[0].ID, tasks[1].ID}))
+
+		tasks, err = r.ReadDeletions(context.Background(), 10)
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		require.Equal(t, domain.DeletionTask{ID: tasks[0].ID, ShortURL: "bca", UserID: 2, JobID: "job2"}, tasks[0])
+
+		// URLs which were not acknowledged are deleted after restart
+		r = reopen(t, r)
+
+		tasks, err = r.ReadDeletions(context.Background(), 10)
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		require.Equal(t, "bca", tasks[0].ShortURL)
+
+		// ids are not given again after restart
+		require.NoError(t, r.EnqueueDeletions(context.Background(), []domain.DeletionTask{{ShortURL: "xyz", UserID: 2}}))
+		next, err := r.ReadDeletions(context.Background(), 10)
+		require.NoError(t, err)
+		require.Len(t, next, 2)
+		require.Greater(t, next[1].ID, tasks[0].ID)
+
+		require.NoError(t, r.AckDeletions(context.Background(), []int64{next[0].ID, next[1].ID}))
+		next, err = r.ReadDeletions(context.Background(), 10)
+		require.NoError(t, err)
+		require.Empty(t, next)
+	})
+}
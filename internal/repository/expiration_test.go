@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+func TestDeleteExpiredURLs(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		ctx := domain.WithIdentity(context.Background(), domain.Identity{UserID: 1})
+
+		_, err := r.Create(ctx, []state.URLStringJSON{
+			{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru", ExpiresAt: &past},
+			{UUID: 2, ShortURL: "cba", OriginalURL: "https://mail.ru", ExpiresAt: &future},
+			{UUID: 3, ShortURL: "bca", OriginalURL: "https://hh.ru", NotBefore: &future},
+		})
+		require.NoError(t, err)
+
+		marked, err := r.DeleteExpiredURLs(context.Background(), now)
+		require.NoError(t, err)
+		require.Equal(t, 1, marked)
+
+		// URLs which were already marked are not counted again
+		marked, err = r.DeleteExpiredURLs(context.Background(), now)
+		require.NoError(t, err)
+		require.Zero(t, marked)
+
+		for shrt, expected := range map[string]bool{"abc": true, "cba": false, "bca": false} {
+			deleted, err := r.IsURLDeleted(context.Background(), shrt)
+			require.NoError(t, err)
+			require.Equal(t, expected, deleted, shrt)
+		}
+
+		all, err := r.ReadAll(context.Background())
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+		require.NotNil(t, all[1].ExpiresAt)
+		require.True(t, future.Equal(*all[1].ExpiresAt))
+		require.NotNil(t, all[2].NotBefore)
+	})
+}
@@ -0,0 +1,1481 @@
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+const (
+	// fileFormatVersion is a version of records written to the file. Records without version are
+	// treated as records of the first version, which had neither owner nor deletion flag.
+	fileFormatVersion = 2
+
+	opCreate = "create"
+	opDelete = "delete"
+	opRevoke = "revoke"
+	opAck    = "ack"
+	// opRestore unmarks deleted URL of the user, opPurge removes URL for good, so its short URL may be used again
+	opRestore = "restore"
+	opPurge   = "purge"
+	// opUpdate changes original URL of URL of the user, opTags replaces tags of URL of the user
+	opUpdate = "update"
+	opTags   = "tags"
+	// opDisable marks URL as disabled because its original URL matches a rule of destinations which can't be shortened
+	opDisable = "disable"
+
+	// clicksFileSuffix is added to location of the file to get location of the file where clicks are stored,
+	// clicks are kept apart from URLs, so they are never loaded to memory and don't slow down compaction
+	clicksFileSuffix = ".clicks"
+	// usersFileSuffix is added to location of the file to get location of the file where registered users are stored
+	usersFileSuffix = ".users"
+	// apiKeysFileSuffix is added to location of the file to get location of the file where API keys are stored
+	apiKeysFileSuffix = ".apikeys"
+	// deletionsFileSuffix is added to location of the file to get location of the file where URLs waiting to be deleted are stored
+	deletionsFileSuffix = ".deletions"
+	// revisionsFileSuffix is added to location of the file to get location of the file where changes of original URLs are stored
+	revisionsFileSuffix = ".revisions"
+)
+
+// fileRecord is a type which represents one line of the file. Record with create operation saves URL,
+// record with delete operation is a tombstone which marks URL of the user as deleted, records with restore
+// and purge operations unmark deleted URL of the user and remove it, record with update operation changes its original URL
+// and time of update, record with tags operation replaces its tags, record with disable operation marks it as disabled.
+type fileRecord struct {
+	Version     int        `json:"version,omitempty"`
+	Op          string     `json:"op,omitempty"`
+	ShortURL    string     `json:"short_url"`
+	OriginalURL string     `json:"original_url,omitempty"`
+	UUID        int        `json:"uuid,omitempty"`
+	UserID      int64      `json:"user_id"`
+	IsDeleted   bool       `json:"is_deleted,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	NotBefore   *time.Time `json:"not_before,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Title       string     `json:"title,omitempty"`
+	Note        string     `json:"note,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	IsDisabled  bool       `json:"is_disabled,omitempty"`
+}
+
+// userRecord is a type which represents one line of the file of users.
+type userRecord struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// apiKeyRecord is a type which represents one line of the file of API keys. Record with create operation saves API key,
+// record with revoke operation marks API key of the user as revoked. It is also used to store API keys in bolt.
+type apiKeyRecord struct {
+	Op        string     `json:"op,omitempty"`
+	ID        string     `json:"id"`
+	UserID    int64      `json:"user_id"`
+	Name      string     `json:"name,omitempty"`
+	Prefix    string     `json:"prefix,omitempty"`
+	Hash      string     `json:"hash,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+func newAPIKeyRecord(key domain.APIKey) apiKeyRecord {
+	return apiKeyRecord{ID: key.ID, UserID: key.UserID, Name: key.Name, Prefix: key.Prefix, Hash: key.Hash,
+		CreatedAt: key.CreatedAt, RevokedAt: key.RevokedAt}
+}
+
+func (rec apiKeyRecord) apiKey() domain.APIKey {
+	return domain.APIKey{ID: rec.ID, UserID: rec.UserID, Name: rec.Name, Prefix: rec.Prefix, Hash: rec.Hash,
+		CreatedAt: rec.CreatedAt, RevokedAt: rec.RevokedAt}
+}
+
+// deletionRecord is a type which represents one line of the file of URLs waiting to be deleted. Record with create
+// operation puts URL to outbox, record with ack operation removes URL with the same id from outbox.
+type deletionRecord struct {
+	Op string `json:"op"`
+	domain.DeletionTask
+}
+
+// File is a type which stores URL data in append-only file with JSON records (one per line).
+// All the URLs are also kept in memory, so the file is read only once.
+type File struct {
+	location string
+	index    *Memory
+	// records is an amount of records in the file, if it is greater than amount of URLs, the file may be compacted
+	records int
+	*sync.Mutex
+}
+
+func NewFile(location string) *File {
+	return &File{location: location, Mutex: &sync.Mutex{}}
+}
+
+func newCreateRecord(url state.URLStringJSON) fileRecord {
+	return fileRecord{Version: fileFormatVersion, Op: opCreate, ShortURL: url.ShortURL, OriginalURL: url.OriginalURL,
+		UUID: url.UUID, UserID: url.UserID, IsDeleted: url.IsDeleted, DeletedAt: url.DeletedAt, ExpiresAt: url.ExpiresAt,
+		NotBefore: url.NotBefore, CreatedAt: url.CreatedAt, UpdatedAt: url.UpdatedAt, Title: url.Title, Note: url.Note, Tags: url.Tags,
+		IsDisabled: url.IsDisabled}
+}
+
+// newOpRecord is a function to make record of operation with URL which was already saved (e.g. tombstone).
+func newOpRecord(op string, url state.URLStringJSON) fileRecord {
+	return fileRecord{Version: fileFormatVersion, Op: op, ShortURL: url.ShortURL, UserID: url.UserID, DeletedAt: url.DeletedAt}
+}
+
+// apply applies record from the file to URLs which are kept in memory.
+func (rec fileRecord) apply(urls *Memory) {
+	switch {
+	case rec.Version == 0:
+		urls.put(state.URLStringJSON{ShortURL: rec.ShortURL, OriginalURL: rec.OriginalURL, UUID: rec.UUID, UserID: -1})
+	case rec.Op == opCreate:
+		urls.put(state.URLStringJSON{ShortURL: rec.ShortURL, OriginalURL: rec.OriginalURL, UUID: rec.UUID,
+			UserID: rec.UserID, IsDeleted: rec.IsDeleted, DeletedAt: rec.DeletedAt, ExpiresAt: rec.ExpiresAt, NotBefore: rec.NotBefore,
+			CreatedAt: rec.CreatedAt, UpdatedAt: rec.UpdatedAt, Title: rec.Title, Note: rec.Note, Tags: rec.Tags, IsDisabled: rec.IsDisabled})
+	case rec.Op == opDelete:
+		if url, ok := urls.urls[rec.ShortURL]; ok && url.UserID == rec.UserID {
+			url.IsDeleted, url.DeletedAt = true, rec.DeletedAt
+			urls.urls[rec.ShortURL] = url
+		}
+	case rec.Op == opRestore:
+		if url, ok := urls.urls[rec.ShortURL]; ok && url.UserID == rec.UserID {
+			url.IsDeleted, url.DeletedAt = false, nil
+			urls.urls[rec.ShortURL] = url
+		}
+	case rec.Op == opPurge:
+		if url, ok := urls.urls[rec.ShortURL]; ok && url.UserID == rec.UserID {
+			urls.remove(url)
+		}
+	case rec.Op == opUpdate:
+		if url, ok := urls.urls[rec.ShortURL]; ok && url.UserID == rec.UserID {
+			urls.setOriginal(rec.ShortURL, rec.OriginalURL, rec.UpdatedAt)
+		}
+	case rec.Op == opTags:
+		if url, ok := urls.urls[rec.ShortURL]; ok && url.UserID == rec.UserID {
+			urls.setTags(rec.ShortURL, rec.Tags)
+		}
+	case rec.Op == opDisable:
+		if url, ok := urls.urls[rec.ShortURL]; ok && url.UserID == rec.UserID {
+			url.IsDisabled = true
+			urls.urls[rec.ShortURL] = url
+		}
+	default:
+		util.GetLogger().Infoln("unknown operation in file record", rec.Op)
+	}
+}
+
+// load reads all the records from the file to memory if it was not done yet. If the last line of the file
+// is torn (which may happen if the app crashed while writing), it is cut off. The caller should hold the lock.
+func (r *File) load() error {
+	if r.index != nil {
+		return nil
+	}
+
+	index := NewMemory()
+
+	// users may be registered before they save any URL, so the file of users is read even if there is no file of URLs
+	if r.location != "" {
+		if err := loadUsers(r.location+usersFileSuffix, index); err != nil {
+			return err
+		}
+
+		if err := loadAPIKeys(r.location+apiKeysFileSuffix, index); err != nil {
+			return err
+		}
+
+		if err := loadDeletions(r.location+deletionsFileSuffix, index); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.Open(r.location)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			r.index = index
+			return nil
+		}
+		return err
+	}
+
+	defer func() {
+		if err := f.Close(); err != nil {
+			util.GetLogger().Infoln(err)
+		}
+	}()
+
+	var offset int64
+	var records int
+	reader := bufio.NewReader(f)
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return readErr
+		}
+
+		if len(bytes.TrimSpace(line)) != 0 {
+			var rec fileRecord
+			if err = json.Unmarshal(line, &rec); err != nil {
+				if !errors.Is(readErr, io.EOF) {
+					return err
+				}
+
+				util.GetLogger().Infoln("cutting off torn line at the end of file", r.location, "offset", offset)
+				if err = os.Truncate(r.location, offset); err != nil {
+					return err
+				}
+				break
+			}
+
+			rec.apply(index)
+			records++
+
+			if errors.Is(readErr, io.EOF) && line[len(line)-1] != '\n' {
+				// the record is complete, but the next one shouldn't be written to the same line
+				if err = r.appendBytes([]byte{'\n'}); err != nil {
+					return err
+				}
+			}
+		}
+
+		offset += int64(len(line))
+
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+	}
+
+	// revisions are read after URLs, so revisions of URLs which were removed are skipped
+	if err = loadRevisions(r.location+revisionsFileSuffix, index); err != nil {
+		return err
+	}
+
+	r.index = index
+	r.records = records
+
+	// tombstones written before time of deletion was saved have no time, so URLs get time of loading and it is saved
+	backfilled := r.index.backfillDeletedAt(time.Now())
+	tombstones := make([]fileRecord, len(backfilled))
+	for i, url := range backfilled {
+		tombstones[i] = newOpRecord(opDelete, url)
+	}
+
+	return r.appendRecords(tombstones)
+}
+
+// loadUsers reads the file of users, so ids which were already given are not given again. Torn lines are skipped.
+func loadUsers(location string, index *Memory) error {
+	f, err := os.Open(location)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var user userRecord
+		if err = json.Unmarshal(scanner.Bytes(), &user); err != nil {
+			util.GetLogger().Infoln("skipping torn user record", err)
+			continue
+		}
+
+		index.users[user.ID] = domain.User(user)
+		if user.ID > index.lastUserID {
+			index.lastUserID = user.ID
+		}
+	}
+
+	return scanner.Err()
+}
+
+// loadAPIKeys reads the file of API keys to memory. Torn lines are skipped.
+func loadAPIKeys(location string, index *Memory) error {
+	f, err := os.Open(location)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec apiKeyRecord
+		if err = json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			util.GetLogger().Infoln("skipping torn API key record", err)
+			continue
+		}
+
+		switch rec.Op {
+		case opCreate:
+			index.apiKeys[rec.Hash] = rec.apiKey()
+		case opRevoke:
+			if rec.RevokedAt == nil {
+				continue
+			}
+
+			if err = index.revokeAPIKey(rec.UserID, rec.ID, *rec.RevokedAt); err != nil {
+				util.GetLogger().Infoln("skipping revocation of unknown API key", rec.ID)
+			}
+		default:
+			util.GetLogger().Infoln("unknown operation in API key record", rec.Op)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// loadDeletions reads the file of URLs waiting to be deleted to memory. Torn lines are skipped.
+func loadDeletions(location string, index *Memory) error {
+	f, err := os.Open(location)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec deletionRecord
+		if err = json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			util.GetLogger().Infoln("skipping torn deletion record", err)
+			continue
+		}
+
+		switch rec.Op {
+		case opCreate:
+			index.putDeletions([]domain.DeletionTask{rec.DeletionTask})
+		case opAck:
+			index.ackDeletions([]int64{rec.ID})
+		default:
+			util.GetLogger().Infoln("unknown operation in deletion record", rec.Op)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// loadRevisions reads the file of changes of original URLs to memory. Torn lines and revisions of unknown URLs are skipped.
+func loadRevisions(location string, index *Memory) error {
+	f, err := os.Open(location)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rev domain.URLRevision
+		if err = json.Unmarshal(scanner.Bytes(), &rev); err != nil {
+			util.GetLogger().Infoln("skipping torn revision record", err)
+			continue
+		}
+
+		if _, ok := index.urls[rev.ShortURL]; !ok {
+			continue
+		}
+
+		index.revisions[rev.ShortURL] = append(index.revisions[rev.ShortURL], rev)
+	}
+
+	return scanner.Err()
+}
+
+// appendBytes writes data to the end of the file and waits for it to be flushed to disk.
+func (r *File) appendBytes(data []byte) error {
+	return appendToFile(r.location, data)
+}
+
+// appendToFile writes data to the end of the file at location and waits for it to be flushed to disk.
+func appendToFile(location string, data []byte) error {
+	err := os.MkdirAll(filepath.Dir(location), 0700)
+	if err != nil {
+		util.GetLogger().Infoln("save mkdir", err)
+		return err
+	}
+
+	f, err := os.OpenFile(location, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		util.GetLogger().Infoln("save", err)
+		return err
+	}
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// appendRecords writes records to the end of the file. The caller should hold the lock.
+func (r *File) appendRecords(records []fileRecord) error {
+	if r.location == "" || len(records) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, rec := range records {
+		if err := enc.Encode(rec); err != nil {
+			return err
+		}
+	}
+
+	// records are written with one call, so after a crash only the last of them may be torn
+	if err := r.appendBytes(buf.Bytes()); err != nil {
+		return err
+	}
+
+	r.records += len(records)
+
+	return nil
+}
+
+// Compact rewrites the file leaving only one record for every URL, so tombstones and superseded records are dropped.
+// New file is written next to the old one and then renamed, so the file is never left half-written.
+func (r *File) Compact() error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	if r.location == "" || r.records <= len(r.index.urls) {
+		return nil
+	}
+
+	urls, err := r.index.ReadAll(context.Background())
+	if err != nil {
+		return err
+	}
+
+	err = replaceFile(r.location, func(enc *json.Encoder) error {
+		for _, url := range urls {
+			if err := enc.Encode(newCreateRecord(url)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	util.GetLogger().Infoln("file", r.location, "compacted from", r.records, "to", len(urls), "records")
+	r.records = len(urls)
+
+	return nil
+}
+
+// replaceFile writes new content of the file at location with write. New file is written next to the old one and then renamed,
+// so the file is never left half-written.
+func replaceFile(location string, write func(enc *json.Encoder) error) error {
+	dir := filepath.Dir(location)
+	tmp, err := os.CreateTemp(dir, filepath.Base(location)+".compact-*")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := os.Remove(tmp.Name()); err != nil && !errors.Is(err, os.ErrNotExist) {
+			util.GetLogger().Infoln(err)
+		}
+	}()
+
+	w := bufio.NewWriter(tmp)
+	if err = write(json.NewEncoder(w)); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err = w.Flush(); err == nil {
+		err = tmp.Sync()
+	}
+
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err = os.Rename(tmp.Name(), location); err != nil {
+		return err
+	}
+
+	// the rename should be flushed to disk too
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	if err = d.Sync(); err != nil {
+		util.GetLogger().Infoln("sync dir", err)
+	}
+
+	return nil
+}
+
+// RunCompaction compacts the file every interval until the context is done.
+func (r *File) RunCompaction(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Compact(); err != nil {
+				util.GetLogger().Infoln("compaction", err)
+			}
+		}
+	}
+}
+
+func (r *File) PingPg(ctx context.Context) error {
+	return errors.New("postgres is not used with file storage")
+}
+
+// ReadAll gets all URLs from the file.
+func (r *File) ReadAll(ctx context.Context) ([]state.URLStringJSON, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r.index.ReadAll(ctx)
+}
+
+// Create saves URLs to the file. If an original URL was already saved in the scope from context, its short version is returned with UniqueError.
+func (r *File) Create(ctx context.Context, urls []state.URLStringJSON) (string, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return "", err
+	}
+
+	id := domain.UserIDFromContext(ctx)
+	toSave := make([]state.URLStringJSON, 0, len(urls))
+
+	var shrt string
+	var checkErr error
+	for _, url := range urls {
+		url.UserID = id
+		url.IsDeleted, url.DeletedAt = false, nil
+
+		if shrt, checkErr = r.index.checkURL(url, domain.DedupScopeFromContext(ctx)); checkErr != nil {
+			break
+		}
+
+		toSave = append(toSave, url)
+	}
+
+	records := make([]fileRecord, 0, len(toSave))
+	for _, url := range toSave {
+		records = append(records, newCreateRecord(url))
+	}
+
+	if err := r.appendRecords(records); err != nil {
+		return "", err
+	}
+
+	for _, url := range toSave {
+		r.index.put(url)
+	}
+
+	return shrt, checkErr
+}
+
+// CreateBatch saves URLs from batch to the file. Nothing is saved if any of the URLs already exist.
+func (r *File) CreateBatch(ctx context.Context, batch []*state.URLStringJSON) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	id := domain.UserIDFromContext(ctx)
+
+	if err := r.index.checkBatch(batch, id, domain.DedupScopeFromContext(ctx)); err != nil {
+		return err
+	}
+
+	toSave := make([]state.URLStringJSON, 0, len(batch))
+	records := make([]fileRecord, 0, len(batch))
+	for _, url := range batch {
+		u := *url
+		u.UserID = id
+		u.IsDeleted, u.DeletedAt = false, nil
+		toSave = append(toSave, u)
+		records = append(records, newCreateRecord(u))
+	}
+
+	if err := r.appendRecords(records); err != nil {
+		return err
+	}
+
+	for _, url := range toSave {
+		r.index.put(url)
+	}
+
+	return nil
+}
+
+// DeleteExpiredURLs appends tombstones for URLs whose expiration time has come, amount of marked URLs is returned.
+func (r *File) DeleteExpiredURLs(ctx context.Context, now time.Time) (int, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return 0, err
+	}
+
+	marked := r.index.markExpired(now)
+
+	records := make([]fileRecord, 0, len(marked))
+	for _, url := range marked {
+		records = append(records, newOpRecord(opDelete, url))
+	}
+
+	if err := r.appendRecords(records); err != nil {
+		return 0, err
+	}
+
+	for _, url := range marked {
+		r.index.urls[url.ShortURL] = url
+	}
+
+	return len(marked), nil
+}
+
+// ReadUserURLs gets URLs created by user whose id is in context which match query.
+func (r *File) ReadUserURLs(ctx context.Context, query domain.URLQuery) ([]state.URLStringJSON, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r.index.ReadUserURLs(ctx, query)
+}
+
+// ReadOwner gets id of the user who saved URL (-1 if the URL has no owner), ErrURLNotFound is returned if there is no such URL.
+func (r *File) ReadOwner(ctx context.Context, shortened string) (int64, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return -1, err
+	}
+
+	return r.index.ReadOwner(ctx, shortened)
+}
+
+// DeleteUserURLs appends tombstones for URLs which belong to users with ids of the same indexes.
+// Statuses of the URLs are returned in the same order.
+func (r *File) DeleteUserURLs(ctx context.Context, shortURLs []string, uid []int64) ([]domain.DeletionStatus, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	marked, statuses, err := r.index.markDeleted(shortURLs, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]fileRecord, 0, len(marked))
+	for _, url := range marked {
+		records = append(records, newOpRecord(opDelete, url))
+	}
+
+	if err = r.appendRecords(records); err != nil {
+		return nil, err
+	}
+
+	for _, url := range marked {
+		r.index.urls[url.ShortURL] = url
+	}
+
+	return statuses, nil
+}
+
+// RestoreUserURLs appends records which unmark deleted URLs of the user whose id is in context if they were deleted after deletedAfter.
+// Statuses of the URLs are returned in the same order.
+func (r *File) RestoreUserURLs(ctx context.Context, shortURLs []string, deletedAfter time.Time) ([]domain.RestoreStatus, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	restored, statuses := r.index.markRestored(shortURLs, domain.UserIDFromContext(ctx), deletedAfter)
+
+	records := make([]fileRecord, 0, len(restored))
+	for _, url := range restored {
+		records = append(records, newOpRecord(opRestore, url))
+	}
+
+	if err := r.appendRecords(records); err != nil {
+		return nil, err
+	}
+
+	for _, url := range restored {
+		r.index.urls[url.ShortURL] = url
+	}
+
+	return statuses, nil
+}
+
+// PurgeDeletedURLs appends records which remove URLs deleted before deletedBefore, their clicks and revisions are removed
+// from the files of clicks and revisions too.
+// Short versions of removed URLs are returned.
+func (r *File) PurgeDeletedURLs(ctx context.Context, deletedBefore time.Time) ([]string, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	urls := r.index.purgeable(deletedBefore)
+	if len(urls) == 0 {
+		return make([]string, 0), nil
+	}
+
+	records := make([]fileRecord, len(urls))
+	purged := make([]string, len(urls))
+	for i, url := range urls {
+		records[i] = newOpRecord(opPurge, url)
+		purged[i] = url.ShortURL
+	}
+
+	// revisions are removed before URLs, so revisions of removed URLs are never read as revisions of new URLs with the same short URLs
+	if err := r.dropRevisions(purged); err != nil {
+		return nil, err
+	}
+
+	if err := r.appendRecords(records); err != nil {
+		return nil, err
+	}
+
+	for _, url := range urls {
+		r.index.remove(url)
+	}
+
+	// short URLs may be used again, so clicks made by removed URLs should not be counted as clicks of new ones
+	if err := r.dropClicks(purged); err != nil {
+		return nil, err
+	}
+
+	return purged, nil
+}
+
+// dropClicks rewrites the file of clicks without clicks made by short URLs. The caller should hold the lock.
+func (r *File) dropClicks(shortURLs []string) error {
+	if r.location == "" {
+		return nil
+	}
+
+	location := r.location + clicksFileSuffix
+	f, err := os.Open(location)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dropped := make(map[string]struct{}, len(shortURLs))
+	for _, shrt := range shortURLs {
+		dropped[shrt] = struct{}{}
+	}
+
+	return replaceFile(location, func(enc *json.Encoder) error {
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var click domain.Click
+			if err := json.Unmarshal(scanner.Bytes(), &click); err != nil {
+				util.GetLogger().Infoln("skipping torn click record", err)
+				continue
+			}
+
+			if _, ok := dropped[click.ShortURL]; ok {
+				continue
+			}
+
+			if err := enc.Encode(click); err != nil {
+				return err
+			}
+		}
+
+		return scanner.Err()
+	})
+}
+
+// dropRevisions rewrites the file of revisions without revisions of short URLs, the file is not rewritten if none of the short URLs
+// have revisions. The caller should hold the lock.
+func (r *File) dropRevisions(shortURLs []string) error {
+	if r.location == "" {
+		return nil
+	}
+
+	dropped := make(map[string]struct{}, len(shortURLs))
+	var found bool
+	for _, shrt := range shortURLs {
+		dropped[shrt] = struct{}{}
+		found = found || len(r.index.revisions[shrt]) > 0
+	}
+
+	if !found {
+		return nil
+	}
+
+	return replaceFile(r.location+revisionsFileSuffix, func(enc *json.Encoder) error {
+		for shrt, revisions := range r.index.revisions {
+			if _, ok := dropped[shrt]; ok {
+				continue
+			}
+
+			for _, rev := range revisions {
+				if err := enc.Encode(rev); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// UpdateOriginal appends record which changes original URL of URL of the user whose id is in context, revision of the change
+// is appended to the file of revisions. If the original URL was already saved in the scope from context with another short URL,
+// that short URL is returned with UniqueError.
+func (r *File) UpdateOriginal(ctx context.Context, shortened string, original string, at time.Time) (string, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return "", err
+	}
+
+	id := domain.UserIDFromContext(ctx)
+	rev, shrt, err := r.index.revise(shortened, original, id, at, domain.DedupScopeFromContext(ctx))
+	if err != nil || rev == nil {
+		return shrt, err
+	}
+
+	// revision is written first, so original URL is never changed without revision after a crash
+	if r.location != "" {
+		data, err := json.Marshal(rev)
+		if err != nil {
+			return "", err
+		}
+
+		if err = appendToFile(r.location+revisionsFileSuffix, append(data, '\n')); err != nil {
+			return "", err
+		}
+	}
+
+	rec := fileRecord{Version: fileFormatVersion, Op: opUpdate, ShortURL: shortened, OriginalURL: original, UserID: id, UpdatedAt: &at}
+	if err = r.appendRecords([]fileRecord{rec}); err != nil {
+		return "", err
+	}
+
+	r.index.setOriginal(shortened, original, &at)
+	r.index.revisions[shortened] = append(r.index.revisions[shortened], *rev)
+
+	return "", nil
+}
+
+// ReadRevisions gets revisions of URL of the user whose id is in context in order they were made.
+func (r *File) ReadRevisions(ctx context.Context, shortened string) ([]domain.URLRevision, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r.index.ReadRevisions(ctx, shortened)
+}
+
+// UpdateTags adds tags to URL of the user whose id is in context and removes tags from it, tags of the URL after the change are returned.
+func (r *File) UpdateTags(ctx context.Context, shortened string, added []string, removed []string) ([]string, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	id := domain.UserIDFromContext(ctx)
+	tags, err := r.index.retag(shortened, added, removed, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := fileRecord{Version: fileFormatVersion, Op: opTags, ShortURL: shortened, UserID: id, Tags: tags}
+	if err = r.appendRecords([]fileRecord{rec}); err != nil {
+		return nil, err
+	}
+
+	r.index.setTags(shortened, tags)
+
+	return tags, nil
+}
+
+// ReadTags counts URLs of the user whose id is in context by their tags.
+func (r *File) ReadTags(ctx context.Context) ([]domain.TagCount, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r.index.ReadTags(ctx)
+}
+
+// DisableURLs appends records which mark URLs as disabled, so they don't redirect anywhere. Unknown short URLs are skipped.
+func (r *File) DisableURLs(ctx context.Context, shortURLs []string) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	marked := r.index.markDisabled(shortURLs)
+
+	records := make([]fileRecord, 0, len(marked))
+	for _, url := range marked {
+		records = append(records, newOpRecord(opDisable, url))
+	}
+
+	if err := r.appendRecords(records); err != nil {
+		return err
+	}
+
+	for _, url := range marked {
+		r.index.urls[url.ShortURL] = url
+	}
+
+	return nil
+}
+
+func (r *File) IsURLDeleted(ctx context.Context, shortened string) (bool, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return false, err
+	}
+
+	return r.index.IsURLDeleted(ctx, shortened)
+}
+
+// CountURLsAndUsers counts URLs which are not deleted and users who have at least one such URL.
+func (r *File) CountURLsAndUsers(ctx context.Context) (int, int, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return 0, 0, err
+	}
+
+	return r.index.CountURLsAndUsers(ctx)
+}
+
+// Export calls fn for every URL in order of their creation.
+func (r *File) Export(ctx context.Context, fn func(url state.URLStringJSON) error) error {
+	urls, err := r.ReadAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, url := range urls {
+		if err = fn(url); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Import saves URLs keeping their owners and deletion flags. URLs which were already saved are skipped.
+func (r *File) Import(ctx context.Context, urls []state.URLStringJSON) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	toSave := make([]state.URLStringJSON, 0, len(urls))
+	records := make([]fileRecord, 0, len(urls))
+	for _, url := range urls {
+		needed, err := r.index.checkImported(url)
+		if err != nil {
+			return err
+		}
+
+		if needed {
+			toSave = append(toSave, url)
+			records = append(records, newCreateRecord(url))
+		}
+	}
+
+	if err := r.appendRecords(records); err != nil {
+		return err
+	}
+
+	for _, url := range toSave {
+		r.index.put(url)
+	}
+
+	return nil
+}
+
+// ExportUsers calls fn for every registered user in order of their ids.
+func (r *File) ExportUsers(ctx context.Context, fn func(user domain.User) error) error {
+	r.Lock()
+	err := r.load()
+	r.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return r.index.ExportUsers(ctx, fn)
+}
+
+// ImportUsers appends users to the file of users keeping their ids, users which were already saved are skipped.
+func (r *File) ImportUsers(ctx context.Context, users []domain.User) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	toSave := make([]domain.User, 0, len(users))
+	for _, user := range users {
+		if _, ok := r.index.users[user.ID]; !ok {
+			toSave = append(toSave, user)
+		}
+	}
+
+	if err := r.appendUsers(toSave); err != nil {
+		return err
+	}
+
+	return r.index.ImportUsers(ctx, toSave)
+}
+
+// ExportAPIKeys calls fn for every API key (including revoked ones) in order of their ids.
+func (r *File) ExportAPIKeys(ctx context.Context, fn func(key domain.APIKey) error) error {
+	r.Lock()
+	err := r.load()
+	r.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return r.index.ExportAPIKeys(ctx, fn)
+}
+
+// ImportAPIKeys appends API keys to the file of API keys keeping their ids and revocation times, keys which were already saved are skipped.
+func (r *File) ImportAPIKeys(ctx context.Context, keys []domain.APIKey) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	toSave := make([]domain.APIKey, 0, len(keys))
+	for _, key := range keys {
+		if r.index.hasAPIKey(key) {
+			continue
+		}
+
+		rec := newAPIKeyRecord(key)
+		rec.Op = opCreate
+		if err := enc.Encode(rec); err != nil {
+			return err
+		}
+		toSave = append(toSave, key)
+	}
+
+	if r.location != "" && len(toSave) != 0 {
+		if err := appendToFile(r.location+apiKeysFileSuffix, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return r.index.ImportAPIKeys(ctx, toSave)
+}
+
+// ExportRevisions calls fn for every revision of original URLs in order of short URLs and numbers of revisions.
+func (r *File) ExportRevisions(ctx context.Context, fn func(rev domain.URLRevision) error) error {
+	r.Lock()
+	err := r.load()
+	r.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return r.index.ExportRevisions(ctx, fn)
+}
+
+// ImportRevisions appends revisions to the file of revisions keeping their numbers. Revisions which were already saved
+// and revisions of unknown URLs are skipped.
+func (r *File) ImportRevisions(ctx context.Context, revisions []domain.URLRevision) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	toSave := make([]domain.URLRevision, 0, len(revisions))
+	for _, rev := range revisions {
+		if !r.index.needsRevision(rev) {
+			continue
+		}
+
+		if err := enc.Encode(rev); err != nil {
+			return err
+		}
+		toSave = append(toSave, rev)
+	}
+
+	if r.location != "" && len(toSave) != 0 {
+		if err := appendToFile(r.location+revisionsFileSuffix, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return r.index.ImportRevisions(ctx, toSave)
+}
+
+// CreateClicks appends clicks to the file of clicks (one JSON record per line).
+func (r *File) CreateClicks(ctx context.Context, clicks []domain.Click) error {
+	if r.location == "" || len(clicks) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, click := range clicks {
+		if err := enc.Encode(click); err != nil {
+			return err
+		}
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	return appendToFile(r.location+clicksFileSuffix, buf.Bytes())
+}
+
+// ReadClicks reads all the clicks made by short URL from the file of clicks. Torn lines are skipped.
+func (r *File) ReadClicks(ctx context.Context, shortened string) ([]domain.Click, error) {
+	clicks := make([]domain.Click, 0)
+	if r.location == "" {
+		return clicks, nil
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	f, err := os.Open(r.location + clicksFileSuffix)
+	if errors.Is(err, os.ErrNotExist) {
+		return clicks, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var click domain.Click
+		if err = json.Unmarshal(scanner.Bytes(), &click); err != nil {
+			util.GetLogger().Infoln("skipping torn click record", err)
+			continue
+		}
+
+		if click.ShortURL == shortened {
+			clicks = append(clicks, click)
+		}
+	}
+
+	return clicks, scanner.Err()
+}
+
+// CreateUser allocates id of a new user and appends it to the file of users, so the id is not given again after restart.
+func (r *File) CreateUser(ctx context.Context) (int64, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return -1, err
+	}
+
+	user := domain.User{ID: r.index.lastUserID + 1, CreatedAt: time.Now()}
+	if err := r.appendUsers([]domain.User{user}); err != nil {
+		return -1, err
+	}
+
+	r.index.users[user.ID] = user
+	r.index.lastUserID = user.ID
+
+	return user.ID, nil
+}
+
+// CreateLegacyUser appends the user who got random id before the registry of users existed to the file of users,
+// the user is appended only once.
+func (r *File) CreateLegacyUser(ctx context.Context, id int64) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	if _, ok := r.index.users[id]; ok {
+		return nil
+	}
+
+	user := domain.User{ID: id, CreatedAt: time.Now()}
+	if err := r.appendUsers([]domain.User{user}); err != nil {
+		return err
+	}
+
+	r.index.users[id] = user
+
+	return nil
+}
+
+// appendUsers appends users to the file of users in one write, the caller should hold the lock.
+func (r *File) appendUsers(users []domain.User) error {
+	if r.location == "" || len(users) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, user := range users {
+		if err := enc.Encode(userRecord(user)); err != nil {
+			return err
+		}
+	}
+
+	return appendToFile(r.location+usersFileSuffix, buf.Bytes())
+}
+
+// appendAPIKeyRecord appends record to the file of API keys, the caller should hold the lock.
+func (r *File) appendAPIKeyRecord(rec apiKeyRecord) error {
+	if r.location == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	return appendToFile(r.location+apiKeysFileSuffix, append(data, '\n'))
+}
+
+// CreateAPIKey appends API key to the file of API keys.
+func (r *File) CreateAPIKey(ctx context.Context, key domain.APIKey) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	rec := newAPIKeyRecord(key)
+	rec.Op = opCreate
+	if err := r.appendAPIKeyRecord(rec); err != nil {
+		return err
+	}
+
+	return r.index.CreateAPIKey(ctx, key)
+}
+
+// ReadAPIKeys gets all API keys of the user (including revoked ones) in order of their creation.
+func (r *File) ReadAPIKeys(ctx context.Context, userID int64) ([]domain.APIKey, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r.index.ReadAPIKeys(ctx, userID)
+}
+
+// ReadAPIKeyByHash gets API key by its hash, ErrAPIKeyNotFound is returned if there is no such key.
+func (r *File) ReadAPIKeyByHash(ctx context.Context, hash string) (domain.APIKey, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return domain.APIKey{}, err
+	}
+
+	return r.index.ReadAPIKeyByHash(ctx, hash)
+}
+
+// RevokeAPIKey appends revocation of API key to the file of API keys, ErrAPIKeyNotFound is returned if the user has no such key.
+func (r *File) RevokeAPIKey(ctx context.Context, userID int64, id string, at time.Time) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	key, err := r.index.findAPIKey(userID, id)
+	if err != nil {
+		return err
+	}
+
+	if key.RevokedAt != nil {
+		return nil
+	}
+
+	if err = r.appendAPIKeyRecord(apiKeyRecord{Op: opRevoke, ID: id, UserID: userID, RevokedAt: &at}); err != nil {
+		return err
+	}
+
+	return r.index.revokeAPIKey(userID, id, at)
+}
+
+// appendDeletionRecords appends records to the file of URLs waiting to be deleted, the caller should hold the lock.
+func (r *File) appendDeletionRecords(records []deletionRecord) error {
+	if r.location == "" {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, rec := range records {
+		if err := enc.Encode(rec); err != nil {
+			return err
+		}
+	}
+
+	return appendToFile(r.location+deletionsFileSuffix, buf.Bytes())
+}
+
+// EnqueueDeletions appends URLs to the file of URLs waiting to be deleted, so they are deleted even after restart.
+func (r *File) EnqueueDeletions(ctx context.Context, tasks []domain.DeletionTask) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	numbered := r.index.nextDeletions(tasks)
+	records := make([]deletionRecord, len(numbered))
+	for i, task := range numbered {
+		records[i] = deletionRecord{Op: opCreate, DeletionTask: task}
+	}
+
+	if err := r.appendDeletionRecords(records); err != nil {
+		return err
+	}
+
+	r.index.putDeletions(numbered)
+	return nil
+}
+
+// ReadDeletions gets up to limit URLs waiting to be deleted, the ones which were queued first are returned first.
+func (r *File) ReadDeletions(ctx context.Context, limit int) ([]domain.DeletionTask, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r.index.ReadDeletions(ctx, limit)
+}
+
+// AckDeletions removes URLs which were deleted from the file of URLs waiting to be deleted.
+// When no URLs are left, the file is emptied, so it doesn't grow forever.
+func (r *File) AckDeletions(ctx context.Context, ids []int64) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.load(); err != nil {
+		return err
+	}
+
+	r.index.ackDeletions(ids)
+
+	if r.location == "" {
+		return nil
+	}
+
+	if len(r.index.deletions) == 0 {
+		if err := os.Truncate(r.location+deletionsFileSuffix, 0); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+
+	records := make([]deletionRecord, len(ids))
+	for i, id := range ids {
+		records[i] = deletionRecord{Op: opAck, DeletionTask: domain.DeletionTask{ID: id}}
+	}
+
+	return r.appendDeletionRecords(records)
+}
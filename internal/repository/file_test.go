@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestFile(t *testing.T) {
+	location := filepath.Join(t.TempDir(), "db.json")
+
+	// a line in the format of the first version, which has neither owner nor version
+	legacy := "{\"short_url\":\"GqKWdrE\",\"original_url\":\"https://ya.ru\",\"uuid\":1}\n"
+	require.NoError(t, os.WriteFile(location, []byte(legacy), 0600))
+
+	r := NewFile(location)
+	require.Error(t, r.PingPg(context.Background()))
+
+	all, err := r.ReadAll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, int64(-1), all[0].UserID)
+
+	ctx := domain.WithIdentity(context.Background(), domain.Identity{UserID: 3})
+
+	// the legacy line has no owner, so the user may save the same original URL
+	_, err = r.Create(ctx, []state.URLStringJSON{{UUID: 2, ShortURL: "xyz", OriginalURL: "https://ya.ru"}})
+	require.NoError(t, err)
+
+	shrt, err := r.Create(ctx, []state.URLStringJSON{{UUID: 2, ShortURL: "zyx", OriginalURL: "https://ya.ru"}})
+	var uErr *domain.UniqueError
+	require.True(t, errors.As(err, &uErr))
+	require.Equal(t, "xyz", shrt)
+
+	_, err = r.Create(ctx, []state.URLStringJSON{{UUID: 2, ShortURL: "abc", OriginalURL: "https://mail.ru"}})
+	require.NoError(t, err)
+
+	require.NoError(t, r.CreateBatch(ctx, []*state.URLStringJSON{{UUID: 3, ShortURL: "cba", OriginalURL: "https://hh.ru"}}))
+
+	userURLs, err := r.ReadUserURLs(ctx, domain.URLQuery{})
+	require.NoError(t, err)
+	require.Len(t, userURLs, 3)
+
+	urlsAmount, usersAmount, err := r.CountURLsAndUsers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 4, urlsAmount)
+	require.Equal(t, 1, usersAmount)
+
+	statuses, err := r.DeleteUserURLs(context.Background(), []string{"abc", "GqKWdrE"}, []int64{3, 3})
+	require.NoError(t, err)
+	require.Equal(t, []domain.DeletionStatus{domain.DeletionDeleted, domain.DeletionNotOwned}, statuses)
+
+	// state should be the same after reading the file from scratch
+	r = NewFile(location)
+
+	deleted, err := r.IsURLDeleted(context.Background(), "abc")
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	deleted, err = r.IsURLDeleted(context.Background(), "GqKWdrE")
+	require.NoError(t, err)
+	require.False(t, deleted)
+
+	urlsAmount, usersAmount, err = r.CountURLsAndUsers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, urlsAmount)
+	require.Equal(t, 1, usersAmount)
+}
+
+func TestFileTornLineAndCompaction(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+
+	location := filepath.Join(t.TempDir(), "db.json")
+
+	content := "{\"version\":2,\"op\":\"create\",\"short_url\":\"abc\",\"original_url\":\"https://ya.ru\",\"uuid\":1,\"user_id\":1}\n" +
+		"{\"version\":2,\"op\":\"create\",\"short_url\":\"cba\",\"original_url\":\"https://mail.ru\",\"uuid\":2,\"user_id\":1}\n" +
+		"{\"version\":2,\"op\":\"delete\",\"short_url\":\"cba\",\"user_id\":1}\n" +
+		"{\"version\":2,\"op\":\"create\",\"short_u"
+	require.NoError(t, os.WriteFile(location, []byte(content), 0600))
+
+	r := NewFile(location)
+
+	all, err := r.ReadAll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	ctx := domain.WithIdentity(context.Background(), domain.Identity{UserID: 1})
+	_, err = r.Create(ctx, []state.URLStringJSON{{UUID: 3, ShortURL: "bca", OriginalURL: "https://hh.ru"}})
+	require.NoError(t, err)
+
+	// the torn line should be cut off, so the file may be read again
+	_, err = NewFile(location).ReadAll(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, r.Compact())
+
+	data, err := os.ReadFile(location)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(data)), "\n"), 3)
+
+	r = NewFile(location)
+
+	deleted, err := r.IsURLDeleted(context.Background(), "cba")
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	userURLs, err := r.ReadUserURLs(ctx, domain.URLQuery{})
+	require.NoError(t, err)
+	require.Len(t, userURLs, 3)
+}
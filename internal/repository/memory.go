@@ -0,0 +1,1031 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+var errURLNotFound = errors.New("url not found")
+
+// ownerKey is a type which represents original URL of a user, a user can have only one short URL for every original URL.
+type ownerKey struct {
+	userID   int64
+	original string
+}
+
+// Memory is a type which keeps all URL data (including owners and deletion flags) in memory only.
+type Memory struct {
+	urls    map[string]state.URLStringJSON
+	byOwner map[ownerKey]string
+	// byOriginal contains short URLs of all the users by their original URLs, so an original URL which is deduplicated
+	// for all the users can be found
+	byOriginal map[string]map[string]struct{}
+	byUser     map[int64]map[string]struct{}
+	clicks     map[string][]domain.Click
+	// revisions contains changes of original URLs by short URLs in order they were made
+	revisions map[string][]domain.URLRevision
+	// apiKeys contains API keys by their hashes
+	apiKeys map[string]domain.APIKey
+	// deletions contains URLs which wait to be deleted in order of their ids
+	deletions []domain.DeletionTask
+	// lastDeletionID is the greatest id which was given to URL waiting to be deleted
+	lastDeletionID int64
+	// lastUserID is the greatest id of user which was allocated or seen in saved URLs
+	lastUserID int64
+	// users contains registered users (including the ones who got random ids before the registry existed) by their ids
+	users map[int64]domain.User
+	*sync.RWMutex
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		urls:       make(map[string]state.URLStringJSON),
+		byOwner:    make(map[ownerKey]string),
+		byOriginal: make(map[string]map[string]struct{}),
+		byUser:     make(map[int64]map[string]struct{}),
+		clicks:     make(map[string][]domain.Click),
+		revisions:  make(map[string][]domain.URLRevision),
+		apiKeys:    make(map[string]domain.APIKey),
+		// ids below the first one may be used by users who got random ids
+		lastUserID: domain.FirstUserID - 1,
+		users:      make(map[int64]domain.User),
+		RWMutex:    &sync.RWMutex{},
+	}
+}
+
+// sortByUUID sorts URLs in order of their creation, URLs with the same uuid are sorted by short URL.
+func sortByUUID(urls []state.URLStringJSON) {
+	sort.Slice(urls, func(i, j int) bool {
+		if urls[i].UUID != urls[j].UUID {
+			return urls[i].UUID < urls[j].UUID
+		}
+		return urls[i].ShortURL < urls[j].ShortURL
+	})
+}
+
+// put saves URL to all the indexes, the caller should hold the lock.
+func (r *Memory) put(url state.URLStringJSON) {
+	if saved, ok := r.urls[url.ShortURL]; ok {
+		r.dropOriginal(saved.OriginalURL, saved.ShortURL)
+	}
+
+	r.urls[url.ShortURL] = url
+	r.byOwner[ownerKey{userID: url.UserID, original: url.OriginalURL}] = url.ShortURL
+	r.addOriginal(url.OriginalURL, url.ShortURL)
+
+	if _, ok := r.byUser[url.UserID]; !ok {
+		r.byUser[url.UserID] = make(map[string]struct{})
+	}
+	r.byUser[url.UserID][url.ShortURL] = struct{}{}
+
+	if url.UserID > r.lastUserID {
+		r.lastUserID = url.UserID
+	}
+}
+
+// addOriginal saves short URL to the index of original URLs of all the users, the caller should hold the lock.
+func (r *Memory) addOriginal(original string, shortened string) {
+	if _, ok := r.byOriginal[original]; !ok {
+		r.byOriginal[original] = make(map[string]struct{})
+	}
+	r.byOriginal[original][shortened] = struct{}{}
+}
+
+// dropOriginal removes short URL from the index of original URLs of all the users, the caller should hold the lock.
+func (r *Memory) dropOriginal(original string, shortened string) {
+	delete(r.byOriginal[original], shortened)
+	if len(r.byOriginal[original]) == 0 {
+		delete(r.byOriginal, original)
+	}
+}
+
+// findOriginal looks for short URL of the original URL among URLs of the user, or among URLs of all the users
+// if the original URL is deduplicated globally (the smallest short URL is returned then, so the result doesn't change
+// if several users saved the original URL before). The caller should hold the lock.
+func (r *Memory) findOriginal(scope domain.DedupScope, uid int64, original string) (string, bool) {
+	if scope != domain.DedupGlobal {
+		shrt, ok := r.byOwner[ownerKey{userID: uid, original: original}]
+		return shrt, ok
+	}
+
+	var found string
+	for shrt := range r.byOriginal[original] {
+		if found == "" || shrt < found {
+			found = shrt
+		}
+	}
+
+	return found, found != ""
+}
+
+func (r *Memory) PingPg(ctx context.Context) error {
+	// there is nothing to connect to, in-memory storage is always available
+	return nil
+}
+
+// ReadAll gets all the URLs from memory.
+func (r *Memory) ReadAll(ctx context.Context) ([]state.URLStringJSON, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	urls := make([]state.URLStringJSON, 0, len(r.urls))
+	for _, url := range r.urls {
+		urls = append(urls, url)
+	}
+
+	sortByUUID(urls)
+
+	return urls, nil
+}
+
+// checkURL checks if URL can be saved. If its original URL was already saved in the scope, short version is returned
+// with UniqueError. The caller should hold the lock.
+func (r *Memory) checkURL(url state.URLStringJSON, scope domain.DedupScope) (string, error) {
+	if shrt, ok := r.findOriginal(scope, url.UserID, url.OriginalURL); ok {
+		return shrt, domain.NewUniqueError(errors.New("original url already exists"))
+	}
+
+	if _, ok := r.urls[url.ShortURL]; ok {
+		return "", domain.ErrShortURLExists
+	}
+
+	return "", nil
+}
+
+// checkBatch checks if all the URLs from batch of the user can be saved, original URLs are checked in the scope.
+// The caller should hold the lock.
+func (r *Memory) checkBatch(batch []*state.URLStringJSON, id int64, scope domain.DedupScope) error {
+	shortInBatch := make(map[string]struct{}, len(batch))
+	originalInBatch := make(map[string]struct{}, len(batch))
+	for _, url := range batch {
+		_, shortExists := r.urls[url.ShortURL]
+		_, originalExists := r.findOriginal(scope, id, url.OriginalURL)
+		_, shortRepeated := shortInBatch[url.ShortURL]
+		_, originalRepeated := originalInBatch[url.OriginalURL]
+		if shortExists || originalExists || shortRepeated || originalRepeated {
+			return domain.NewUniqueError(errors.New("url from batch already exists"))
+		}
+
+		shortInBatch[url.ShortURL] = struct{}{}
+		originalInBatch[url.OriginalURL] = struct{}{}
+	}
+
+	return nil
+}
+
+// Create saves URLs to memory. If an original URL was already saved in the scope from context, its short version is returned with UniqueError.
+func (r *Memory) Create(ctx context.Context, urls []state.URLStringJSON) (string, error) {
+	id := domain.UserIDFromContext(ctx)
+
+	r.Lock()
+	defer r.Unlock()
+
+	for _, url := range urls {
+		url.UserID = id
+		url.IsDeleted, url.DeletedAt = false, nil
+
+		if shrt, err := r.checkURL(url, domain.DedupScopeFromContext(ctx)); err != nil {
+			return shrt, err
+		}
+
+		r.put(url)
+	}
+
+	return "", nil
+}
+
+// CreateBatch saves URLs from batch to memory. Nothing is saved if any of the URLs already exist.
+func (r *Memory) CreateBatch(ctx context.Context, batch []*state.URLStringJSON) error {
+	id := domain.UserIDFromContext(ctx)
+
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.checkBatch(batch, id, domain.DedupScopeFromContext(ctx)); err != nil {
+		return err
+	}
+
+	for _, url := range batch {
+		u := *url
+		u.UserID = id
+		u.IsDeleted, u.DeletedAt = false, nil
+		r.put(u)
+	}
+
+	return nil
+}
+
+// ReadUserURLs gets URLs created by user whose id is in context which match query.
+func (r *Memory) ReadUserURLs(ctx context.Context, query domain.URLQuery) ([]state.URLStringJSON, error) {
+	id := domain.UserIDFromContext(ctx)
+
+	r.RLock()
+	defer r.RUnlock()
+
+	urls := make([]state.URLStringJSON, 0, len(r.byUser[id]))
+	for shrt := range r.byUser[id] {
+		urls = append(urls, r.urls[shrt])
+	}
+
+	sortByCreation(urls)
+
+	return pageURLs(urls, query), nil
+}
+
+// ReadOwner gets id of the user who saved URL (-1 if the URL has no owner), ErrURLNotFound is returned if there is no such URL.
+func (r *Memory) ReadOwner(ctx context.Context, shortened string) (int64, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	url, ok := r.urls[shortened]
+	if !ok {
+		return -1, domain.ErrURLNotFound
+	}
+
+	return url.UserID, nil
+}
+
+// markDeleted marks URLs as deleted, every URL is marked only if it belongs to the user with id of the same index.
+// URLs which were marked are returned with statuses of all the URLs. The caller should hold the lock.
+func (r *Memory) markDeleted(shortURLs []string, uid []int64) ([]state.URLStringJSON, []domain.DeletionStatus, error) {
+	if len(shortURLs) != len(uid) {
+		return nil, nil, errors.New("amounts of urls and user ids are not equal")
+	}
+
+	now := time.Now()
+	marked := make([]state.URLStringJSON, 0, len(shortURLs))
+	statuses := make([]domain.DeletionStatus, len(shortURLs))
+	for i, shrt := range shortURLs {
+		url, ok := r.urls[shrt]
+		statuses[i] = deletionStatus(ok, url.UserID, uid[i])
+		if statuses[i] != domain.DeletionDeleted || url.IsDeleted {
+			continue
+		}
+
+		url.IsDeleted, url.DeletedAt = true, &now
+		marked = append(marked, url)
+	}
+
+	return marked, statuses, nil
+}
+
+// deletionStatus is a function to get status of URL which the user asked to delete. URL which was deleted before is treated as deleted.
+// URLs without owner (e.g. saved before they got owners) can't be deleted by anybody, user without id can't delete anything.
+func deletionStatus(exists bool, owner int64, uid int64) domain.DeletionStatus {
+	switch {
+	case !exists:
+		return domain.DeletionNotFound
+	case owner < 0 || uid < 0 || owner != uid:
+		return domain.DeletionNotOwned
+	default:
+		return domain.DeletionDeleted
+	}
+}
+
+// DeleteUserURLs marks URLs as deleted, every URL is marked only if it belongs to the user with id of the same index.
+// Statuses of the URLs are returned in the same order.
+func (r *Memory) DeleteUserURLs(ctx context.Context, shortURLs []string, uid []int64) ([]domain.DeletionStatus, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	marked, statuses, err := r.markDeleted(shortURLs, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, url := range marked {
+		r.urls[url.ShortURL] = url
+	}
+
+	return statuses, nil
+}
+
+// markExpired marks URLs whose expiration time has come as deleted. URLs which were marked are returned. The caller should hold the lock.
+func (r *Memory) markExpired(now time.Time) []state.URLStringJSON {
+	marked := make([]state.URLStringJSON, 0)
+	for _, url := range r.urls {
+		if url.IsDeleted || !url.IsExpired(now) {
+			continue
+		}
+
+		deletedAt := now
+		url.IsDeleted, url.DeletedAt = true, &deletedAt
+		marked = append(marked, url)
+	}
+
+	return marked
+}
+
+// DeleteExpiredURLs marks URLs whose expiration time has come as deleted, amount of marked URLs is returned.
+func (r *Memory) DeleteExpiredURLs(ctx context.Context, now time.Time) (int, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	marked := r.markExpired(now)
+	for _, url := range marked {
+		r.urls[url.ShortURL] = url
+	}
+
+	return len(marked), nil
+}
+
+// restoreStatus is a function to get status of URL of the user which the user asked to restore.
+// URL may be restored only if it was deleted after deletedAfter and its expiration time has not come.
+func restoreStatus(url state.URLStringJSON, exists bool, uid int64, deletedAfter time.Time, now time.Time) domain.RestoreStatus {
+	switch {
+	case !exists:
+		return domain.RestoreNotFound
+	case uid < 0 || url.UserID != uid:
+		return domain.RestoreNotOwned
+	case !url.IsDeleted:
+		return domain.RestoreNotDeleted
+	case (url.DeletedAt != nil && !url.DeletedAt.After(deletedAfter)) || url.IsExpired(now):
+		return domain.RestoreExpired
+	default:
+		return domain.RestoreRestored
+	}
+}
+
+// markRestored unmarks deleted URLs of the user. URLs which were unmarked are returned with statuses of all the URLs.
+// The caller should hold the lock.
+func (r *Memory) markRestored(shortURLs []string, uid int64, deletedAfter time.Time) ([]state.URLStringJSON, []domain.RestoreStatus) {
+	now := time.Now()
+	restored := make([]state.URLStringJSON, 0, len(shortURLs))
+	statuses := make([]domain.RestoreStatus, len(shortURLs))
+	// the same URL may be repeated in the request, but it is restored only once
+	seen := make(map[string]struct{}, len(shortURLs))
+	for i, shrt := range shortURLs {
+		if _, ok := seen[shrt]; ok {
+			statuses[i] = domain.RestoreRestored
+			continue
+		}
+
+		url, ok := r.urls[shrt]
+		statuses[i] = restoreStatus(url, ok, uid, deletedAfter, now)
+		if statuses[i] != domain.RestoreRestored {
+			continue
+		}
+
+		url.IsDeleted, url.DeletedAt = false, nil
+		restored = append(restored, url)
+		seen[shrt] = struct{}{}
+	}
+
+	return restored, statuses
+}
+
+// RestoreUserURLs unmarks deleted URLs of the user whose id is in context if they were deleted after deletedAfter.
+// Statuses of the URLs are returned in the same order.
+func (r *Memory) RestoreUserURLs(ctx context.Context, shortURLs []string, deletedAfter time.Time) ([]domain.RestoreStatus, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	restored, statuses := r.markRestored(shortURLs, domain.UserIDFromContext(ctx), deletedAfter)
+	for _, url := range restored {
+		r.urls[url.ShortURL] = url
+	}
+
+	return statuses, nil
+}
+
+// backfillDeletedAt gives time of deletion to URLs which were deleted before it was saved, so they may be restored
+// during grace period and purged after it, like in database migration. URLs which got the time are returned.
+// The caller should hold the lock.
+func (r *Memory) backfillDeletedAt(now time.Time) []state.URLStringJSON {
+	urls := make([]state.URLStringJSON, 0)
+	for short, url := range r.urls {
+		if url.IsDeleted && url.DeletedAt == nil {
+			url.DeletedAt = &now
+			r.urls[short] = url
+			urls = append(urls, url)
+		}
+	}
+
+	return urls
+}
+
+// purgeable is a function to find URLs which were deleted before deletedBefore, so they may be removed for good.
+// The caller should hold the lock.
+func (r *Memory) purgeable(deletedBefore time.Time) []state.URLStringJSON {
+	urls := make([]state.URLStringJSON, 0)
+	for _, url := range r.urls {
+		if url.IsDeleted && url.DeletedAt != nil && url.DeletedAt.Before(deletedBefore) {
+			urls = append(urls, url)
+		}
+	}
+
+	return urls
+}
+
+// remove removes URL from all the indexes with its clicks and revisions, so its short URL may be used again. The caller should hold the lock.
+func (r *Memory) remove(url state.URLStringJSON) {
+	delete(r.urls, url.ShortURL)
+	delete(r.clicks, url.ShortURL)
+	delete(r.revisions, url.ShortURL)
+
+	key := ownerKey{userID: url.UserID, original: url.OriginalURL}
+	if r.byOwner[key] == url.ShortURL {
+		delete(r.byOwner, key)
+	}
+	r.dropOriginal(url.OriginalURL, url.ShortURL)
+
+	delete(r.byUser[url.UserID], url.ShortURL)
+	if len(r.byUser[url.UserID]) == 0 {
+		delete(r.byUser, url.UserID)
+	}
+}
+
+// PurgeDeletedURLs removes URLs which were deleted before deletedBefore, short versions of removed URLs are returned.
+func (r *Memory) PurgeDeletedURLs(ctx context.Context, deletedBefore time.Time) ([]string, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	urls := r.purgeable(deletedBefore)
+	purged := make([]string, len(urls))
+	for i, url := range urls {
+		r.remove(url)
+		purged[i] = url.ShortURL
+	}
+
+	return purged, nil
+}
+
+// revisable is a function to check if original URL of URL may be changed by the user. URL which is deleted can't be changed until it is restored.
+func revisable(url state.URLStringJSON, exists bool, uid int64) bool {
+	return exists && url.UserID == uid && !url.IsDeleted
+}
+
+// revise checks if original URL of URL of the user may be changed and makes revision of the change. If the original URL was already
+// saved in the scope with another short URL, that short URL is returned with UniqueError. If the URL already has the original URL,
+// nil revision is returned, because nothing should be changed. The caller should hold the lock.
+func (r *Memory) revise(shortened string, original string, uid int64, at time.Time, scope domain.DedupScope) (*domain.URLRevision, string, error) {
+	url, ok := r.urls[shortened]
+	if !revisable(url, ok, uid) {
+		return nil, "", domain.ErrURLNotFound
+	}
+
+	if url.OriginalURL == original {
+		return nil, "", nil
+	}
+
+	if shrt, ok := r.findOriginal(scope, uid, original); ok {
+		return nil, shrt, domain.NewUniqueError(errors.New("original url already exists"))
+	}
+
+	return &domain.URLRevision{ShortURL: shortened, Revision: len(r.revisions[shortened]) + 1, PreviousURL: url.OriginalURL,
+		OriginalURL: original, ChangedAt: at}, "", nil
+}
+
+// setOriginal changes original URL of saved URL, so the URL is found by its new original URL only. Time of the change
+// is not set if it is nil. The caller should hold the lock.
+func (r *Memory) setOriginal(shortened string, original string, at *time.Time) {
+	url := r.urls[shortened]
+
+	key := ownerKey{userID: url.UserID, original: url.OriginalURL}
+	if r.byOwner[key] == shortened {
+		delete(r.byOwner, key)
+	}
+
+	r.dropOriginal(url.OriginalURL, shortened)
+	r.addOriginal(original, shortened)
+
+	url.OriginalURL = original
+	if at != nil {
+		url.UpdatedAt = at
+	}
+	r.urls[shortened] = url
+	r.byOwner[ownerKey{userID: url.UserID, original: original}] = shortened
+}
+
+// UpdateOriginal changes original URL of URL of the user whose id is in context and saves revision of the change.
+// If the original URL was already saved in the scope from context with another short URL, that short URL is returned with UniqueError.
+func (r *Memory) UpdateOriginal(ctx context.Context, shortened string, original string, at time.Time) (string, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	rev, shrt, err := r.revise(shortened, original, domain.UserIDFromContext(ctx), at, domain.DedupScopeFromContext(ctx))
+	if err != nil || rev == nil {
+		return shrt, err
+	}
+
+	r.setOriginal(shortened, original, &at)
+	r.revisions[shortened] = append(r.revisions[shortened], *rev)
+
+	return "", nil
+}
+
+// ReadRevisions gets revisions of URL of the user whose id is in context in order they were made.
+func (r *Memory) ReadRevisions(ctx context.Context, shortened string) ([]domain.URLRevision, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	url, ok := r.urls[shortened]
+	if !ok || url.UserID != domain.UserIDFromContext(ctx) {
+		return nil, domain.ErrURLNotFound
+	}
+
+	return append(make([]domain.URLRevision, 0, len(r.revisions[shortened])), r.revisions[shortened]...), nil
+}
+
+// retag checks if tags of URL of the user may be changed and gets its tags after the change. Tags of deleted URL can't be changed
+// the same way as its original URL. The caller should hold the lock.
+func (r *Memory) retag(shortened string, added []string, removed []string, uid int64) ([]string, error) {
+	url, ok := r.urls[shortened]
+	if !revisable(url, ok, uid) {
+		return nil, domain.ErrURLNotFound
+	}
+
+	return mergeTags(url.Tags, added, removed)
+}
+
+// setTags changes tags of saved URL. The caller should hold the lock.
+func (r *Memory) setTags(shortened string, tags []string) {
+	url := r.urls[shortened]
+	url.Tags = tags
+	r.urls[shortened] = url
+}
+
+// UpdateTags adds tags to URL of the user whose id is in context and removes tags from it, tags of the URL after the change are returned.
+func (r *Memory) UpdateTags(ctx context.Context, shortened string, added []string, removed []string) ([]string, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	tags, err := r.retag(shortened, added, removed, domain.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	r.setTags(shortened, tags)
+
+	return tags, nil
+}
+
+// ReadTags counts URLs of the user whose id is in context by their tags.
+func (r *Memory) ReadTags(ctx context.Context) ([]domain.TagCount, error) {
+	id := domain.UserIDFromContext(ctx)
+
+	r.RLock()
+	defer r.RUnlock()
+
+	urls := make([]state.URLStringJSON, 0, len(r.byUser[id]))
+	for shrt := range r.byUser[id] {
+		urls = append(urls, r.urls[shrt])
+	}
+
+	return countTags(urls), nil
+}
+
+// markDisabled marks saved URLs as disabled, URLs which were marked are returned. The caller should hold the lock.
+func (r *Memory) markDisabled(shortURLs []string) []state.URLStringJSON {
+	marked := make([]state.URLStringJSON, 0, len(shortURLs))
+	for _, short := range shortURLs {
+		if url, ok := r.urls[short]; ok && !url.IsDisabled {
+			url.IsDisabled = true
+			marked = append(marked, url)
+		}
+	}
+
+	return marked
+}
+
+// DisableURLs marks URLs as disabled, so they don't redirect anywhere. Unknown short URLs are skipped.
+func (r *Memory) DisableURLs(ctx context.Context, shortURLs []string) error {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, url := range r.markDisabled(shortURLs) {
+		r.urls[url.ShortURL] = url
+	}
+
+	return nil
+}
+
+func (r *Memory) IsURLDeleted(ctx context.Context, shortened string) (bool, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	url, ok := r.urls[shortened]
+	if !ok {
+		return false, errURLNotFound
+	}
+
+	return url.IsDeleted, nil
+}
+
+// CountURLsAndUsers counts URLs which are not deleted and users who have at least one such URL (URLs without owner are not counted as user's).
+func (r *Memory) CountURLsAndUsers(ctx context.Context) (int, int, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	var totalURLs int
+	users := make(map[int64]struct{})
+	for _, url := range r.urls {
+		if url.IsDeleted {
+			continue
+		}
+
+		totalURLs++
+		if url.UserID >= 0 {
+			users[url.UserID] = struct{}{}
+		}
+	}
+
+	return totalURLs, len(users), nil
+}
+
+// checkImported checks if URL from another storage should be saved. It should not be saved if it was already imported,
+// and it can't be saved if the owner has already saved the original URL with another short URL. The caller should hold the lock.
+func (r *Memory) checkImported(url state.URLStringJSON) (bool, error) {
+	if _, ok := r.urls[url.ShortURL]; ok {
+		return false, nil
+	}
+
+	if shrt, ok := r.byOwner[ownerKey{userID: url.UserID, original: url.OriginalURL}]; ok {
+		return false, fmt.Errorf("original url %s of user %d is already saved with short url %s", url.OriginalURL, url.UserID, shrt)
+	}
+
+	return true, nil
+}
+
+// Export calls fn for every URL in order of their creation.
+func (r *Memory) Export(ctx context.Context, fn func(url state.URLStringJSON) error) error {
+	urls, err := r.ReadAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, url := range urls {
+		if err = fn(url); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Import saves URLs keeping their owners and deletion flags. URLs which were already saved are skipped.
+func (r *Memory) Import(ctx context.Context, urls []state.URLStringJSON) error {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, url := range urls {
+		needed, err := r.checkImported(url)
+		if err != nil {
+			return err
+		}
+
+		if needed {
+			r.put(url)
+		}
+	}
+
+	return nil
+}
+
+// ExportUsers calls fn for every registered user in order of their ids.
+func (r *Memory) ExportUsers(ctx context.Context, fn func(user domain.User) error) error {
+	r.RLock()
+	users := make([]domain.User, 0, len(r.users))
+	for _, user := range r.users {
+		users = append(users, user)
+	}
+	r.RUnlock()
+
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].ID < users[j].ID
+	})
+
+	for _, user := range users {
+		if err := fn(user); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImportUsers saves users keeping their ids, users which were already saved are skipped. Ids of imported users are never given to new users.
+func (r *Memory) ImportUsers(ctx context.Context, users []domain.User) error {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, user := range users {
+		if _, ok := r.users[user.ID]; ok {
+			continue
+		}
+
+		r.users[user.ID] = user
+		if user.ID > r.lastUserID {
+			r.lastUserID = user.ID
+		}
+	}
+
+	return nil
+}
+
+// ExportAPIKeys calls fn for every API key (including revoked ones) in order of their ids.
+func (r *Memory) ExportAPIKeys(ctx context.Context, fn func(key domain.APIKey) error) error {
+	r.RLock()
+	keys := make([]domain.APIKey, 0, len(r.apiKeys))
+	for _, key := range r.apiKeys {
+		keys = append(keys, key)
+	}
+	r.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ID < keys[j].ID
+	})
+
+	for _, key := range keys {
+		if err := fn(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasAPIKey checks if the key or another key with its id is already saved, the caller should hold the lock.
+func (r *Memory) hasAPIKey(key domain.APIKey) bool {
+	if _, ok := r.apiKeys[key.Hash]; ok {
+		return true
+	}
+
+	for _, saved := range r.apiKeys {
+		if saved.ID == key.ID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ImportAPIKeys saves API keys keeping their ids and revocation times, keys which were already saved are skipped.
+func (r *Memory) ImportAPIKeys(ctx context.Context, keys []domain.APIKey) error {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, key := range keys {
+		if !r.hasAPIKey(key) {
+			r.apiKeys[key.Hash] = key
+		}
+	}
+
+	return nil
+}
+
+// ExportRevisions calls fn for every revision of original URLs in order of short URLs and numbers of revisions.
+func (r *Memory) ExportRevisions(ctx context.Context, fn func(rev domain.URLRevision) error) error {
+	r.RLock()
+	revisions := make([]domain.URLRevision, 0, len(r.revisions))
+	for _, revs := range r.revisions {
+		revisions = append(revisions, revs...)
+	}
+	r.RUnlock()
+
+	sort.Slice(revisions, func(i, j int) bool {
+		if revisions[i].ShortURL != revisions[j].ShortURL {
+			return revisions[i].ShortURL < revisions[j].ShortURL
+		}
+		return revisions[i].Revision < revisions[j].Revision
+	})
+
+	for _, rev := range revisions {
+		if err := fn(rev); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// needsRevision checks if the revision belongs to a saved URL and isn't saved yet, the caller should hold the lock.
+func (r *Memory) needsRevision(rev domain.URLRevision) bool {
+	if _, ok := r.urls[rev.ShortURL]; !ok {
+		return false
+	}
+
+	for _, saved := range r.revisions[rev.ShortURL] {
+		if saved.Revision == rev.Revision {
+			return false
+		}
+	}
+
+	return true
+}
+
+// addRevision saves the revision keeping revisions of its URL in order of their numbers, the caller should hold the lock.
+func (r *Memory) addRevision(rev domain.URLRevision) {
+	revisions := append(r.revisions[rev.ShortURL], rev)
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+	r.revisions[rev.ShortURL] = revisions
+}
+
+// ImportRevisions saves revisions keeping their numbers. Revisions which were already saved and revisions of unknown URLs are skipped.
+func (r *Memory) ImportRevisions(ctx context.Context, revisions []domain.URLRevision) error {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, rev := range revisions {
+		if r.needsRevision(rev) {
+			r.addRevision(rev)
+		}
+	}
+
+	return nil
+}
+
+// CreateClicks saves clicks to memory.
+func (r *Memory) CreateClicks(ctx context.Context, clicks []domain.Click) error {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, click := range clicks {
+		r.clicks[click.ShortURL] = append(r.clicks[click.ShortURL], click)
+	}
+
+	return nil
+}
+
+// ReadClicks gets all the clicks made by short URL.
+func (r *Memory) ReadClicks(ctx context.Context, shortened string) ([]domain.Click, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	clicks := make([]domain.Click, len(r.clicks[shortened]))
+	copy(clicks, r.clicks[shortened])
+
+	return clicks, nil
+}
+
+// CreateUser allocates id which is greater than ids of all the users known.
+func (r *Memory) CreateUser(ctx context.Context) (int64, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.lastUserID++
+	r.users[r.lastUserID] = domain.User{ID: r.lastUserID, CreatedAt: time.Now()}
+	return r.lastUserID, nil
+}
+
+// CreateLegacyUser saves the user who got random id before the registry of users existed.
+func (r *Memory) CreateLegacyUser(ctx context.Context, id int64) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		r.users[id] = domain.User{ID: id, CreatedAt: time.Now()}
+	}
+	return nil
+}
+
+// CreateAPIKey saves API key.
+func (r *Memory) CreateAPIKey(ctx context.Context, key domain.APIKey) error {
+	r.Lock()
+	defer r.Unlock()
+
+	r.apiKeys[key.Hash] = key
+	return nil
+}
+
+// ReadAPIKeys gets all API keys of the user (including revoked ones) in order of their creation.
+func (r *Memory) ReadAPIKeys(ctx context.Context, userID int64) ([]domain.APIKey, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	keys := make([]domain.APIKey, 0)
+	for _, key := range r.apiKeys {
+		if key.UserID == userID {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+
+	return keys, nil
+}
+
+// ReadAPIKeyByHash gets API key by its hash, ErrAPIKeyNotFound is returned if there is no such key.
+func (r *Memory) ReadAPIKeyByHash(ctx context.Context, hash string) (domain.APIKey, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	key, ok := r.apiKeys[hash]
+	if !ok {
+		return domain.APIKey{}, domain.ErrAPIKeyNotFound
+	}
+
+	return key, nil
+}
+
+// findAPIKey is a function to get API key of the user by its id, the caller should hold the lock.
+func (r *Memory) findAPIKey(userID int64, id string) (domain.APIKey, error) {
+	for _, key := range r.apiKeys {
+		if key.UserID == userID && key.ID == id {
+			return key, nil
+		}
+	}
+
+	return domain.APIKey{}, domain.ErrAPIKeyNotFound
+}
+
+// revokeAPIKey marks API key of the user as revoked, the caller should hold the lock. Key which was already revoked keeps its revocation time.
+func (r *Memory) revokeAPIKey(userID int64, id string, at time.Time) error {
+	key, err := r.findAPIKey(userID, id)
+	if err != nil {
+		return err
+	}
+
+	if key.RevokedAt == nil {
+		key.RevokedAt = &at
+		r.apiKeys[key.Hash] = key
+	}
+
+	return nil
+}
+
+// RevokeAPIKey marks API key of the user as revoked, ErrAPIKeyNotFound is returned if the user has no such key.
+func (r *Memory) RevokeAPIKey(ctx context.Context, userID int64, id string, at time.Time) error {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.revokeAPIKey(userID, id, at)
+}
+
+// nextDeletions is a function to give ids to URLs waiting to be deleted, the caller should hold the lock.
+func (r *Memory) nextDeletions(tasks []domain.DeletionTask) []domain.DeletionTask {
+	numbered := make([]domain.DeletionTask, len(tasks))
+	for i, task := range tasks {
+		task.ID = r.lastDeletionID + int64(i) + 1
+		numbered[i] = task
+	}
+
+	return numbered
+}
+
+// putDeletions puts URLs which already have ids to outbox, the caller should hold the lock.
+func (r *Memory) putDeletions(tasks []domain.DeletionTask) {
+	for _, task := range tasks {
+		r.deletions = append(r.deletions, task)
+		if task.ID > r.lastDeletionID {
+			r.lastDeletionID = task.ID
+		}
+	}
+}
+
+// ackDeletions removes URLs from outbox, the caller should hold the lock.
+func (r *Memory) ackDeletions(ids []int64) {
+	acked := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		acked[id] = struct{}{}
+	}
+
+	left := r.deletions[:0]
+	for _, task := range r.deletions {
+		if _, ok := acked[task.ID]; !ok {
+			left = append(left, task)
+		}
+	}
+	r.deletions = left
+}
+
+// EnqueueDeletions puts URLs to outbox, so they are deleted later.
+func (r *Memory) EnqueueDeletions(ctx context.Context, tasks []domain.DeletionTask) error {
+	r.Lock()
+	defer r.Unlock()
+
+	r.putDeletions(r.nextDeletions(tasks))
+	return nil
+}
+
+// ReadDeletions gets up to limit URLs from outbox, the ones which were queued first are returned first.
+func (r *Memory) ReadDeletions(ctx context.Context, limit int) ([]domain.DeletionTask, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	if limit > len(r.deletions) {
+		limit = len(r.deletions)
+	}
+
+	return append([]domain.DeletionTask(nil), r.deletions[:limit]...), nil
+}
+
+// AckDeletions removes URLs which were deleted from outbox.
+func (r *Memory) AckDeletions(ctx context.Context, ids []int64) error {
+	r.Lock()
+	defer r.Unlock()
+
+	r.ackDeletions(ids)
+	return nil
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+func TestURLMetadata(t *testing.T) {
+	created := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+	owner := domain.WithIdentity(context.Background(), domain.Identity{UserID: 1})
+
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		_, err := r.Create(owner, []state.URLStringJSON{{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru", CreatedAt: &created,
+			Title: "Yandex", Note: "search engine", Tags: []string{"search", "work"}}})
+		require.NoError(t, err)
+
+		err = r.CreateBatch(owner, []*state.URLStringJSON{{UUID: 2, ShortURL: "cba", OriginalURL: "https://mail.ru", CreatedAt: &created}})
+		require.NoError(t, err)
+
+		_, err = r.UpdateOriginal(owner, "abc", "https://go.dev", updated)
+		require.NoError(t, err)
+
+		// metadata is kept after reopening
+		r = reopen(t, r)
+
+		urls, err := r.ReadUserURLs(owner, domain.URLQuery{})
+		require.NoError(t, err)
+		require.Len(t, urls, 2)
+
+		require.NotNil(t, urls[0].CreatedAt)
+		require.True(t, created.Equal(*urls[0].CreatedAt))
+		require.NotNil(t, urls[0].UpdatedAt)
+		require.True(t, updated.Equal(*urls[0].UpdatedAt))
+		require.Equal(t, "Yandex", urls[0].Title)
+		require.Equal(t, "search engine", urls[0].Note)
+		require.Equal(t, []string{"search", "work"}, urls[0].Tags)
+
+		require.NotNil(t, urls[1].CreatedAt)
+		require.Nil(t, urls[1].UpdatedAt)
+		require.Empty(t, urls[1].Title)
+		require.Empty(t, urls[1].Tags)
+	})
+}
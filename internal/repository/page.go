@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+// urlHost is a function to get host of URL in lower case, empty string is returned if URL has no host.
+func urlHost(original string) string {
+	u, err := url.Parse(original)
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToLower(u.Hostname())
+}
+
+// matchesFilter is a function to check if URL matches filter. Host of original URL matches domain of filter
+// if it is the same domain or its subdomain.
+func matchesFilter(url state.URLStringJSON, filter domain.URLFilter) bool {
+	original := url.OriginalURL
+	if filter.Contains != "" && !strings.Contains(strings.ToLower(original), strings.ToLower(filter.Contains)) {
+		return false
+	}
+
+	if filter.Tag != "" && !hasTag(url.Tags, filter.Tag) {
+		return false
+	}
+
+	if filter.Domain == "" {
+		return true
+	}
+
+	host, d := urlHost(original), strings.ToLower(filter.Domain)
+	return host == d || strings.HasSuffix(host, "."+d)
+}
+
+// createdAt is a function to get time of creation of URL, zero time is returned for URLs which were saved before it was kept.
+func createdAt(u state.URLStringJSON) time.Time {
+	if u.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *u.CreatedAt
+}
+
+// sortByCreation sorts URLs in order of their creation, URLs created at the same time are sorted by short URL.
+func sortByCreation(urls []state.URLStringJSON) {
+	sort.Slice(urls, func(i, j int) bool {
+		if ti, tj := createdAt(urls[i]), createdAt(urls[j]); !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return urls[i].ShortURL < urls[j].ShortURL
+	})
+}
+
+// isAfter is a function to check if URL is listed after cursor when URLs are listed in the order.
+func isAfter(u state.URLStringJSON, cursor domain.URLCursor, order domain.SortOrder) bool {
+	asc := order != domain.SortDesc
+	if t := createdAt(u); !t.Equal(cursor.CreatedAt) {
+		return t.After(cursor.CreatedAt) == asc
+	}
+
+	return u.ShortURL != cursor.ShortURL && (u.ShortURL > cursor.ShortURL) == asc
+}
+
+// pageURLs is a function to get URLs which match query in requested order, URLs should be sorted by sortByCreation.
+func pageURLs(urls []state.URLStringJSON, query domain.URLQuery) []state.URLStringJSON {
+	if query.Order == domain.SortDesc {
+		for i, j := 0, len(urls)-1; i < j; i, j = i+1, j-1 {
+			urls[i], urls[j] = urls[j], urls[i]
+		}
+	}
+
+	page := make([]state.URLStringJSON, 0)
+	for _, u := range urls {
+		if query.After != nil && !isAfter(u, *query.After, query.Order) {
+			continue
+		}
+
+		if !matchesFilter(u, query.URLFilter) {
+			continue
+		}
+
+		page = append(page, u)
+		if query.Limit > 0 && len(page) == query.Limit {
+			break
+		}
+	}
+
+	return page
+}
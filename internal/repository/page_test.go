@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+func TestReadUserURLsPage(t *testing.T) {
+	owner := domain.WithIdentity(context.Background(), domain.Identity{UserID: 1})
+	stranger := domain.WithIdentity(context.Background(), domain.Identity{UserID: 2})
+
+	shorts := func(urls []state.URLStringJSON) []string {
+		res := make([]string, 0, len(urls))
+		for _, u := range urls {
+			res = append(res, u.ShortURL)
+		}
+		return res
+	}
+
+	// uuid doesn't grow with time of creation, URL without time of creation was saved before it was kept
+	first := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := first.Add(time.Second)
+
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		_, err := r.Create(owner, []state.URLStringJSON{
+			{UUID: 4, ShortURL: "abc", OriginalURL: "https://ya.ru/search"},
+			{UUID: 3, ShortURL: "cba", OriginalURL: "https://mail.ru", CreatedAt: &first},
+			{UUID: 2, ShortURL: "bca", OriginalURL: "https://music.YA.ru/album", CreatedAt: &second},
+			{UUID: 2, ShortURL: "acb", OriginalURL: "https://notya.ru/search", CreatedAt: &second},
+		})
+		require.NoError(t, err)
+		_, err = r.Create(stranger, []state.URLStringJSON{{UUID: 5, ShortURL: "bac", OriginalURL: "https://ya.ru"}})
+		require.NoError(t, err)
+
+		var testTable = []struct {
+			query domain.URLQuery
+			want  []string
+		}{
+			{domain.URLQuery{}, []string{"abc", "cba", "acb", "bca"}},
+			{domain.URLQuery{Order: domain.SortDesc}, []string{"bca", "acb", "cba", "abc"}},
+			{domain.URLQuery{Limit: 2}, []string{"abc", "cba"}},
+			{domain.URLQuery{Limit: 2, After: &domain.URLCursor{CreatedAt: first, ShortURL: "cba"}}, []string{"acb", "bca"}},
+			{domain.URLQuery{Limit: 1, After: &domain.URLCursor{ShortURL: "abc"}}, []string{"cba"}},
+			{domain.URLQuery{Order: domain.SortDesc, After: &domain.URLCursor{CreatedAt: second, ShortURL: "acb"}}, []string{"cba", "abc"}},
+			{domain.URLQuery{After: &domain.URLCursor{CreatedAt: second, ShortURL: "bca"}}, []string{}},
+			{domain.URLQuery{URLFilter: domain.URLFilter{Contains: "SEARCH"}}, []string{"abc", "acb"}},
+			{domain.URLQuery{URLFilter: domain.URLFilter{Domain: "ya.ru"}}, []string{"abc", "bca"}},
+			{domain.URLQuery{URLFilter: domain.URLFilter{Domain: "ya.ru", Contains: "album"}}, []string{"bca"}},
+		}
+
+		for _, testCase := range testTable {
+			urls, err := r.ReadUserURLs(owner, testCase.query)
+			require.NoError(t, err)
+			require.Equal(t, testCase.want, shorts(urls))
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+func TestDisableURLs(t *testing.T) {
+	ctx := domain.WithIdentity(context.Background(), domain.Identity{UserID: 1})
+
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		_, err := r.Create(ctx, []state.URLStringJSON{
+			{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru"},
+			{UUID: 2, ShortURL: "cba", OriginalURL: "https://mail.ru"},
+		})
+		require.NoError(t, err)
+
+		// unknown short URLs are skipped, disabling twice changes nothing
+		require.NoError(t, r.DisableURLs(context.Background(), []string{"abc", "nope"}))
+		require.NoError(t, r.DisableURLs(context.Background(), []string{"abc"}))
+
+		// disabled URLs stay disabled after reopening
+		r = reopen(t, r)
+
+		urls, err := r.ReadAll(context.Background())
+		require.NoError(t, err)
+		require.Len(t, urls, 2)
+
+		disabled := make(map[string]bool, len(urls))
+		for _, url := range urls {
+			disabled[url.ShortURL] = url.IsDisabled
+		}
+		require.Equal(t, map[string]bool{"abc": true, "cba": false}, disabled)
+	})
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+// testDSNEnvName is a name of env variable with DSN of database for tests of postgres storage, the tests are skipped if it is not set.
+const testDSNEnvName = "TEST_DATABASE_DSN"
+
+// newTestURL is a function to get postgres storage with applied migrations.
+func newTestURL(t *testing.T) *URL {
+	dsn := os.Getenv(testDSNEnvName)
+	if dsn == "" {
+		t.Skip(testDSNEnvName, "is not set")
+	}
+
+	require.NoError(t, util.InitLogger())
+
+	// migrations are read relative to the root of the module
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("../.."))
+	pg, err := state.NewPG(dsn)
+	require.NoError(t, os.Chdir(wd))
+	require.NoError(t, err)
+
+	return NewURL(t.TempDir()+"/db.json", pg)
+}
+
+func TestPostgresDeleteURLsWithoutOwner(t *testing.T) {
+	r := newTestURL(t)
+	db, err := r.getPg()
+	require.NoError(t, err)
+
+	suffix := fmt.Sprint(time.Now().UnixNano())
+	ownerless, owned := "ownerless"+suffix, "owned"+suffix
+
+	// URLs which were saved before they got owners have no user id
+	_, err = db.Exec("INSERT INTO urlshrt (uuid, short, original, is_deleted) VALUES(1, $1, $2, 0)", ownerless, "https://ya.ru/"+suffix)
+	require.NoError(t, err)
+
+	ctx := domain.WithIdentity(context.Background(), domain.Identity{UserID: 1000})
+	_, err = r.Create(ctx, []state.URLStringJSON{{UUID: 2, ShortURL: owned, OriginalURL: "https://mail.ru/" + suffix}})
+	require.NoError(t, err)
+
+	// nobody owns URL without owner, user without id owns nothing
+	statuses, err := r.DeleteUserURLs(context.Background(), []string{ownerless, ownerless, owned}, []int64{-1, 1000, -1})
+	require.NoError(t, err)
+	require.Equal(t, []domain.DeletionStatus{domain.DeletionNotOwned, domain.DeletionNotOwned, domain.DeletionNotOwned}, statuses)
+
+	statuses, err = r.DeleteUserURLs(context.Background(), []string{ownerless, owned, "missing" + suffix}, []int64{1000, 1000, 1000})
+	require.NoError(t, err)
+	require.Equal(t, []domain.DeletionStatus{domain.DeletionNotOwned, domain.DeletionDeleted, domain.DeletionNotFound}, statuses)
+
+	deleted, err := r.IsURLDeleted(context.Background(), ownerless)
+	require.NoError(t, err)
+	require.False(t, deleted)
+}
+
+func TestPostgresCreateLegacyUser(t *testing.T) {
+	r := newTestURL(t)
+	db, err := r.getPg()
+	require.NoError(t, err)
+
+	// saving the same user twice changes nothing
+	require.NoError(t, r.CreateLegacyUser(context.Background(), 5))
+	require.NoError(t, r.CreateLegacyUser(context.Background(), 5))
+
+	var count int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM users WHERE id = 5").Scan(&count))
+	require.Equal(t, 1, count)
+
+	id, err := r.CreateUser(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, id, domain.FirstUserID)
+}
+
+func TestPostgresGlobalDedup(t *testing.T) {
+	r := newTestURL(t)
+
+	suffix := fmt.Sprint(time.Now().UnixNano())
+	original := "https://ya.ru/" + suffix
+
+	_, err := r.Create(userInScope(1000, domain.DedupGlobal), []state.URLStringJSON{{UUID: 1, ShortURL: "a" + suffix, OriginalURL: original}})
+	require.NoError(t, err)
+
+	shrt, err := r.Create(userInScope(1001, domain.DedupGlobal), []state.URLStringJSON{{UUID: 2, ShortURL: "b" + suffix, OriginalURL: original}})
+	var uErr *domain.UniqueError
+	require.ErrorAs(t, err, &uErr)
+	require.Equal(t, "a"+suffix, shrt)
+
+	err = r.CreateBatch(userInScope(1001, domain.DedupGlobal), []*state.URLStringJSON{{UUID: 2, ShortURL: "b" + suffix, OriginalURL: original}})
+	require.ErrorAs(t, err, &uErr)
+
+	_, err = r.Create(userInScope(1001, domain.DedupGlobal), []state.URLStringJSON{{UUID: 2, ShortURL: "c" + suffix, OriginalURL: original + "/other"}})
+	require.NoError(t, err)
+
+	shrt, err = r.UpdateOriginal(userInScope(1001, domain.DedupGlobal), "c"+suffix, original, time.Now())
+	require.ErrorAs(t, err, &uErr)
+	require.Equal(t, "a"+suffix, shrt)
+
+	// every user has own short URL in per user scope
+	_, err = r.Create(userInScope(1001, domain.DedupPerUser), []state.URLStringJSON{{UUID: 3, ShortURL: "d" + suffix, OriginalURL: original}})
+	require.NoError(t, err)
+}
+
+func TestPostgresReadOwner(t *testing.T) {
+	r := newTestURL(t)
+
+	suffix := fmt.Sprint(time.Now().UnixNano())
+	_, err := r.Create(userInScope(1000, domain.DedupPerUser), []state.URLStringJSON{{UUID: 1, ShortURL: "a" + suffix, OriginalURL: "https://ya.ru/" + suffix}})
+	require.NoError(t, err)
+
+	owner, err := r.ReadOwner(context.Background(), "a"+suffix)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), owner)
+
+	_, err = r.ReadOwner(context.Background(), "b"+suffix)
+	require.ErrorIs(t, err, domain.ErrURLNotFound)
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// testRepo is an interface of the repositories which behave the same way with every storage.
+type testRepo interface {
+	domain.URLRepository
+	domain.UserRepository
+	domain.APIKeyRepository
+	domain.ClickRepository
+	domain.DeletionOutbox
+	domain.PolicyRepository
+	domain.TagRepository
+}
+
+// forEachRepo is a function to run the test for memory, file and bolt repositories, every one of them is started empty.
+func forEachRepo(t *testing.T, fn func(t *testing.T, r testRepo)) {
+	t.Run("memory", func(t *testing.T) {
+		fn(t, NewMemory())
+	})
+
+	t.Run("file", func(t *testing.T) {
+		fn(t, NewFile(filepath.Join(t.TempDir(), "db.json")))
+	})
+
+	t.Run("bolt", func(t *testing.T) {
+		b, err := NewBolt(filepath.Join(t.TempDir(), "urlshrt.db"))
+		require.NoError(t, err)
+		defer b.Close()
+
+		fn(t, b)
+	})
+}
+
+// reopen is a function to get the repository which reads again everything saved by r,
+// memory repository has nothing to read, so it is returned as it is.
+func reopen(t *testing.T, r testRepo) testRepo {
+	switch r := r.(type) {
+	case *File:
+		return NewFile(r.location)
+	case *Bolt:
+		path := r.db.Path()
+		require.NoError(t, r.Close())
+
+		b, err := NewBolt(path)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			b.Close()
+		})
+
+		return b
+	}
+
+	return r
+}
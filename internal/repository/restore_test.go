@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+func TestRestoreAndPurge(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	owner := domain.WithIdentity(context.Background(), domain.Identity{UserID: 1})
+	stranger := domain.WithIdentity(context.Background(), domain.Identity{UserID: 2})
+
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		_, err := r.Create(owner, []state.URLStringJSON{
+			{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru"},
+			{UUID: 2, ShortURL: "cba", OriginalURL: "https://mail.ru"},
+			{UUID: 3, ShortURL: "bca", OriginalURL: "https://hh.ru", ExpiresAt: &past},
+			{UUID: 4, ShortURL: "acb", OriginalURL: "https://go.dev"},
+		})
+		require.NoError(t, err)
+
+		_, err = r.DeleteUserURLs(context.Background(), []string{"abc", "cba"}, []int64{1, 1})
+		require.NoError(t, err)
+
+		_, err = r.DeleteExpiredURLs(context.Background(), now)
+		require.NoError(t, err)
+
+		require.NoError(t, r.CreateClicks(context.Background(), []domain.Click{{ShortURL: "cba", Time: now}}))
+
+		// grace period of the second URL is treated as over
+		statuses, err := r.RestoreUserURLs(owner, []string{"cba"}, future)
+		require.NoError(t, err)
+		require.Equal(t, []domain.RestoreStatus{domain.RestoreExpired}, statuses)
+
+		statuses, err = r.RestoreUserURLs(owner, []string{"abc", "abc", "bca", "acb", "nope"}, past)
+		require.NoError(t, err)
+		require.Equal(t, []domain.RestoreStatus{domain.RestoreRestored, domain.RestoreRestored, domain.RestoreExpired,
+			domain.RestoreNotDeleted, domain.RestoreNotFound}, statuses)
+
+		statuses, err = r.RestoreUserURLs(stranger, []string{"cba"}, past)
+		require.NoError(t, err)
+		require.Equal(t, []domain.RestoreStatus{domain.RestoreNotOwned}, statuses)
+
+		deleted, err := r.IsURLDeleted(context.Background(), "abc")
+		require.NoError(t, err)
+		require.False(t, deleted)
+
+		// URLs which were deleted later than the given time are kept
+		purged, err := r.PurgeDeletedURLs(context.Background(), past)
+		require.NoError(t, err)
+		require.Empty(t, purged)
+
+		purged, err = r.PurgeDeletedURLs(context.Background(), future)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"cba", "bca"}, purged)
+
+		_, err = r.IsURLDeleted(context.Background(), "cba")
+		require.Error(t, err)
+
+		clicks, err := r.ReadClicks(context.Background(), "cba")
+		require.NoError(t, err)
+		require.Empty(t, clicks)
+
+		urls, err := r.ReadUserURLs(owner, domain.URLQuery{})
+		require.NoError(t, err)
+		require.Len(t, urls, 2)
+
+		// short URL of purged URL may be used again, as well as its original URL
+		_, err = r.Create(stranger, []state.URLStringJSON{{UUID: 5, ShortURL: "cba", OriginalURL: "https://hh.ru"}})
+		require.NoError(t, err)
+		_, err = r.Create(owner, []state.URLStringJSON{{UUID: 6, ShortURL: "xyz", OriginalURL: "https://mail.ru"}})
+		require.NoError(t, err)
+
+		_, err = r.DeleteUserURLs(context.Background(), []string{"acb"}, []int64{1})
+		require.NoError(t, err)
+
+		// restorations, purges and times of deletion are kept after reopening
+		r = reopen(t, r)
+
+		urls, err = r.ReadAll(context.Background())
+		require.NoError(t, err)
+		require.Len(t, urls, 4)
+
+		for _, url := range urls {
+			require.Equal(t, url.ShortURL == "acb", url.IsDeleted, url.ShortURL)
+			require.Equal(t, url.ShortURL == "acb", url.DeletedAt != nil, url.ShortURL)
+		}
+
+		cba, err := r.ReadUserURLs(stranger, domain.URLQuery{})
+		require.NoError(t, err)
+		require.Len(t, cba, 1)
+		require.Equal(t, "https://hh.ru", cba[0].OriginalURL)
+	})
+}
+
+func TestBackfillDeletedAt(t *testing.T) {
+	dir := t.TempDir()
+
+	// URLs which were deleted before time of deletion was saved
+	fileLocation := filepath.Join(dir, "db.json")
+	require.NoError(t, os.WriteFile(fileLocation, []byte(
+		"{\"version\":2,\"op\":\"create\",\"short_url\":\"abc\",\"original_url\":\"https://ya.ru\",\"uuid\":1,\"user_id\":1}\n"+
+			"{\"version\":2,\"op\":\"delete\",\"short_url\":\"abc\",\"user_id\":1}\n"), 0600))
+
+	boltLocation := filepath.Join(dir, "urlshrt.db")
+	b, err := NewBolt(boltLocation)
+	require.NoError(t, err)
+	require.NoError(t, b.db.Update(func(tx *bolt.Tx) error {
+		return putBoltURL(tx, state.URLStringJSON{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru", UserID: 1, IsDeleted: true})
+	}))
+	require.NoError(t, b.Close())
+
+	b, err = NewBolt(boltLocation)
+	require.NoError(t, err)
+
+	past := time.Now().Add(-time.Hour)
+	owner := domain.WithIdentity(context.Background(), domain.Identity{UserID: 1})
+
+	for name, r := range map[string]domain.URLRepository{"file": NewFile(fileLocation), "bolt": b} {
+		// the URLs got time of loading, so they are not purged at once and may be restored during grace period
+		purged, err := r.PurgeDeletedURLs(context.Background(), past)
+		require.NoError(t, err, name)
+		require.Empty(t, purged, name)
+
+		urls, err := r.ReadAll(context.Background())
+		require.NoError(t, err, name)
+		require.Len(t, urls, 1, name)
+		require.NotNil(t, urls[0].DeletedAt, name)
+		require.True(t, urls[0].DeletedAt.After(past), name)
+
+		statuses, err := r.RestoreUserURLs(owner, []string{"abc"}, past)
+		require.NoError(t, err, name)
+		require.Equal(t, []domain.RestoreStatus{domain.RestoreRestored}, statuses, name)
+	}
+
+	// time given to the URL is saved, so it is not moved by every loading
+	require.NoError(t, b.Close())
+	require.NoError(t, os.WriteFile(fileLocation, []byte(
+		"{\"version\":2,\"op\":\"create\",\"short_url\":\"abc\",\"original_url\":\"https://ya.ru\",\"uuid\":1,\"user_id\":1}\n"+
+			"{\"version\":2,\"op\":\"delete\",\"short_url\":\"abc\",\"user_id\":1}\n"), 0600))
+
+	f := NewFile(fileLocation)
+	urls, err := f.ReadAll(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, urls[0].DeletedAt)
+	deletedAt := *urls[0].DeletedAt
+
+	urls, err = NewFile(fileLocation).ReadAll(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, urls[0].DeletedAt)
+	require.True(t, deletedAt.Equal(*urls[0].DeletedAt))
+}
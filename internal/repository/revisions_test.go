@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+func TestUpdateOriginal(t *testing.T) {
+	now := time.Now()
+	owner := domain.WithIdentity(context.Background(), domain.Identity{UserID: 1})
+	stranger := domain.WithIdentity(context.Background(), domain.Identity{UserID: 2})
+
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		_, err := r.Create(owner, []state.URLStringJSON{
+			{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru"},
+			{UUID: 2, ShortURL: "cba", OriginalURL: "https://mail.ru"},
+			{UUID: 3, ShortURL: "bca", OriginalURL: "https://hh.ru"},
+		})
+		require.NoError(t, err)
+
+		_, err = r.UpdateOriginal(owner, "abc", "https://go.dev", now)
+		require.NoError(t, err)
+		_, err = r.UpdateOriginal(owner, "abc", "https://pkg.go.dev", now.Add(time.Second))
+		require.NoError(t, err)
+
+		// nothing is changed if the URL already has the original URL
+		_, err = r.UpdateOriginal(owner, "abc", "https://pkg.go.dev", now)
+		require.NoError(t, err)
+
+		// the owner can't have two short URLs for the same original URL
+		shrt, err := r.UpdateOriginal(owner, "abc", "https://mail.ru", now)
+		var uErr *domain.UniqueError
+		require.True(t, errors.As(err, &uErr))
+		require.Equal(t, "cba", shrt)
+
+		_, err = r.UpdateOriginal(stranger, "abc", "https://ozon.ru", now)
+		require.ErrorIs(t, err, domain.ErrURLNotFound)
+		_, err = r.UpdateOriginal(owner, "nope", "https://ozon.ru", now)
+		require.ErrorIs(t, err, domain.ErrURLNotFound)
+
+		_, err = r.ReadRevisions(stranger, "abc")
+		require.ErrorIs(t, err, domain.ErrURLNotFound)
+
+		revisions, err := r.ReadRevisions(owner, "abc")
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		require.Equal(t, 2, revisions[1].Revision)
+		require.Equal(t, "https://go.dev", revisions[1].PreviousURL)
+		require.Equal(t, "https://pkg.go.dev", revisions[1].OriginalURL)
+
+		// old original URL is free, so it may be saved again
+		_, err = r.Create(owner, []state.URLStringJSON{{UUID: 4, ShortURL: "acb", OriginalURL: "https://ya.ru"}})
+		require.NoError(t, err)
+
+		// deleted URL can't be changed, and revisions of purged URL are removed with it
+		_, err = r.UpdateOriginal(owner, "bca", "https://ozon.ru", now)
+		require.NoError(t, err)
+		_, err = r.DeleteUserURLs(context.Background(), []string{"bca"}, []int64{1})
+		require.NoError(t, err)
+		_, err = r.UpdateOriginal(owner, "bca", "https://hh.ru", now)
+		require.ErrorIs(t, err, domain.ErrURLNotFound)
+
+		_, err = r.PurgeDeletedURLs(context.Background(), now.Add(time.Hour))
+		require.NoError(t, err)
+		_, err = r.Create(stranger, []state.URLStringJSON{{UUID: 5, ShortURL: "bca", OriginalURL: "https://hh.ru"}})
+		require.NoError(t, err)
+
+		revisions, err = r.ReadRevisions(stranger, "bca")
+		require.NoError(t, err)
+		require.Empty(t, revisions)
+
+		// changes of original URLs and revisions are kept after reopening
+		r = reopen(t, r)
+
+		urls, err := r.ReadUserURLs(owner, domain.URLQuery{})
+		require.NoError(t, err)
+		require.Len(t, urls, 3)
+		require.Equal(t, "https://pkg.go.dev", urls[0].OriginalURL)
+
+		revisions, err = r.ReadRevisions(owner, "abc")
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		require.Equal(t, "https://ya.ru", revisions[0].PreviousURL)
+
+		revisions, err = r.ReadRevisions(stranger, "bca")
+		require.NoError(t, err)
+		require.Empty(t, revisions)
+
+		// the next revision gets the next number
+		_, err = r.UpdateOriginal(owner, "abc", "https://go.dev", now)
+		require.NoError(t, err)
+
+		revisions, err = r.ReadRevisions(owner, "abc")
+		require.NoError(t, err)
+		require.Len(t, revisions, 3)
+		require.Equal(t, 3, revisions[2].Revision)
+	})
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+// hasTag is a function to check if sorted tags contain the tag.
+func hasTag(tags []string, tag string) bool {
+	i := sort.SearchStrings(tags, tag)
+	return i < len(tags) && tags[i] == tag
+}
+
+// mergeTags is a function to get tags of URL after tags are added to it and removed from it. Tags are sorted,
+// URL can't have more than domain.MaxTags tags.
+func mergeTags(current []string, added []string, removed []string) ([]string, error) {
+	set := make(map[string]struct{}, len(current)+len(added))
+	for _, tag := range current {
+		set[tag] = struct{}{}
+	}
+
+	for _, tag := range added {
+		set[tag] = struct{}{}
+	}
+
+	for _, tag := range removed {
+		delete(set, tag)
+	}
+
+	if len(set) > domain.MaxTags {
+		return nil, fmt.Errorf("%w: url should have no more than %d tags", domain.ErrInvalidMetadata, domain.MaxTags)
+	}
+
+	tags := make([]string, 0, len(set))
+	for tag := range set {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	return tags, nil
+}
+
+// countTags is a function to count URLs by their tags, tags are sorted by name.
+func countTags(urls []state.URLStringJSON) []domain.TagCount {
+	counts := make(map[string]int)
+	for _, url := range urls {
+		for _, tag := range url.Tags {
+			counts[tag]++
+		}
+	}
+
+	tags := make([]domain.TagCount, 0, len(counts))
+	for tag, count := range counts {
+		tags = append(tags, domain.TagCount{Tag: tag, Count: count})
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Tag < tags[j].Tag
+	})
+
+	return tags
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+func TestUpdateTags(t *testing.T) {
+	owner := domain.WithIdentity(context.Background(), domain.Identity{UserID: 1})
+	stranger := domain.WithIdentity(context.Background(), domain.Identity{UserID: 2})
+
+	tooMany := make([]string, domain.MaxTags)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("tag%02d", i)
+	}
+
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		_, err := r.Create(owner, []state.URLStringJSON{
+			{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru", Tags: []string{"search"}},
+			{UUID: 2, ShortURL: "cba", OriginalURL: "https://mail.ru"},
+			{UUID: 3, ShortURL: "bca", OriginalURL: "https://hh.ru"},
+		})
+		require.NoError(t, err)
+
+		tags, err := r.UpdateTags(owner, "abc", []string{"work", "search"}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"search", "work"}, tags)
+
+		_, err = r.UpdateTags(owner, "cba", []string{"work"}, nil)
+		require.NoError(t, err)
+
+		tags, err = r.UpdateTags(owner, "abc", nil, []string{"search", "nope"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"work"}, tags)
+
+		_, err = r.UpdateTags(owner, "bca", tooMany, nil)
+		require.NoError(t, err)
+		_, err = r.UpdateTags(owner, "bca", []string{"one-more"}, nil)
+		require.ErrorIs(t, err, domain.ErrInvalidMetadata)
+
+		_, err = r.UpdateTags(stranger, "abc", []string{"mine"}, nil)
+		require.ErrorIs(t, err, domain.ErrURLNotFound)
+		_, err = r.UpdateTags(owner, "nope", []string{"mine"}, nil)
+		require.ErrorIs(t, err, domain.ErrURLNotFound)
+
+		// tags of deleted URL can't be changed
+		_, err = r.DeleteUserURLs(context.Background(), []string{"bca"}, []int64{1})
+		require.NoError(t, err)
+		_, err = r.UpdateTags(owner, "bca", nil, []string{"tag00"})
+		require.ErrorIs(t, err, domain.ErrURLNotFound)
+
+		// tags are kept after reopening
+		r = reopen(t, r)
+
+		counts, err := r.ReadTags(owner)
+		require.NoError(t, err)
+		require.Len(t, counts, domain.MaxTags+1)
+		require.Equal(t, domain.TagCount{Tag: "tag00", Count: 1}, counts[0])
+		require.Equal(t, domain.TagCount{Tag: "work", Count: 2}, counts[domain.MaxTags])
+
+		counts, err = r.ReadTags(stranger)
+		require.NoError(t, err)
+		require.Empty(t, counts)
+
+		urls, err := r.ReadUserURLs(owner, domain.URLQuery{URLFilter: domain.URLFilter{Tag: "work"}})
+		require.NoError(t, err)
+		require.Len(t, urls, 2)
+		require.Equal(t, "abc", urls[0].ShortURL)
+		require.Equal(t, "cba", urls[1].ShortURL)
+	})
+}
@@ -2,31 +2,99 @@
 package repository
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
-	"os"
-	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/PoorMercymain/urlshrt/internal/domain"
 	"github.com/PoorMercymain/urlshrt/internal/state"
 	"github.com/PoorMercymain/urlshrt/pkg/util"
 )
 
+const (
+	// shortUniqueIndex is a name of the index which doesn't let the same short URL be saved twice.
+	shortUniqueIndex = "idx_short_unique"
+	// userOriginalUniqueIndex is a name of the index which doesn't let a user save the same original URL twice.
+	userOriginalUniqueIndex = "idx_user_original_unique"
+	// originalLockClass is the first key of advisory locks which are taken on original URLs deduplicated for all the users.
+	originalLockClass = 1001
+
+	insertURL = "INSERT INTO urlshrt (uuid, short, original, user_id, is_deleted, expires_at, not_before, created_at, updated_at, title, note) " +
+		"VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)"
+	insertTags = "INSERT INTO url_tags (short, tag) SELECT $1, unnest($2::text[]) ON CONFLICT DO NOTHING"
+
+	// createdKey is time of creation of URL by which URLs of a user are listed, it is indexed by idx_user_created_short
+	createdKey = "COALESCE(created_at, '0001-01-01 00:00:00+00'::timestamptz)"
+
+	// metadataColumns are columns with metadata of URL, they are scanned by urlMetadata
+	metadataColumns = "created_at, updated_at, title, note, " +
+		"COALESCE((SELECT array_agg(tag ORDER BY tag) FROM url_tags WHERE url_tags.short = urlshrt.short), '{}')"
+)
+
+// urlMetadata is a type to scan metadata of URL from metadataColumns.
+type urlMetadata struct {
+	createdAt, updatedAt sql.NullTime
+	title, note          string
+	tags                 []string
+}
+
+// dest is a method to get destinations of metadataColumns for Scan, types is used to scan array of tags.
+func (m *urlMetadata) dest(types *pgtype.Map) []interface{} {
+	return []interface{}{&m.createdAt, &m.updatedAt, &m.title, &m.note, types.SQLScanner(&m.tags)}
+}
+
+// apply is a method to set scanned metadata to URL.
+func (m *urlMetadata) apply(u *state.URLStringJSON) {
+	u.CreatedAt, u.UpdatedAt, u.Title, u.Note = timeOrNil(m.createdAt), timeOrNil(m.updatedAt), m.title, m.note
+	if len(m.tags) > 0 {
+		u.Tags = m.tags
+	}
+}
+
+// saveTags is a function to save tags of URL in transaction.
+func saveTags(ctx context.Context, tx *sql.Tx, url state.URLStringJSON) error {
+	if len(url.Tags) == 0 {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, insertTags, url.ShortURL, url.Tags)
+	return err
+}
+
 type URL struct {
-	locationOfJSON string
-	pg             *state.Postgres
+	file *File
+	pg   *state.Postgres
 }
 
 func NewURL(locationOfJSON string, pg *state.Postgres) *URL {
-	return &URL{locationOfJSON: locationOfJSON, pg: pg}
+	return &URL{file: NewFile(locationOfJSON), pg: pg}
+}
+
+// getPg is a function to get connection to postgres. If postgres is not used, nil is returned and file storage should be used.
+// Once postgres is used, its errors are returned instead of switching to file storage, otherwise data would be split between them.
+func (r *URL) getPg() (*sql.DB, error) {
+	if r.pg == nil || r.pg.GetDSN() == "" {
+		return nil, nil
+	}
+
+	return r.pg.GetPgPtr()
+}
+
+// RunCompaction compacts the file storage every interval until the context is done. Nothing is done if postgres is used.
+func (r *URL) RunCompaction(ctx context.Context, interval time.Duration) {
+	if r.pg != nil && r.pg.GetDSN() != "" {
+		return
+	}
+
+	r.file.RunCompaction(ctx, interval)
 }
 
 func (r *URL) WithTransaction(db *sql.DB, txFunc func(*sql.Tx) error) error {
@@ -62,189 +130,162 @@ func (r *URL) PingPg(ctx context.Context) error {
 
 // ReadAll is a function which is used in another function of the app's database level. It gets all URL's data from a database.
 func (r *URL) ReadAll(ctx context.Context) ([]state.URLStringJSON, error) {
-	var db *sql.DB
-	var errOuter error
-	if db, errOuter = r.pg.GetPgPtr(); errOuter != nil || r.PingPg(ctx) != nil || r.pg.GetDSN() == "" {
-		f, err := os.Open(r.locationOfJSON)
-		if err != nil {
-			return nil, err
-		}
-
-		defer func() {
-			if err := f.Close(); err != nil {
-				util.GetLogger().Infoln(err)
-			}
-		}()
-
-		scanner := bufio.NewScanner(f)
-
-		jsonSlice := make([]state.URLStringJSON, 0)
-		var jsonSliceElemBuffer state.URLStringJSON
-
-		for scanner.Scan() {
-			buf := bytes.NewBuffer([]byte(scanner.Text()))
-
-			err := json.Unmarshal(buf.Bytes(), &jsonSliceElemBuffer)
-			if err != nil {
-				return nil, err
-			}
-
-			jsonSlice = append(jsonSlice, jsonSliceElemBuffer)
-		}
-
-		return jsonSlice, nil
+	db, err := r.getPg()
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return r.file.ReadAll(ctx)
 	}
 
-	rows, errOuter := db.QueryContext(ctx, "SELECT uuid, short, original FROM urlshrt")
-	if errOuter != nil {
-		return nil, errOuter
+	rows, err := db.QueryContext(ctx, "SELECT uuid, short, original, COALESCE(user_id, -1), expires_at, not_before, is_disabled, "+
+		metadataColumns+" FROM urlshrt")
+	if err != nil {
+		return nil, err
 	}
 	defer rows.Close()
 	if rows.Err() != nil {
 		return nil, rows.Err()
 	}
+	types := pgtype.NewMap()
 	urlsFromPg := make([]state.URLStringJSON, 0)
 	for rows.Next() {
 		var u state.URLStringJSON
+		var expiresAt, notBefore sql.NullTime
+		var meta urlMetadata
 
-		errOuter = rows.Scan(&u.UUID, &u.ShortURL, &u.OriginalURL)
-		if errOuter != nil {
-			return nil, errOuter
+		err = rows.Scan(append([]interface{}{&u.UUID, &u.ShortURL, &u.OriginalURL, &u.UserID, &expiresAt, &notBefore, &u.IsDisabled},
+			meta.dest(types)...)...)
+		if err != nil {
+			return nil, err
 		}
+		u.ExpiresAt, u.NotBefore = timeOrNil(expiresAt), timeOrNil(notBefore)
+		meta.apply(&u)
 		urlsFromPg = append(urlsFromPg, u)
 	}
 	return urlsFromPg, nil
 }
 
-// Create is a function which saves the URL data (original, shortened...) to a database.
-func (r *URL) Create(ctx context.Context, urls []state.URLStringJSON) (string, error) {
-	var db *sql.DB
-	var err error
-	var f *os.File
+// timeOrNil is a function to convert nullable time from database to pointer.
+func timeOrNil(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
 
-	if db, err = r.pg.GetPgPtr(); err != nil || r.PingPg(ctx) != nil || r.pg.GetDSN() == "" {
-		if r.locationOfJSON == "" {
-			return "", nil
-		}
-		err = os.MkdirAll(filepath.Dir(r.locationOfJSON), 0600)
-		if err != nil {
-			util.GetLogger().Infoln("save mkdir", err)
-			return "", err
-		}
+	return &t.Time
+}
 
-		f, err = os.OpenFile(r.locationOfJSON, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0600)
-		if err != nil {
-			util.GetLogger().Infoln("save", err)
-			return "", err
-		}
+// Create is a function which saves the URL data (original, shortened...) with its tags to a database, every URL is saved in its own transaction.
+// If an original URL was already saved in the scope from context, its short version is returned with UniqueError.
+func (r *URL) Create(ctx context.Context, urls []state.URLStringJSON) (string, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return "", err
+	} else if db == nil {
+		return r.file.Create(ctx, urls)
+	}
 
-		defer func() {
-			if err = f.Close(); err != nil {
-				util.GetLogger().Infoln(err)
+	id := domain.UserIDFromContext(ctx)
+	scope := domain.DedupScopeFromContext(ctx)
+	for _, url := range urls {
+		var shrt string
+		err := r.WithTransaction(db, func(tx *sql.Tx) error {
+			var err error
+			if shrt, err = lockOriginals(ctx, tx, scope, []string{url.OriginalURL}); err != nil {
+				return err
 			}
-		}()
-
-		var urlsFromFile []state.URLStringJSON
-		urlsFromFile, err = r.ReadAll(ctx)
-		if err != nil {
-			return "", err
-		}
-		urlsFromFileMap := make(map[string]state.URLStringJSON)
-		for _, url := range urlsFromFile {
-			urlsFromFileMap[url.OriginalURL] = url
-		}
 
-		for _, str := range urls {
-			if _, ok := urlsFromFileMap[str.OriginalURL]; !ok {
-				var jsonByteSlice []byte
-				jsonByteSlice, err = json.Marshal(str)
-				if err != nil {
-					return "", err
-				}
-				buf := bytes.NewBuffer(jsonByteSlice)
-				buf.WriteByte('\n')
-				_, err = f.WriteString(buf.String())
-				if err != nil {
-					return "", err
-				}
+			_, err = tx.ExecContext(ctx, insertURL, url.UUID, url.ShortURL, url.OriginalURL, id, 0, url.ExpiresAt, url.NotBefore,
+				url.CreatedAt, url.UpdatedAt, url.Title, url.Note)
+			if err != nil {
+				return mapUniqueViolation(err)
 			}
-		}
 
-		return "", nil
-	}
-	for _, url := range urls {
+			return saveTags(ctx, tx, url)
+		})
 
-		var pgErr *pgconn.PgError
-		id := ctx.Value(domain.Key("id")).(int64)
-		_, err = db.ExecContext(ctx, "INSERT INTO urlshrt VALUES($1, $2, $3, $4, $5)", url.UUID, url.ShortURL, url.OriginalURL, id, 0)
-		if err != nil {
-			if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
-				uErr := domain.NewUniqueError(err)
-				row := db.QueryRow("SELECT short FROM urlshrt WHERE original = $1", url.OriginalURL)
-				var shrt string
-				errScan := row.Scan(&shrt)
-				if errScan != nil {
-					return "", errScan
-				}
-				return shrt, uErr
+		var uErr *domain.UniqueError
+		if errors.As(err, &uErr) {
+			if shrt != "" {
+				return shrt, err
 			}
+
+			row := db.QueryRowContext(ctx, "SELECT short FROM urlshrt WHERE COALESCE(user_id, -1) = $1 AND original = $2", id, url.OriginalURL)
+			if errScan := row.Scan(&shrt); errScan != nil {
+				return "", errScan
+			}
+			return shrt, err
 		}
 
+		if err != nil {
+			return "", err
+		}
 	}
+
 	return "", nil
 }
 
-// CreateBatch is a function which saves URL data to a database when original URLs were in JSON batch.
-func (r *URL) CreateBatch(ctx context.Context, batch []*state.URLStringJSON) error {
-	var db *sql.DB
-	var err error
+// lockOriginals makes the transaction wait for other transactions which save the same original URLs if they are deduplicated
+// for all the users and checks if any of them was already saved by anybody, its short URL is returned with UniqueError then.
+// Locks are held until the end of the transaction, so the check and the write can't be interleaved with another transaction.
+// Original URLs of one user are checked by unique index, so nothing is done for per user scope.
+func lockOriginals(ctx context.Context, tx *sql.Tx, scope domain.DedupScope, originals []string) (string, error) {
+	if scope != domain.DedupGlobal {
+		return "", nil
+	}
 
-	if r.pg != nil {
-		db, err = r.pg.GetPgPtr()
+	// locks are taken in the same order by all the transactions, so they never wait for each other in a cycle
+	sorted := append(make([]string, 0, len(originals)), originals...)
+	sort.Strings(sorted)
+	for _, original := range sorted {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, hashtext($2))", originalLockClass, original); err != nil {
+			return "", err
+		}
 	}
 
-	if r.pg == nil || err != nil || r.PingPg(ctx) != nil || r.pg.GetDSN() == "" {
-		if r.locationOfJSON == "" {
-			return nil
+	for _, original := range originals {
+		var shrt string
+		err := tx.QueryRowContext(ctx, "SELECT short FROM urlshrt WHERE original = $1 ORDER BY short LIMIT 1", original).Scan(&shrt)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
 		}
-		err = os.MkdirAll(filepath.Dir(r.locationOfJSON), 0600)
 		if err != nil {
-			util.GetLogger().Infoln("save mkdir", err)
-			return err
+			return "", err
 		}
 
-		var f *os.File
-		f, err = os.OpenFile(r.locationOfJSON, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0600)
-		if err != nil {
-			util.GetLogger().Infoln("save", err)
-			return err
-		}
+		return shrt, domain.NewUniqueError(errors.New("original url already exists"))
+	}
 
-		defer func() {
-			if err = f.Close(); err != nil {
-				util.GetLogger().Infoln(err)
-			}
-		}()
+	return "", nil
+}
 
-		for _, str := range batch {
-			var jsonByteSlice []byte
-			jsonByteSlice, err = json.Marshal(str)
-			if err != nil {
-				return err
-			}
-			buf := bytes.NewBuffer(jsonByteSlice)
-			buf.WriteByte('\n')
-			_, err := f.WriteString(buf.String())
-			if err != nil {
-				return err
-			}
-		}
+// mapUniqueViolation is a function to convert unique violation from database to error of domain level, other errors are returned as is.
+func mapUniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != pgerrcode.UniqueViolation {
+		return err
+	}
 
-		return nil
+	switch pgErr.ConstraintName {
+	case shortUniqueIndex:
+		return domain.ErrShortURLExists
+	case userOriginalUniqueIndex:
+		return domain.NewUniqueError(err)
+	default:
+		return err
+	}
+}
+
+// CreateBatch is a function which saves URL data to a database when original URLs were in JSON batch.
+func (r *URL) CreateBatch(ctx context.Context, batch []*state.URLStringJSON) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.CreateBatch(ctx, batch)
 	}
 
 	return r.WithTransaction(db, func(tx *sql.Tx) error {
-		stmt, err := tx.PrepareContext(ctx, "INSERT INTO urlshrt VALUES($1, $2, $3, $4, $5)")
+		stmt, err := tx.PrepareContext(ctx, insertURL)
 
 		if err != nil {
 			return err
@@ -257,12 +298,26 @@ func (r *URL) CreateBatch(ctx context.Context, batch []*state.URLStringJSON) err
 		}
 		util.GetLogger().Infoln(len(batch))
 
-		id := ctx.Value(domain.Key("id")).(int64)
+		id := domain.UserIDFromContext(ctx)
+
+		originals := make([]string, len(batch))
+		for i, url := range batch {
+			originals[i] = url.OriginalURL
+		}
+
+		if _, err = lockOriginals(ctx, tx, domain.DedupScopeFromContext(ctx), originals); err != nil {
+			return err
+		}
 
 		for _, url := range batch {
 			util.GetLogger().Infoln(url.OriginalURL, url.ShortURL)
-			_, err = stmt.ExecContext(ctx, url.UUID, url.ShortURL, url.OriginalURL, id, 0)
+			_, err = stmt.ExecContext(ctx, url.UUID, url.ShortURL, url.OriginalURL, id, 0, url.ExpiresAt, url.NotBefore,
+				url.CreatedAt, url.UpdatedAt, url.Title, url.Note)
 			if err != nil {
+				return mapUniqueViolation(err)
+			}
+
+			if err = saveTags(ctx, tx, *url); err != nil {
 				return err
 			}
 		}
@@ -271,20 +326,18 @@ func (r *URL) CreateBatch(ctx context.Context, batch []*state.URLStringJSON) err
 	})
 }
 
-func (r *URL) ReadUserURLs(ctx context.Context) ([]state.URLStringJSON, error) {
-	var db *sql.DB
-	var err error
-	if db, err = r.pg.GetPgPtr(); err != nil || r.PingPg(ctx) != nil || r.pg.GetDSN() == "" {
-		if err != nil {
-			return make([]state.URLStringJSON, 0), err
-		} else {
-			return make([]state.URLStringJSON, 0), errors.New("postgres not found")
-		}
+// ReadUserURLs gets URLs created by user whose id is in context which match query. URLs are read by keyset of time of creation
+// and short URL, so pages are read by index without skipping the previous ones.
+func (r *URL) ReadUserURLs(ctx context.Context, query domain.URLQuery) ([]state.URLStringJSON, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return r.file.ReadUserURLs(ctx, query)
 	}
 
-	id := ctx.Value(domain.Key("id")).(int64)
-
-	rows, err := db.QueryContext(ctx, "SELECT uuid, short, original FROM urlshrt WHERE user_id = $1", id)
+	sqlQuery, args := userURLsQuery(domain.UserIDFromContext(ctx), query)
+	rows, err := db.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -292,35 +345,138 @@ func (r *URL) ReadUserURLs(ctx context.Context) ([]state.URLStringJSON, error) {
 	if rows.Err() != nil {
 		return nil, rows.Err()
 	}
+	types := pgtype.NewMap()
 	urlsFromPg := make([]state.URLStringJSON, 0)
 	for rows.Next() {
 		var u state.URLStringJSON
+		var meta urlMetadata
 
-		err = rows.Scan(&u.UUID, &u.ShortURL, &u.OriginalURL)
+		err = rows.Scan(append([]interface{}{&u.UUID, &u.ShortURL, &u.OriginalURL}, meta.dest(types)...)...)
 		if err != nil {
 			return nil, err
 		}
+		meta.apply(&u)
 		urlsFromPg = append(urlsFromPg, u)
 	}
-	return urlsFromPg, nil
+	return urlsFromPg, rows.Err()
+}
+
+// ReadOwner gets id of the user who saved URL from database (-1 if the URL has no owner), ErrURLNotFound is returned if there is no such URL.
+func (r *URL) ReadOwner(ctx context.Context, shortened string) (int64, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return -1, err
+	} else if db == nil {
+		return r.file.ReadOwner(ctx, shortened)
+	}
+
+	var owner int64
+	err = db.QueryRowContext(ctx, "SELECT COALESCE(user_id, -1) FROM urlshrt WHERE short = $1", shortened).Scan(&owner)
+	if errors.Is(err, sql.ErrNoRows) {
+		return -1, domain.ErrURLNotFound
+	}
+	if err != nil {
+		return -1, err
+	}
+
+	return owner, nil
+}
+
+// userURLsQuery is a function to build query which reads URLs of the user matching query with its arguments.
+func userURLsQuery(id int64, query domain.URLQuery) (string, []interface{}) {
+	args := []interface{}{id}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	var b strings.Builder
+	b.WriteString("SELECT uuid, short, original, " + metadataColumns + " FROM urlshrt WHERE user_id = $1")
+
+	if query.Contains != "" {
+		b.WriteString(" AND original ILIKE '%' || " + arg(escapeLike(query.Contains)) + " || '%'")
+	}
+
+	// host is generated from original URL in lower case, URLs of subdomains are listed too
+	if query.Domain != "" {
+		d := strings.ToLower(query.Domain)
+		b.WriteString(" AND (host = " + arg(d) + " OR host LIKE '%.' || " + arg(escapeLike(d)) + ")")
+	}
+
+	direction, comparison := "ASC", ">"
+	if query.Order == domain.SortDesc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if query.Tag != "" {
+		b.WriteString(" AND EXISTS (SELECT 1 FROM url_tags WHERE url_tags.short = urlshrt.short AND url_tags.tag = " + arg(query.Tag) + ")")
+	}
+
+	// URLs which were saved before time of creation was kept have zero time, like in cursors made of them
+	if query.After != nil {
+		b.WriteString(" AND (" + createdKey + ", short) " + comparison + " (" + arg(query.After.CreatedAt) + ", " + arg(query.After.ShortURL) + ")")
+	}
+
+	b.WriteString(" ORDER BY " + createdKey + " " + direction + ", short " + direction)
+
+	if query.Limit > 0 {
+		b.WriteString(" LIMIT " + arg(query.Limit))
+	}
+
+	return b.String(), args
 }
 
-func (r *URL) DeleteUserURLs(ctx context.Context, shortURLs []string, uid []int64) error {
-	var db *sql.DB
-	var err error
+// escapeLike is a function to escape symbols which have special meaning in LIKE patterns, so they are matched as is.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// DeleteUserURLs marks URLs as deleted, every URL is marked only if it belongs to the user with id of the same index.
+// Statuses of the URLs are returned in the same order.
+func (r *URL) DeleteUserURLs(ctx context.Context, shortURLs []string, uid []int64) ([]domain.DeletionStatus, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return r.file.DeleteUserURLs(ctx, shortURLs, uid)
+	}
+
+	if len(shortURLs) != len(uid) {
+		return nil, errors.New("amounts of urls and user ids are not equal")
+	}
+
+	util.GetLogger().Infoln(shortURLs)
 
-	if r.pg != nil {
-		db, err = r.pg.GetPgPtr()
+	statuses := make([]domain.DeletionStatus, len(shortURLs))
+	err = r.WithTransaction(db, func(tx *sql.Tx) error {
+		// owners are read in the same transaction, so statuses match what was marked,
+		// URLs saved before they got owners have no user id, so nobody owns them (deletionStatus treats -1 as no owner)
+		rows, err := tx.Query("SELECT short, COALESCE(user_id, -1) FROM urlshrt WHERE short = ANY($1::text[]) FOR UPDATE", shortURLs)
 		if err != nil {
-			util.GetLogger().Infoln("err1", err)
 			return err
 		}
-	}
+		defer rows.Close()
 
-	util.GetLogger().Infoln(shortURLs)
+		owners := make(map[string]int64, len(shortURLs))
+		for rows.Next() {
+			var short string
+			var owner int64
+			if err = rows.Scan(&short, &owner); err != nil {
+				return err
+			}
+			owners[short] = owner
+		}
 
-	return r.WithTransaction(db, func(tx *sql.Tx) error {
-		stmt, err := tx.Prepare("UPDATE urlshrt SET is_deleted = 1 WHERE (short, user_id) IN (SELECT unnest($1::text[]), unnest($2::int[]))")
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		for i, shrt := range shortURLs {
+			owner, ok := owners[shrt]
+			statuses[i] = deletionStatus(ok, owner, uid[i])
+		}
+
+		stmt, err := tx.Prepare("UPDATE urlshrt SET is_deleted = 1, deleted_at = now() WHERE is_deleted = 0 AND (short, user_id) IN (SELECT unnest($1::text[]), unnest($2::bigint[]))")
 
 		if err != nil {
 			util.GetLogger().Infoln("err4", err)
@@ -337,20 +493,23 @@ func (r *URL) DeleteUserURLs(ctx context.Context, shortURLs []string, uid []int6
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
 }
 
 func (r *URL) IsURLDeleted(ctx context.Context, shortened string) (bool, error) {
-	var db *sql.DB
-	var err error
-	var isDeleted int
-
-	if r.pg != nil {
-		db, err = r.pg.GetPgPtr()
-		if err != nil {
-			return false, err
-		}
+	db, err := r.getPg()
+	if err != nil {
+		return false, err
+	} else if db == nil {
+		return r.file.IsURLDeleted(ctx, shortened)
 	}
 
+	var isDeleted int
+
 	util.GetLogger().Infoln(shortened)
 	row := db.QueryRow("SELECT is_deleted FROM urlshrt WHERE short = $1", shortened)
 	util.GetLogger().Infoln(row.Err())
@@ -365,18 +524,47 @@ func (r *URL) IsURLDeleted(ctx context.Context, shortened string) (bool, error)
 	return true, nil
 }
 
-func (r *URL) CountURLsAndUsers(ctx context.Context) (int, int, error) {
-	var db *sql.DB
-	var err error
-	var totalURLs, totalUsers int
+// DisableURLs marks URLs as disabled, so they don't redirect anywhere. Unknown short URLs are skipped.
+func (r *URL) DisableURLs(ctx context.Context, shortURLs []string) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.DisableURLs(ctx, shortURLs)
+	}
 
-	if r.pg != nil {
-		db, err = r.pg.GetPgPtr()
-		if err != nil {
-			return 0, 0, err
-		}
+	_, err = db.ExecContext(ctx, "UPDATE urlshrt SET is_disabled = TRUE WHERE is_disabled = FALSE AND short = ANY($1::text[])", shortURLs)
+	return err
+}
+
+// DeleteExpiredURLs marks URLs whose expiration time has come as deleted, amount of marked URLs is returned.
+func (r *URL) DeleteExpiredURLs(ctx context.Context, now time.Time) (int, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return 0, err
+	} else if db == nil {
+		return r.file.DeleteExpiredURLs(ctx, now)
+	}
+
+	res, err := db.ExecContext(ctx, "UPDATE urlshrt SET is_deleted = 1, deleted_at = $1 WHERE is_deleted = 0 AND expires_at <= $1", now)
+	if err != nil {
+		return 0, err
+	}
+
+	marked, err := res.RowsAffected()
+	return int(marked), err
+}
+
+func (r *URL) CountURLsAndUsers(ctx context.Context) (int, int, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return 0, 0, err
+	} else if db == nil {
+		return r.file.CountURLsAndUsers(ctx)
 	}
 
+	var totalURLs, totalUsers int
+
 	err = db.QueryRow("SELECT (SELECT COUNT(*) FROM urlshrt WHERE is_deleted = 0) AS total_urls, (SELECT COUNT(DISTINCT user_id) FROM urlshrt WHERE is_deleted = 0) AS total_users").Scan(&totalURLs, &totalUsers)
 	if err != nil {
 		util.GetLogger().Infoln(err)
@@ -385,3 +573,813 @@ func (r *URL) CountURLsAndUsers(ctx context.Context) (int, int, error) {
 
 	return totalURLs, totalUsers, err
 }
+
+// RestoreUserURLs unmarks deleted URLs of the user whose id is in context if they were deleted after deletedAfter.
+// Statuses of the URLs are returned in the same order.
+func (r *URL) RestoreUserURLs(ctx context.Context, shortURLs []string, deletedAfter time.Time) ([]domain.RestoreStatus, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return r.file.RestoreUserURLs(ctx, shortURLs, deletedAfter)
+	}
+
+	id := domain.UserIDFromContext(ctx)
+	now := time.Now()
+
+	statuses := make([]domain.RestoreStatus, len(shortURLs))
+	err = r.WithTransaction(db, func(tx *sql.Tx) error {
+		// URLs are read in the same transaction, so statuses match what was unmarked
+		rows, err := tx.QueryContext(ctx, "SELECT short, COALESCE(user_id, -1), is_deleted, deleted_at, expires_at FROM urlshrt WHERE short = ANY($1::text[]) FOR UPDATE", shortURLs)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		urls := make(map[string]state.URLStringJSON, len(shortURLs))
+		for rows.Next() {
+			var u state.URLStringJSON
+			var isDeleted sql.NullInt64
+			var deletedAt, expiresAt sql.NullTime
+			if err = rows.Scan(&u.ShortURL, &u.UserID, &isDeleted, &deletedAt, &expiresAt); err != nil {
+				return err
+			}
+			u.IsDeleted = isDeleted.Valid && isDeleted.Int64 != 0
+			u.DeletedAt, u.ExpiresAt = timeOrNil(deletedAt), timeOrNil(expiresAt)
+			urls[u.ShortURL] = u
+		}
+
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		restored := make([]string, 0, len(shortURLs))
+		// the same URL may be repeated in the request, but it is restored only once
+		seen := make(map[string]struct{}, len(shortURLs))
+		for i, shrt := range shortURLs {
+			if _, ok := seen[shrt]; ok {
+				statuses[i] = domain.RestoreRestored
+				continue
+			}
+
+			url, ok := urls[shrt]
+			statuses[i] = restoreStatus(url, ok, id, deletedAfter, now)
+			if statuses[i] == domain.RestoreRestored {
+				restored = append(restored, shrt)
+				seen[shrt] = struct{}{}
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE urlshrt SET is_deleted = 0, deleted_at = NULL WHERE short = ANY($1::text[])", restored)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// PurgeDeletedURLs removes URLs which were deleted before deletedBefore with their clicks, revisions and tags in one transaction,
+// short versions of removed URLs are returned.
+func (r *URL) PurgeDeletedURLs(ctx context.Context, deletedBefore time.Time) ([]string, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return r.file.PurgeDeletedURLs(ctx, deletedBefore)
+	}
+
+	purged := make([]string, 0)
+	err = r.WithTransaction(db, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, "DELETE FROM urlshrt WHERE is_deleted = 1 AND deleted_at < $1 RETURNING short", deletedBefore)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var shrt string
+			if err = rows.Scan(&shrt); err != nil {
+				return err
+			}
+			purged = append(purged, shrt)
+		}
+
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		// short URLs may be used again, so clicks, revisions and tags of removed URLs should not be counted as the ones of new URLs
+		if _, err = tx.ExecContext(ctx, "DELETE FROM clicks WHERE short = ANY($1::text[])", purged); err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecContext(ctx, "DELETE FROM url_revisions WHERE short = ANY($1::text[])", purged); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM url_tags WHERE short = ANY($1::text[])", purged)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return purged, nil
+}
+
+// UpdateOriginal changes original URL of URL of the user whose id is in context and saves revision of the change in one transaction.
+// If the original URL was already saved in the scope from context with another short URL, that short URL is returned with UniqueError.
+func (r *URL) UpdateOriginal(ctx context.Context, shortened string, original string, at time.Time) (string, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return "", err
+	} else if db == nil {
+		return r.file.UpdateOriginal(ctx, shortened, original, at)
+	}
+
+	id := domain.UserIDFromContext(ctx)
+	var shrt string
+	err = r.WithTransaction(db, func(tx *sql.Tx) error {
+		// the URL is locked, so revisions of concurrent changes get different numbers
+		url := state.URLStringJSON{ShortURL: shortened}
+		var isDeleted sql.NullInt64
+		row := tx.QueryRowContext(ctx, "SELECT COALESCE(user_id, -1), original, is_deleted FROM urlshrt WHERE short = $1 FOR UPDATE", shortened)
+		err := row.Scan(&url.UserID, &url.OriginalURL, &isDeleted)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		url.IsDeleted = isDeleted.Valid && isDeleted.Int64 != 0
+
+		if !revisable(url, err == nil, id) {
+			return domain.ErrURLNotFound
+		}
+
+		if url.OriginalURL == original {
+			return nil
+		}
+
+		if shrt, err = lockOriginals(ctx, tx, domain.DedupScopeFromContext(ctx), []string{original}); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE urlshrt SET original = $1, updated_at = $2 WHERE short = $3", original, at, shortened)
+		if err != nil {
+			return mapUniqueViolation(err)
+		}
+
+		_, err = tx.ExecContext(ctx, "INSERT INTO url_revisions (short, revision, previous, original, changed_at) "+
+			"SELECT $1, COALESCE(MAX(revision), 0) + 1, $2, $3, $4 FROM url_revisions WHERE short = $1", shortened, url.OriginalURL, original, at)
+		return err
+	})
+
+	var uErr *domain.UniqueError
+	if errors.As(err, &uErr) && shrt != "" {
+		return shrt, err
+	} else if errors.As(err, &uErr) {
+		// transaction is aborted by unique violation, so short URL of the original URL is read after it
+		row := db.QueryRowContext(ctx, "SELECT short FROM urlshrt WHERE COALESCE(user_id, -1) = $1 AND original = $2", id, original)
+		if errScan := row.Scan(&shrt); errScan != nil {
+			return "", errScan
+		}
+		return shrt, err
+	}
+
+	return "", err
+}
+
+// ReadRevisions gets revisions of URL of the user whose id is in context in order they were made.
+func (r *URL) ReadRevisions(ctx context.Context, shortened string) ([]domain.URLRevision, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return r.file.ReadRevisions(ctx, shortened)
+	}
+
+	var owner int64
+	err = db.QueryRowContext(ctx, "SELECT COALESCE(user_id, -1) FROM urlshrt WHERE short = $1", shortened).Scan(&owner)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && owner != domain.UserIDFromContext(ctx)) {
+		return nil, domain.ErrURLNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT short, revision, previous, original, changed_at FROM url_revisions WHERE short = $1 ORDER BY revision", shortened)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := make([]domain.URLRevision, 0)
+	for rows.Next() {
+		var rev domain.URLRevision
+		if err = rows.Scan(&rev.ShortURL, &rev.Revision, &rev.PreviousURL, &rev.OriginalURL, &rev.ChangedAt); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, rev)
+	}
+
+	return revisions, rows.Err()
+}
+
+// UpdateTags adds tags to URL of the user whose id is in context and removes tags from it in one transaction,
+// tags of the URL after the change are returned.
+func (r *URL) UpdateTags(ctx context.Context, shortened string, added []string, removed []string) ([]string, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return r.file.UpdateTags(ctx, shortened, added, removed)
+	}
+
+	var tags []string
+	err = r.WithTransaction(db, func(tx *sql.Tx) error {
+		// the URL is locked, so concurrent changes of its tags don't let it have more tags than it may
+		url := state.URLStringJSON{ShortURL: shortened}
+		var isDeleted sql.NullInt64
+		row := tx.QueryRowContext(ctx, "SELECT COALESCE(user_id, -1), is_deleted FROM urlshrt WHERE short = $1 FOR UPDATE", shortened)
+		err := row.Scan(&url.UserID, &isDeleted)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		url.IsDeleted = isDeleted.Valid && isDeleted.Int64 != 0
+
+		if !revisable(url, err == nil, domain.UserIDFromContext(ctx)) {
+			return domain.ErrURLNotFound
+		}
+
+		row = tx.QueryRowContext(ctx, "SELECT COALESCE(array_agg(tag), '{}') FROM url_tags WHERE short = $1", shortened)
+		if err = row.Scan(pgtype.NewMap().SQLScanner(&url.Tags)); err != nil {
+			return err
+		}
+
+		if tags, err = mergeTags(url.Tags, added, removed); err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecContext(ctx, "DELETE FROM url_tags WHERE short = $1 AND tag = ANY($2::text[])", shortened, removed); err != nil {
+			return err
+		}
+
+		url.Tags = added
+		return saveTags(ctx, tx, url)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// ReadTags counts URLs of the user whose id is in context by their tags.
+func (r *URL) ReadTags(ctx context.Context) ([]domain.TagCount, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return r.file.ReadTags(ctx)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT url_tags.tag, COUNT(*) FROM url_tags JOIN urlshrt ON urlshrt.short = url_tags.short "+
+		"WHERE urlshrt.user_id = $1 GROUP BY url_tags.tag ORDER BY url_tags.tag", domain.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]domain.TagCount, 0)
+	for rows.Next() {
+		var tag domain.TagCount
+		if err = rows.Scan(&tag.Tag, &tag.Count); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, rows.Err()
+}
+
+// Export calls fn for every URL in order of their creation, URLs are read from database row by row.
+func (r *URL) Export(ctx context.Context, fn func(url state.URLStringJSON) error) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.Export(ctx, fn)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT uuid, short, original, user_id, is_deleted, deleted_at, expires_at, not_before, is_disabled, "+
+		metadataColumns+" FROM urlshrt ORDER BY uuid, short")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	types := pgtype.NewMap()
+	for rows.Next() {
+		var u state.URLStringJSON
+		var userID sql.NullInt64
+		var isDeleted sql.NullInt64
+		var deletedAt, expiresAt, notBefore sql.NullTime
+		var meta urlMetadata
+
+		err = rows.Scan(append([]interface{}{&u.UUID, &u.ShortURL, &u.OriginalURL, &userID, &isDeleted, &deletedAt, &expiresAt, &notBefore,
+			&u.IsDisabled}, meta.dest(types)...)...)
+		if err != nil {
+			return err
+		}
+		u.DeletedAt, u.ExpiresAt, u.NotBefore = timeOrNil(deletedAt), timeOrNil(expiresAt), timeOrNil(notBefore)
+		meta.apply(&u)
+
+		u.UserID = -1
+		if userID.Valid {
+			u.UserID = userID.Int64
+		}
+		u.IsDeleted = isDeleted.Valid && isDeleted.Int64 != 0
+
+		if err = fn(u); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Import saves URLs keeping their owners, deletion and disabling flags in one transaction. URLs which were already saved are skipped.
+func (r *URL) Import(ctx context.Context, urls []state.URLStringJSON) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.Import(ctx, urls)
+	}
+
+	return r.WithTransaction(db, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, "INSERT INTO urlshrt (uuid, short, original, user_id, is_deleted, expires_at, not_before, deleted_at, "+
+			"created_at, updated_at, title, note, is_disabled) SELECT $1, $2::text, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13 "+
+			"WHERE NOT EXISTS (SELECT 1 FROM urlshrt WHERE short = $2::text) ON CONFLICT (COALESCE(user_id, -1), original) DO NOTHING")
+		if err != nil {
+			return err
+		}
+
+		defer stmt.Close()
+
+		for _, url := range urls {
+			var userID sql.NullInt64
+			if url.UserID >= 0 {
+				userID = sql.NullInt64{Int64: url.UserID, Valid: true}
+			}
+
+			// URLs which were deleted before time of deletion was saved get time of import, like the ones migrated in postgres,
+			// so they are purged after grace period
+			var isDeleted int
+			deletedAt := url.DeletedAt
+			if url.IsDeleted {
+				isDeleted = 1
+				if deletedAt == nil {
+					now := time.Now()
+					deletedAt = &now
+				}
+			}
+
+			res, err := stmt.ExecContext(ctx, url.UUID, url.ShortURL, url.OriginalURL, userID, isDeleted, url.ExpiresAt, url.NotBefore, deletedAt,
+				url.CreatedAt, url.UpdatedAt, url.Title, url.Note, url.IsDisabled)
+			if err != nil {
+				return err
+			}
+
+			// tags of skipped URLs would be given to URLs which were already saved
+			if imported, err := res.RowsAffected(); err != nil {
+				return err
+			} else if imported > 0 {
+				if err = saveTags(ctx, tx, url); err != nil {
+					return err
+				}
+			}
+		}
+
+		// ids of imported users should not be given to new users
+		_, err = tx.ExecContext(ctx, "SELECT setval('users_id_seq', GREATEST((SELECT last_value FROM users_id_seq), (SELECT COALESCE(MAX(user_id), 0) FROM urlshrt)))")
+		return err
+	})
+}
+
+// ExportUsers calls fn for every registered user in order of their ids.
+func (r *URL) ExportUsers(ctx context.Context, fn func(user domain.User) error) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.ExportUsers(ctx, fn)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT id, created_at FROM users ORDER BY id")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user domain.User
+		if err = rows.Scan(&user.ID, &user.CreatedAt); err != nil {
+			return err
+		}
+
+		if err = fn(user); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// ImportUsers saves users keeping their ids in one transaction, users which were already saved are skipped.
+func (r *URL) ImportUsers(ctx context.Context, users []domain.User) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.ImportUsers(ctx, users)
+	}
+
+	return r.WithTransaction(db, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, "INSERT INTO users (id, created_at) VALUES($1, $2) ON CONFLICT (id) DO NOTHING")
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, user := range users {
+			if _, err = stmt.ExecContext(ctx, user.ID, user.CreatedAt); err != nil {
+				return err
+			}
+		}
+
+		// ids of imported users should not be given to new users
+		_, err = tx.ExecContext(ctx, "SELECT setval('users_id_seq', GREATEST((SELECT last_value FROM users_id_seq), (SELECT COALESCE(MAX(id), 0) FROM users)))")
+		return err
+	})
+}
+
+// ExportAPIKeys calls fn for every API key (including revoked ones) in order of their ids.
+func (r *URL) ExportAPIKeys(ctx context.Context, fn func(key domain.APIKey) error) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.ExportAPIKeys(ctx, fn)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT id, user_id, name, prefix, hash, created_at, revoked_at FROM api_keys ORDER BY id")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key domain.APIKey
+		var revokedAt sql.NullTime
+		if err = rows.Scan(&key.ID, &key.UserID, &key.Name, &key.Prefix, &key.Hash, &key.CreatedAt, &revokedAt); err != nil {
+			return err
+		}
+		key.RevokedAt = timeOrNil(revokedAt)
+
+		if err = fn(key); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// ImportAPIKeys saves API keys keeping their ids and revocation times in one transaction, keys which were already saved are skipped.
+func (r *URL) ImportAPIKeys(ctx context.Context, keys []domain.APIKey) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.ImportAPIKeys(ctx, keys)
+	}
+
+	return r.WithTransaction(db, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, "INSERT INTO api_keys (id, user_id, name, prefix, hash, created_at, revoked_at) "+
+			"VALUES($1, $2, $3, $4, $5, $6, $7) ON CONFLICT DO NOTHING")
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, key := range keys {
+			_, err = stmt.ExecContext(ctx, key.ID, key.UserID, key.Name, key.Prefix, key.Hash, key.CreatedAt, key.RevokedAt)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ExportRevisions calls fn for every revision of original URLs in order of short URLs and numbers of revisions.
+func (r *URL) ExportRevisions(ctx context.Context, fn func(rev domain.URLRevision) error) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.ExportRevisions(ctx, fn)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT short, revision, previous, original, changed_at FROM url_revisions ORDER BY short, revision")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rev domain.URLRevision
+		if err = rows.Scan(&rev.ShortURL, &rev.Revision, &rev.PreviousURL, &rev.OriginalURL, &rev.ChangedAt); err != nil {
+			return err
+		}
+
+		if err = fn(rev); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// ImportRevisions saves revisions keeping their numbers in one transaction. Revisions which were already saved
+// and revisions of unknown URLs are skipped.
+func (r *URL) ImportRevisions(ctx context.Context, revisions []domain.URLRevision) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.ImportRevisions(ctx, revisions)
+	}
+
+	return r.WithTransaction(db, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, "INSERT INTO url_revisions (short, revision, previous, original, changed_at) "+
+			"SELECT $1::text, $2, $3, $4, $5 WHERE EXISTS (SELECT 1 FROM urlshrt WHERE short = $1::text) ON CONFLICT (short, revision) DO NOTHING")
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, rev := range revisions {
+			_, err = stmt.ExecContext(ctx, rev.ShortURL, rev.Revision, rev.PreviousURL, rev.OriginalURL, rev.ChangedAt)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// CreateClicks saves clicks to database in one transaction.
+func (r *URL) CreateClicks(ctx context.Context, clicks []domain.Click) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.CreateClicks(ctx, clicks)
+	}
+
+	return r.WithTransaction(db, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, "INSERT INTO clicks (short, clicked_at, referrer, user_agent, ip) VALUES($1, $2, $3, $4, $5)")
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, click := range clicks {
+			_, err = stmt.ExecContext(ctx, click.ShortURL, click.Time, click.Referrer, click.UserAgent, click.IP)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReadClicks gets all the clicks made by short URL from database.
+func (r *URL) ReadClicks(ctx context.Context, shortened string) ([]domain.Click, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return r.file.ReadClicks(ctx, shortened)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT short, clicked_at, referrer, user_agent, ip FROM clicks WHERE short = $1 ORDER BY clicked_at", shortened)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clicks := make([]domain.Click, 0)
+	for rows.Next() {
+		var click domain.Click
+		if err = rows.Scan(&click.ShortURL, &click.Time, &click.Referrer, &click.UserAgent, &click.IP); err != nil {
+			return nil, err
+		}
+		clicks = append(clicks, click)
+	}
+
+	return clicks, rows.Err()
+}
+
+// CreateUser allocates id of a new user by sequence of users table.
+func (r *URL) CreateUser(ctx context.Context) (int64, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return -1, err
+	} else if db == nil {
+		return r.file.CreateUser(ctx)
+	}
+
+	var id int64
+	if err := db.QueryRowContext(ctx, "INSERT INTO users DEFAULT VALUES RETURNING id").Scan(&id); err != nil {
+		return -1, err
+	}
+
+	return id, nil
+}
+
+// CreateLegacyUser saves the user who got random id before the registry of users existed to database.
+func (r *URL) CreateLegacyUser(ctx context.Context, id int64) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.CreateLegacyUser(ctx, id)
+	}
+
+	_, err = db.ExecContext(ctx, "INSERT INTO users (id) VALUES($1) ON CONFLICT (id) DO NOTHING", id)
+	return err
+}
+
+// CreateAPIKey saves API key to database.
+func (r *URL) CreateAPIKey(ctx context.Context, key domain.APIKey) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.CreateAPIKey(ctx, key)
+	}
+
+	_, err = db.ExecContext(ctx, "INSERT INTO api_keys (id, user_id, name, prefix, hash, created_at) VALUES($1, $2, $3, $4, $5, $6)",
+		key.ID, key.UserID, key.Name, key.Prefix, key.Hash, key.CreatedAt)
+	return err
+}
+
+// ReadAPIKeys gets all API keys of the user (including revoked ones) from database in order of their creation.
+func (r *URL) ReadAPIKeys(ctx context.Context, userID int64) ([]domain.APIKey, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return r.file.ReadAPIKeys(ctx, userID)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT id, user_id, name, prefix, hash, created_at, revoked_at FROM api_keys WHERE user_id = $1 ORDER BY created_at", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]domain.APIKey, 0)
+	for rows.Next() {
+		var key domain.APIKey
+		var revokedAt sql.NullTime
+		if err = rows.Scan(&key.ID, &key.UserID, &key.Name, &key.Prefix, &key.Hash, &key.CreatedAt, &revokedAt); err != nil {
+			return nil, err
+		}
+		key.RevokedAt = timeOrNil(revokedAt)
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// ReadAPIKeyByHash gets API key by its hash from database, ErrAPIKeyNotFound is returned if there is no such key.
+func (r *URL) ReadAPIKeyByHash(ctx context.Context, hash string) (domain.APIKey, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return domain.APIKey{}, err
+	} else if db == nil {
+		return r.file.ReadAPIKeyByHash(ctx, hash)
+	}
+
+	var key domain.APIKey
+	var revokedAt sql.NullTime
+	err = db.QueryRowContext(ctx, "SELECT id, user_id, name, prefix, hash, created_at, revoked_at FROM api_keys WHERE hash = $1", hash).
+		Scan(&key.ID, &key.UserID, &key.Name, &key.Prefix, &key.Hash, &key.CreatedAt, &revokedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.APIKey{}, domain.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return domain.APIKey{}, err
+	}
+	key.RevokedAt = timeOrNil(revokedAt)
+
+	return key, nil
+}
+
+// RevokeAPIKey marks API key of the user as revoked in database, ErrAPIKeyNotFound is returned if the user has no such key.
+// Key which was already revoked keeps its revocation time.
+func (r *URL) RevokeAPIKey(ctx context.Context, userID int64, id string, at time.Time) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.RevokeAPIKey(ctx, userID, id, at)
+	}
+
+	res, err := db.ExecContext(ctx, "UPDATE api_keys SET revoked_at = COALESCE(revoked_at, $1) WHERE user_id = $2 AND id = $3", at, userID, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return domain.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// EnqueueDeletions saves URLs waiting to be deleted to outbox table in one transaction.
+func (r *URL) EnqueueDeletions(ctx context.Context, tasks []domain.DeletionTask) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.EnqueueDeletions(ctx, tasks)
+	}
+
+	return r.WithTransaction(db, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, "INSERT INTO deletion_outbox (short, user_id, job_id) VALUES($1, $2, $3)")
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, task := range tasks {
+			if _, err = stmt.ExecContext(ctx, task.ShortURL, task.UserID, task.JobID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReadDeletions gets up to limit URLs waiting to be deleted from outbox table, the ones which were queued first are returned first.
+func (r *URL) ReadDeletions(ctx context.Context, limit int) ([]domain.DeletionTask, error) {
+	db, err := r.getPg()
+	if err != nil {
+		return nil, err
+	} else if db == nil {
+		return r.file.ReadDeletions(ctx, limit)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT id, short, user_id, job_id FROM deletion_outbox ORDER BY id LIMIT $1", limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := make([]domain.DeletionTask, 0)
+	for rows.Next() {
+		var task domain.DeletionTask
+		if err = rows.Scan(&task.ID, &task.ShortURL, &task.UserID, &task.JobID); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, rows.Err()
+}
+
+// AckDeletions removes URLs which were deleted from outbox table.
+func (r *URL) AckDeletions(ctx context.Context, ids []int64) error {
+	db, err := r.getPg()
+	if err != nil {
+		return err
+	} else if db == nil {
+		return r.file.AckDeletions(ctx, ids)
+	}
+
+	_, err = db.ExecContext(ctx, "DELETE FROM deletion_outbox WHERE id = ANY($1::bigint[])", ids)
+	return err
+}
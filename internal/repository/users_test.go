@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+func TestCreateUser(t *testing.T) {
+	forEachRepo(t, func(t *testing.T, r testRepo) {
+		id, err := r.CreateUser(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, domain.FirstUserID, id)
+
+		// URL of a user who got id from somewhere else (e.g. was imported)
+		ctx := domain.WithIdentity(context.Background(), domain.Identity{UserID: domain.FirstUserID + 10})
+		_, err = r.Create(ctx, []state.URLStringJSON{{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru"}})
+		require.NoError(t, err)
+
+		id, err = r.CreateUser(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, domain.FirstUserID+11, id)
+
+		// ids which were given are not given again after reopening
+		id, err = reopen(t, r).CreateUser(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, domain.FirstUserID+12, id)
+	})
+
+	// users who saved nothing are remembered too
+	location := filepath.Join(t.TempDir(), "empty.json")
+	_, err := NewFile(location).CreateUser(context.Background())
+	require.NoError(t, err)
+
+	id, err := NewFile(location).CreateUser(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, domain.FirstUserID+1, id)
+}
+
+func TestCreateLegacyUser(t *testing.T) {
+	dir := t.TempDir()
+
+	b, err := NewBolt(filepath.Join(dir, "urlshrt.db"))
+	require.NoError(t, err)
+
+	f := NewFile(filepath.Join(dir, "db.json"))
+	m := NewMemory()
+
+	for name, r := range map[string]domain.UserRepository{"memory": m, "file": f, "bolt": b} {
+		// saving the same user twice changes nothing
+		require.NoError(t, r.CreateLegacyUser(context.Background(), 5), name)
+		require.NoError(t, r.CreateLegacyUser(context.Background(), 5), name)
+
+		// ids of legacy users don't affect ids of new users
+		id, err := r.CreateUser(context.Background())
+		require.NoError(t, err, name)
+		require.Equal(t, domain.FirstUserID, id, name)
+	}
+
+	require.Len(t, m.users, 2)
+	require.Contains(t, m.users, int64(5))
+
+	users, err := os.ReadFile(filepath.Join(dir, "db.json") + usersFileSuffix)
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(string(users), "\n"))
+
+	// legacy users are remembered after reopening
+	require.NoError(t, b.Close())
+	b, err = NewBolt(filepath.Join(dir, "urlshrt.db"))
+	require.NoError(t, err)
+	defer b.Close()
+
+	f = NewFile(filepath.Join(dir, "db.json"))
+	require.NoError(t, f.CreateLegacyUser(context.Background(), 5))
+	require.Len(t, f.index.users, 2)
+	require.Contains(t, f.index.users, int64(5))
+
+	users, err = os.ReadFile(filepath.Join(dir, "db.json") + usersFileSuffix)
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(string(users), "\n"))
+
+	err = b.db.View(func(tx *bolt.Tx) error {
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, 5)
+		require.NotNil(t, tx.Bucket(registeredUsersBucket).Get(key))
+		require.Equal(t, uint64(domain.FirstUserID), tx.Bucket(registeredUsersBucket).Sequence())
+		return nil
+	})
+	require.NoError(t, err)
+
+	id, err := b.CreateUser(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, domain.FirstUserID+1, id)
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+const (
+	// apiKeySecretLength is an amount of random bytes in API key
+	apiKeySecretLength = 32
+	// apiKeyIDLength is an amount of random bytes in id of API key
+	apiKeyIDLength = 8
+	// apiKeyVisibleLength is an amount of characters of API key which are kept after the prefix, so the user could recognize the key
+	apiKeyVisibleLength = 6
+	// maxAPIKeyNameLength is the longest name of API key which may be given
+	maxAPIKeyNameLength = 100
+)
+
+// APIKey is a type which manages API keys of users. API keys let servers act on behalf of the user without JWT.
+type APIKey struct {
+	repo domain.APIKeyRepository
+}
+
+func NewAPIKey(repo domain.APIKeyRepository) *APIKey {
+	return &APIKey{repo: repo}
+}
+
+// hashAPIKey is a function to get hash of API key under which it is stored.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Create generates new API key for the user whose id is in context. The key itself is returned only once, only its hash is saved.
+// ErrUnauthenticated is returned if there is no id in context, so no key is owned by nobody.
+func (s *APIKey) Create(ctx context.Context, name string) (domain.APIKey, string, error) {
+	userID := domain.UserIDFromContext(ctx)
+	if userID < 0 {
+		return domain.APIKey{}, "", domain.ErrUnauthenticated
+	}
+
+	name = strings.TrimSpace(name)
+	if len(name) > maxAPIKeyNameLength {
+		return domain.APIKey{}, "", domain.ErrInvalidAPIKeyName
+	}
+
+	secret, err := randomBytes(apiKeySecretLength)
+	if err != nil {
+		return domain.APIKey{}, "", err
+	}
+
+	id, err := randomBytes(apiKeyIDLength)
+	if err != nil {
+		return domain.APIKey{}, "", err
+	}
+
+	raw := domain.APIKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	key := domain.APIKey{
+		ID:        hex.EncodeToString(id),
+		UserID:    userID,
+		Name:      name,
+		Prefix:    raw[:len(domain.APIKeyPrefix)+apiKeyVisibleLength],
+		Hash:      hashAPIKey(raw),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err = s.repo.CreateAPIKey(ctx, key); err != nil {
+		return domain.APIKey{}, "", err
+	}
+
+	return key, raw, nil
+}
+
+// ReadAll gets all API keys of the user whose id is in context, revoked keys are included.
+func (s *APIKey) ReadAll(ctx context.Context) ([]domain.APIKey, error) {
+	return s.repo.ReadAPIKeys(ctx, domain.UserIDFromContext(ctx))
+}
+
+// Revoke revokes API key of the user whose id is in context, so it can't be used anymore.
+func (s *APIKey) Revoke(ctx context.Context, id string) error {
+	return s.repo.RevokeAPIKey(ctx, domain.UserIDFromContext(ctx), id, time.Now().UTC())
+}
+
+// Resolve gets id of the user who owns API key. ErrInvalidAPIKey is returned if the key is unknown or revoked.
+func (s *APIKey) Resolve(ctx context.Context, key string) (int64, error) {
+	if !strings.HasPrefix(key, domain.APIKeyPrefix) {
+		return -1, domain.ErrInvalidAPIKey
+	}
+
+	apiKey, err := s.repo.ReadAPIKeyByHash(ctx, hashAPIKey(key))
+	if errors.Is(err, domain.ErrAPIKeyNotFound) {
+		return -1, domain.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return -1, err
+	}
+
+	if apiKey.RevokedAt != nil {
+		return -1, domain.ErrInvalidAPIKey
+	}
+
+	return apiKey.UserID, nil
+}
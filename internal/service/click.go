@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+// maxClickBatch is the biggest amount of clicks which is written to repository at once.
+const maxClickBatch = 500
+
+// Click is a type which records clicks in buffer and writes them to repository in batches, so redirects are not slowed down by writes.
+type Click struct {
+	repo          domain.ClickRepository
+	urlRepo       domain.URLRepository
+	events        chan domain.Click
+	flushInterval time.Duration
+}
+
+// NewClick creates service of clicks. Up to bufferSize clicks may wait to be written, buffered clicks are written at least every flushInterval.
+func NewClick(repo domain.ClickRepository, urlRepo domain.URLRepository, bufferSize int, flushInterval time.Duration) *Click {
+	return &Click{repo: repo, urlRepo: urlRepo, events: make(chan domain.Click, bufferSize), flushInterval: flushInterval}
+}
+
+// Record puts click to the buffer without waiting. If the buffer is full, click is dropped.
+func (s *Click) Record(click domain.Click) {
+	select {
+	case s.events <- click:
+	default:
+		util.GetLogger().Infoln("click buffer is full, click dropped", click.ShortURL)
+	}
+}
+
+// Run writes buffered clicks to repository until the context is done, then clicks which are left in the buffer are written too.
+func (s *Click) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]domain.Click, 0, maxClickBatch)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		// the context may be already done while shutting down, but clicks should not be lost
+		if err := s.repo.CreateClicks(context.Background(), batch); err != nil {
+			util.GetLogger().Infoln("failed to save clicks:", err)
+		}
+		batch = batch[:0]
+	}
+
+	add := func(click domain.Click) {
+		batch = append(batch, click)
+		if len(batch) == maxClickBatch {
+			flush()
+		}
+	}
+
+	for {
+		select {
+		case click := <-s.events:
+			add(click)
+		case <-ticker.C:
+			flush()
+		case <-ctx.Done():
+			for {
+				select {
+				case click := <-s.events:
+					add(click)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// ReadStats counts clicks made by short URL of the user whose id is in context. Clicks are counted in total and by periods of bucket length.
+func (s *Click) ReadStats(ctx context.Context, shortened string, bucket time.Duration) (domain.ClickStats, error) {
+	stats := domain.ClickStats{ShortURL: shortened, Buckets: make([]domain.ClickBucket, 0)}
+
+	if bucket < time.Minute {
+		return stats, fmt.Errorf("%w: bucket should be at least a minute long", domain.ErrInvalidBucket)
+	}
+
+	owner, err := s.urlRepo.ReadOwner(ctx, shortened)
+	if err != nil {
+		return stats, err
+	}
+
+	// stats of somebody else's URL are not shown, so the URL is treated as not existing, URL without owner belongs to nobody
+	if uid := domain.UserIDFromContext(ctx); owner < 0 || owner != uid {
+		return stats, domain.ErrURLNotFound
+	}
+
+	clicks, err := s.repo.ReadClicks(ctx, shortened)
+	if err != nil {
+		return stats, err
+	}
+
+	counts := make(map[time.Time]int)
+	for _, click := range clicks {
+		counts[click.Time.UTC().Truncate(bucket)]++
+	}
+
+	stats.Total = len(clicks)
+	for start, amount := range counts {
+		stats.Buckets = append(stats.Buckets, domain.ClickBucket{Start: start, Clicks: amount})
+	}
+
+	sort.Slice(stats.Buckets, func(i, j int) bool {
+		return stats.Buckets[i].Start.Before(stats.Buckets[j].Start)
+	})
+
+	return stats, nil
+}
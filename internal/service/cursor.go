@@ -0,0 +1,64 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+)
+
+// encodeCursor is a function to make opaque cursor which points to position of URL in the list of URLs.
+func encodeCursor(url state.URLStringJSON) (string, error) {
+	data, err := json.Marshal(domain.NewURLCursor(url))
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor is a function to get position in the list of URLs from cursor, nil is returned for empty cursor.
+func decodeCursor(cursor string) (*domain.URLCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidQuery)
+	}
+
+	var c domain.URLCursor
+	if err = json.Unmarshal(data, &c); err != nil || c.ShortURL == "" {
+		return nil, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidQuery)
+	}
+
+	return &c, nil
+}
+
+// newURLQuery is a function to make query to repository from request of a page of URLs, page size and order are checked.
+func newURLQuery(req domain.URLPageRequest) (domain.URLQuery, error) {
+	query := domain.URLQuery{URLFilter: req.URLFilter, Limit: req.Limit}
+	// tags are saved in lower case
+	query.Tag = strings.ToLower(strings.TrimSpace(query.Tag))
+
+	if query.Limit == 0 {
+		query.Limit = domain.DefaultPageSize
+	}
+
+	if query.Limit < 0 || query.Limit > domain.MaxPageSize {
+		return query, fmt.Errorf("%w: page size should be from 1 to %d", domain.ErrInvalidQuery, domain.MaxPageSize)
+	}
+
+	order, err := domain.ParseSortOrder(string(req.Order))
+	if err != nil {
+		return query, err
+	}
+	query.Order = order
+
+	query.After, err = decodeCursor(req.Cursor)
+	return query, err
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+const (
+	// deletionJobIDLength is an amount of random bytes in id of deletion job
+	deletionJobIDLength = 8
+	// deletionJobRetention is how long deletion jobs are kept after they are created, so the user could check them
+	deletionJobRetention = time.Hour
+	// minDeletionBackoff is the first pause before deletion is retried after an error of repository
+	minDeletionBackoff = 100 * time.Millisecond
+	// maxDeletionBackoff is the longest pause before deletion is retried
+	maxDeletionBackoff = 30 * time.Second
+	// maxDeletionAttempts is how many times URLs are tried to be deleted, then they are reported as failed and removed from outbox,
+	// so they don't keep URLs queued after them from being deleted
+	maxDeletionAttempts = 10
+)
+
+// deletionJobs is a type which keeps deletion jobs in memory until they are old enough to be forgotten.
+type deletionJobs struct {
+	jobs map[string]*domain.DeletionJob
+	*sync.Mutex
+}
+
+func newDeletionJobs() *deletionJobs {
+	return &deletionJobs{jobs: make(map[string]*domain.DeletionJob), Mutex: &sync.Mutex{}}
+}
+
+// create registers a job of the user where all the short URLs are pending, id of the job is returned.
+func (j *deletionJobs) create(userID int64, shortURLs []string) (string, error) {
+	b := make([]byte, deletionJobIDLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	job := &domain.DeletionJob{ID: hex.EncodeToString(b), UserID: userID, CreatedAt: now, Results: make([]domain.DeletionResult, len(shortURLs))}
+	for i, short := range shortURLs {
+		job.Results[i] = domain.DeletionResult{ShortURL: short, Status: domain.DeletionPending}
+	}
+
+	j.Lock()
+	defer j.Unlock()
+
+	for id, old := range j.jobs {
+		if now.Sub(old.CreatedAt) > deletionJobRetention {
+			delete(j.jobs, id)
+		}
+	}
+
+	j.jobs[job.ID] = job
+	return job.ID, nil
+}
+
+// complete sets status of the first pending short URL of the job, the job is done when there are no pending URLs left.
+func (j *deletionJobs) complete(id string, short string, status domain.DeletionStatus) {
+	j.Lock()
+	defer j.Unlock()
+
+	job, ok := j.jobs[id]
+	if !ok {
+		return
+	}
+
+	done := true
+	set := false
+	for i := range job.Results {
+		if !set && job.Results[i].ShortURL == short && job.Results[i].Status == domain.DeletionPending {
+			job.Results[i].Status = status
+			set = true
+		}
+
+		if job.Results[i].Status == domain.DeletionPending {
+			done = false
+		}
+	}
+
+	job.Done = done
+}
+
+// forget removes the job, it is used if URLs of the job could not be queued.
+func (j *deletionJobs) forget(id string) {
+	j.Lock()
+	defer j.Unlock()
+
+	delete(j.jobs, id)
+}
+
+// read gets a copy of the job, so it is not changed while the caller uses it. Jobs of other users are not found.
+func (j *deletionJobs) read(userID int64, id string) (domain.DeletionJob, error) {
+	j.Lock()
+	defer j.Unlock()
+
+	job, ok := j.jobs[id]
+	if !ok || job.UserID != userID {
+		return domain.DeletionJob{}, domain.ErrDeletionJobNotFound
+	}
+
+	cp := *job
+	cp.Results = append([]domain.DeletionResult(nil), job.Results...)
+	return cp, nil
+}
+
+// Deletion is a type which deletes URLs of users in background. URLs are put to outbox first, so the ones which were
+// not deleted yet are deleted after restart. Statuses of deletion jobs are kept only in memory though.
+type Deletion struct {
+	repo          domain.URLRepository
+	outbox        domain.DeletionOutbox
+	jobs          *deletionJobs
+	batchSize     int
+	flushInterval time.Duration
+	// gracePeriod is how long after deletion URLs may be restored
+	gracePeriod time.Duration
+	// queued is an amount of URLs which were queued since the last flush
+	queued atomic.Int64
+	// full is signaled when there are enough queued URLs for a batch, so they are deleted without waiting for the interval
+	full chan struct{}
+	// attempts are amounts of failed attempts to delete tasks by their ids, they are used only by the goroutine which flushes outbox
+	attempts map[int64]int
+}
+
+// NewDeletion creates service of deletions. Queued URLs are deleted by batches of up to batchSize URLs at least every flushInterval,
+// deleted URLs may be restored during gracePeriod after deletion.
+func NewDeletion(repo domain.URLRepository, outbox domain.DeletionOutbox, batchSize int, flushInterval time.Duration, gracePeriod time.Duration) *Deletion {
+	return &Deletion{repo: repo, outbox: outbox, jobs: newDeletionJobs(), batchSize: batchSize, flushInterval: flushInterval,
+		gracePeriod: gracePeriod, full: make(chan struct{}, 1), attempts: make(map[int64]int)}
+}
+
+// DeleteUserURLs puts URLs of the user whose id is in context to outbox, so they are deleted in background.
+// Id of the deletion job which reports what happened to every URL is returned.
+func (s *Deletion) DeleteUserURLs(ctx context.Context, shortURLs []string) (string, error) {
+	userID := domain.UserIDFromContext(ctx)
+
+	jobID, err := s.jobs.create(userID, shortURLs)
+	if err != nil {
+		return "", err
+	}
+
+	tasks := make([]domain.DeletionTask, len(shortURLs))
+	for i, short := range shortURLs {
+		tasks[i] = domain.DeletionTask{ShortURL: short, UserID: userID, JobID: jobID}
+	}
+
+	if err = s.outbox.EnqueueDeletions(ctx, tasks); err != nil {
+		s.jobs.forget(jobID)
+		return "", err
+	}
+
+	if s.queued.Add(int64(len(tasks))) >= int64(s.batchSize) {
+		select {
+		case s.full <- struct{}{}:
+		default:
+		}
+	}
+
+	return jobID, nil
+}
+
+// ReadDeletionJob gets deletion job of the user whose id is in context.
+func (s *Deletion) ReadDeletionJob(ctx context.Context, id string) (domain.DeletionJob, error) {
+	return s.jobs.read(domain.UserIDFromContext(ctx), id)
+}
+
+// RestoreUserURLs unmarks deleted URLs of the user whose id is in context if grace period after their deletion is not over yet.
+// URLs which are still queued are not deleted yet, so they are reported as not deleted and are deleted later anyway.
+func (s *Deletion) RestoreUserURLs(ctx context.Context, shortURLs []string) ([]domain.RestoreResult, error) {
+	statuses, err := s.repo.RestoreUserURLs(ctx, shortURLs, time.Now().Add(-s.gracePeriod))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statuses) != len(shortURLs) {
+		return nil, errors.New("amounts of restore statuses and urls are not equal")
+	}
+
+	results := make([]domain.RestoreResult, len(shortURLs))
+	for i, short := range shortURLs {
+		results[i] = domain.RestoreResult{ShortURL: short, Status: statuses[i]}
+	}
+
+	return results, nil
+}
+
+// giveUp counts failed attempt to delete tasks, true is returned if any of them was tried maxDeletionAttempts times.
+func (s *Deletion) giveUp(tasks []domain.DeletionTask) bool {
+	exhausted := false
+	for _, task := range tasks {
+		s.attempts[task.ID]++
+		if s.attempts[task.ID] >= maxDeletionAttempts {
+			exhausted = true
+		}
+	}
+
+	return exhausted
+}
+
+// flush deletes queued URLs by batches until outbox is empty. URLs are removed from outbox only after they are deleted,
+// so if the app stops in between, they are deleted again, which doesn't change anything. URLs which could not be deleted
+// after maxDeletionAttempts are reported as failed and removed from outbox too.
+func (s *Deletion) flush() error {
+	// the context of the request which queued URLs may be already done, but URLs should be deleted anyway
+	ctx := context.Background()
+	s.queued.Store(0)
+
+	for {
+		tasks, err := s.outbox.ReadDeletions(ctx, s.batchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(tasks) == 0 {
+			return nil
+		}
+
+		shortURLs := make([]string, len(tasks))
+		uid := make([]int64, len(tasks))
+		ids := make([]int64, len(tasks))
+		for i, task := range tasks {
+			shortURLs[i], uid[i], ids[i] = task.ShortURL, task.UserID, task.ID
+		}
+
+		statuses, err := s.repo.DeleteUserURLs(ctx, shortURLs, uid)
+		if err == nil && len(statuses) != len(tasks) {
+			err = errors.New("amounts of deletion statuses and urls are not equal")
+		}
+		if err != nil {
+			if !s.giveUp(tasks) {
+				return err
+			}
+
+			util.GetLogger().Infoln("failed to delete urls", shortURLs, "after", maxDeletionAttempts, "attempts, they are removed from outbox:", err)
+			statuses = make([]domain.DeletionStatus, len(tasks))
+			for i := range statuses {
+				statuses[i] = domain.DeletionFailed
+			}
+		}
+
+		if err = s.outbox.AckDeletions(ctx, ids); err != nil {
+			return err
+		}
+
+		for i, task := range tasks {
+			s.jobs.complete(task.JobID, task.ShortURL, statuses[i])
+			delete(s.attempts, task.ID)
+		}
+
+		if len(tasks) < s.batchSize {
+			return nil
+		}
+	}
+}
+
+// drain deletes all the URLs which are left in outbox when the service is stopped.
+func (s *Deletion) drain() {
+	if err := s.flush(); err != nil {
+		util.GetLogger().Infoln("failed to delete queued urls, they are left in outbox:", err)
+	}
+}
+
+// Run deletes queued URLs every flush interval (or earlier if there are enough of them) until the context is done,
+// then URLs which are left in outbox are deleted too. After an error of repository deletion is retried with growing pauses,
+// URLs which still could not be deleted after maxDeletionAttempts are reported as failed.
+func (s *Deletion) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	var backoff time.Duration
+	for {
+		select {
+		case <-ctx.Done():
+			s.drain()
+			return
+		case <-ticker.C:
+		case <-s.full:
+		}
+
+		for {
+			err := s.flush()
+			if err == nil {
+				backoff = 0
+				break
+			}
+
+			backoff *= 2
+			if backoff < minDeletionBackoff {
+				backoff = minDeletionBackoff
+			} else if backoff > maxDeletionBackoff {
+				backoff = maxDeletionBackoff
+			}
+			util.GetLogger().Infoln("failed to delete queued urls, retrying in", backoff, err)
+
+			select {
+			case <-ctx.Done():
+				s.drain()
+				return
+			case <-time.After(backoff):
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/domain/mocks"
+	"github.com/PoorMercymain/urlshrt/internal/repository"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestDeletionFailed(t *testing.T) {
+	require.NoError(t, util.InitLogger())
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	errRepo := errors.New("repository is broken")
+	repo := mocks.NewMockURLRepository(ctrl)
+	repo.EXPECT().DeleteUserURLs(gomock.Any(), []string{"abc", "cba"}, []int64{1, 1}).Return(nil, errRepo).Times(maxDeletionAttempts)
+	repo.EXPECT().DeleteUserURLs(gomock.Any(), []string{"bca"}, []int64{2}).Return([]domain.DeletionStatus{domain.DeletionDeleted}, nil)
+
+	outbox := repository.NewMemory()
+	s := NewDeletion(repo, outbox, 2, time.Hour, time.Hour)
+
+	failing, err := s.DeleteUserURLs(domain.WithIdentity(context.Background(), domain.Identity{UserID: 1}), []string{"abc", "cba"})
+	require.NoError(t, err)
+	queued, err := s.DeleteUserURLs(domain.WithIdentity(context.Background(), domain.Identity{UserID: 2}), []string{"bca"})
+	require.NoError(t, err)
+
+	// URLs queued after the failing ones wait until the failing ones are given up
+	for i := 1; i < maxDeletionAttempts; i++ {
+		require.ErrorIs(t, s.flush(), errRepo)
+	}
+
+	job, err := s.jobs.read(2, queued)
+	require.NoError(t, err)
+	require.False(t, job.Done)
+
+	require.NoError(t, s.flush())
+
+	job, err = s.jobs.read(1, failing)
+	require.NoError(t, err)
+	require.True(t, job.Done)
+	require.Equal(t, []domain.DeletionResult{{ShortURL: "abc", Status: domain.DeletionFailed}, {ShortURL: "cba", Status: domain.DeletionFailed}},
+		job.Results)
+
+	job, err = s.jobs.read(2, queued)
+	require.NoError(t, err)
+	require.True(t, job.Done)
+	require.Equal(t, []domain.DeletionResult{{ShortURL: "bca", Status: domain.DeletionDeleted}}, job.Results)
+
+	tasks, err := outbox.ReadDeletions(context.Background(), 10)
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+	require.Empty(t, s.attempts)
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/internal/state"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+// Policy is a type which applies rules of destinations which can't be shortened to URLs which were saved before the rules appeared.
+type Policy struct {
+	repo   domain.PolicyRepository
+	store  *state.Store
+	policy domain.URLPolicy
+}
+
+// NewPolicy creates Policy service, store should be the one which is used by URL service of the same repository,
+// so disabled URLs stop redirecting at once.
+func NewPolicy(repo domain.PolicyRepository, store *state.Store, policy domain.URLPolicy) *Policy {
+	return &Policy{repo: repo, store: store, policy: policy}
+}
+
+// Enforce reloads the rules and disables saved URLs whose original URLs match them, short URLs which were disabled are returned.
+func (s *Policy) Enforce(ctx context.Context) ([]string, error) {
+	if err := s.policy.Reload(); err != nil {
+		return nil, err
+	}
+
+	urls, err := s.repo.ReadAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	disabled := make([]string, 0)
+	for _, url := range urls {
+		if url.IsDisabled {
+			continue
+		}
+
+		// original URLs which were saved before they were checked may be invalid, they are not disabled
+		if err = s.policy.Check(url.OriginalURL); errors.Is(err, domain.ErrForbiddenURL) {
+			util.GetLogger().Infoln("url disabled by policy, short", url.ShortURL, "user", url.UserID, "url", url.OriginalURL, "reason", err)
+			disabled = append(disabled, url.ShortURL)
+		}
+	}
+
+	if len(disabled) == 0 {
+		return disabled, nil
+	}
+
+	if err = s.repo.DisableURLs(ctx, disabled); err != nil {
+		return nil, err
+	}
+
+	for _, short := range disabled {
+		if url, ok := s.store.GetByShort(short); ok {
+			url.IsDisabled = true
+			s.store.Replace(url)
+		}
+	}
+
+	return disabled, nil
+}
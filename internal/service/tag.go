@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// Tag is a type which manages tags of users' URLs, tags let users group their URLs.
+type Tag struct {
+	repo domain.TagRepository
+}
+
+func NewTag(repo domain.TagRepository) *Tag {
+	return &Tag{repo: repo}
+}
+
+// AddTags adds tags to URL of the user whose id is in context, all tags of the URL are returned.
+func (s *Tag) AddTags(ctx context.Context, shortened string, tags []string) ([]string, error) {
+	added, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(added) == 0 {
+		return nil, fmt.Errorf("%w: no tags to add", domain.ErrInvalidMetadata)
+	}
+
+	return s.repo.UpdateTags(ctx, shortened, added, nil)
+}
+
+// RemoveTags removes tags from URL of the user whose id is in context, tags which are left are returned.
+func (s *Tag) RemoveTags(ctx context.Context, shortened string, tags []string) ([]string, error) {
+	removed, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(removed) == 0 {
+		return nil, fmt.Errorf("%w: no tags to remove", domain.ErrInvalidMetadata)
+	}
+
+	return s.repo.UpdateTags(ctx, shortened, nil, removed)
+}
+
+// ReadTags gets tags of URLs of the user whose id is in context with amounts of URLs which have them.
+func (s *Tag) ReadTags(ctx context.Context) ([]domain.TagCount, error) {
+	return s.repo.ReadTags(ctx)
+}
@@ -4,6 +4,7 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
 	"sync"
 	"time"
@@ -14,15 +15,93 @@ import (
 )
 
 type URL struct {
-	repo domain.URLRepository
+	repo   domain.URLRepository
+	store  *state.Store
+	gen    domain.ShortCodeGenerator
+	canon  domain.URLCanonicalizer
+	policy domain.URLPolicy
+	scope  domain.DedupScope
 }
 
-func NewURL(repo domain.URLRepository) *URL {
-	return &URL{repo: repo}
+// NewURL creates URL service. Original URLs are brought to the canonical form by canon before they are saved or looked up,
+// if canon is nil, only empty original URLs are rejected. Original URLs which don't comply with policy can't be saved,
+// if policy is nil, every original URL complies. Scope defines if an original URL gets one short URL for every user
+// or for all the users at once.
+func NewURL(repo domain.URLRepository, store *state.Store, gen domain.ShortCodeGenerator, canon domain.URLCanonicalizer, policy domain.URLPolicy,
+	scope domain.DedupScope) *URL {
+	return &URL{repo: repo, store: store, gen: gen, canon: canon, policy: policy, scope: scope}
+}
+
+// canonicalize checks original URL and returns its canonical form.
+func (s *URL) canonicalize(original string) (string, error) {
+	if s.canon == nil {
+		if original == "" {
+			return "", fmt.Errorf("%w: url should not be empty", domain.ErrInvalidURL)
+		}
+		return original, nil
+	}
+
+	return s.canon.Canonicalize(original)
+}
+
+// check brings original URL which the user wants to save to the canonical form and checks it against policy,
+// rejected URLs are logged with id of the user.
+func (s *URL) check(userID int64, original string) (string, error) {
+	original, err := s.canonicalize(original)
+	if err != nil {
+		return "", err
+	}
+
+	if s.policy == nil {
+		return original, nil
+	}
+
+	if err = s.policy.Check(original); err != nil {
+		util.GetLogger().Infoln("original url rejected by policy, user", userID, "url", original, "reason", err)
+		return "", err
+	}
+
+	return original, nil
+}
+
+// findSaved looks for URL which was already saved for the original URL. Only URLs of the user are searched
+// unless original URLs are deduplicated for all the users.
+func (s *URL) findSaved(userID int64, original string) (state.URLStringJSON, bool) {
+	if s.scope == domain.DedupGlobal {
+		return s.store.GetByOriginal(original)
+	}
+
+	return s.store.GetByOwner(userID, original)
 }
 
 func (s *URL) ReadUserURLs(ctx context.Context) ([]state.URLStringJSON, error) {
-	return s.repo.ReadUserURLs(ctx)
+	return s.repo.ReadUserURLs(ctx, domain.URLQuery{})
+}
+
+// ReadUserURLsPage gets a page of URLs of the user whose id is in context. Cursor of the next page is returned
+// if there are URLs after the page.
+func (s *URL) ReadUserURLsPage(ctx context.Context, req domain.URLPageRequest) (domain.URLPage, error) {
+	query, err := newURLQuery(req)
+	if err != nil {
+		return domain.URLPage{}, err
+	}
+
+	// one more URL is read to know if the page is the last one
+	limit := query.Limit
+	query.Limit++
+
+	urls, err := s.repo.ReadUserURLs(ctx, query)
+	if err != nil {
+		return domain.URLPage{}, err
+	}
+
+	if len(urls) <= limit {
+		return domain.URLPage{URLs: urls}, nil
+	}
+
+	page := domain.URLPage{URLs: urls[:limit]}
+	page.NextCursor, err = encodeCursor(page.URLs[limit-1])
+	return page, err
 }
 
 func (s *URL) PingPg(ctx context.Context) error {
@@ -34,11 +113,6 @@ func (s *URL) CreateShortenedFromBatch(ctx context.Context, batch []*domain.Batc
 	wg.Add(1)
 	defer wg.Done()
 
-	curURLsPtr, err := state.GetCurrentURLsPtr()
-	if err != nil {
-		return nil, err
-	}
-
 	var random *rand.Rand
 	if rSeed := ctx.Value(domain.Key("seed")); rSeed != nil {
 		random = rand.New(rand.NewSource(ctx.Value(domain.Key("seed")).(int64)))
@@ -47,33 +121,76 @@ func (s *URL) CreateShortenedFromBatch(ctx context.Context, batch []*domain.Batc
 		random = rand.New(rand.NewSource(time.Now().Unix()))
 	}
 
-	const shrtURLReqLen = 7
-
+	id := domain.UserIDFromContext(ctx)
+	now := time.Now()
 	notYetWritten := make([]*state.URLStringJSON, 0)
 
-	util.GetLogger().Infoln("its them", *curURLsPtr.Urls, "len", len(*curURLsPtr.Urls))
-	allShortURLs := make(map[string]bool)
-	for _, urlFromCurURLs := range *curURLsPtr.Urls {
-		allShortURLs[urlFromCurURLs.ShortURL] = true
-	}
+	// short URLs generated for the batch are not in the store yet, so they are checked separately
+	batchShortURLs := make(map[string]bool)
+	curLen := s.store.Len()
 
 	var uuidShift int
 	util.GetLogger().Infoln(batch)
 	for j, batchURL := range batch {
 		util.GetLogger().Infoln("ok", batchURL)
-		if foundURL, ok := (*curURLsPtr.Urls)[batchURL.OriginalURL]; ok {
+		original, err := s.check(id, batchURL.OriginalURL)
+		if err != nil {
+			return nil, fmt.Errorf("%w (correlation id %s)", err, batchURL.ID)
+		}
+		batch[j].OriginalURL = original
+
+		tags, err := normalizeMetadata(batchURL.Title, batchURL.Note, batchURL.Tags)
+		if err != nil {
+			return nil, err
+		}
+
+		if foundURL, ok := s.findSaved(id, original); ok {
 			batch[j].ShortenedURL = foundURL.ShortURL
+		} else if err := validateWindow(batchURL.ExpiresAt, batchURL.NotBefore, now); err != nil {
+			return nil, err
+		} else if batchURL.Alias != "" {
+			if err := validateAlias(batchURL.Alias); err != nil {
+				return nil, err
+			}
+
+			if _, shortExists := batchShortURLs[batchURL.Alias]; shortExists || s.store.ShortExists(batchURL.Alias) {
+				return nil, domain.NewAliasConflictError(batchURL.Alias)
+			}
+
+			uuidShift += 1
+			batch[j].ShortenedURL = batchURL.Alias
+			notYetWritten = append(notYetWritten, &(state.URLStringJSON{
+				UUID:        curLen + uuidShift,
+				ShortURL:    batch[j].ShortenedURL,
+				OriginalURL: batch[j].OriginalURL,
+				UserID:      id,
+				ExpiresAt:   batch[j].ExpiresAt,
+				NotBefore:   batch[j].NotBefore,
+				CreatedAt:   &now,
+				Title:       batch[j].Title,
+				Note:        batch[j].Note,
+				Tags:        tags,
+			}))
+			batchShortURLs[batch[j].ShortenedURL] = true
 		} else {
 			uuidShift += 1
-			for {
-				batch[j].ShortenedURL = util.GenerateRandomString(shrtURLReqLen, random)
-				if _, shortExists := allShortURLs[batch[j].ShortenedURL]; !shortExists {
+			for attempt := 0; ; attempt++ {
+				batch[j].ShortenedURL = s.gen.Generate(domain.ShortCodeRequest{OriginalURL: original,
+					Random: random, Used: curLen + uuidShift - 1, Attempt: attempt})
+				if _, shortExists := batchShortURLs[batch[j].ShortenedURL]; !shortExists && !s.store.ShortExists(batch[j].ShortenedURL) {
 					notYetWritten = append(notYetWritten, &(state.URLStringJSON{
-						UUID:        len(*curURLsPtr.Urls) + uuidShift,
+						UUID:        curLen + uuidShift,
 						ShortURL:    batch[j].ShortenedURL,
 						OriginalURL: batch[j].OriginalURL,
+						UserID:      id,
+						ExpiresAt:   batch[j].ExpiresAt,
+						NotBefore:   batch[j].NotBefore,
+						CreatedAt:   &now,
+						Title:       batch[j].Title,
+						Note:        batch[j].Note,
+						Tags:        tags,
 					}))
-					allShortURLs[batch[j].ShortenedURL] = true
+					batchShortURLs[batch[j].ShortenedURL] = true
 					break
 				}
 			}
@@ -90,38 +207,44 @@ func (s *URL) CreateShortenedFromBatch(ctx context.Context, batch []*domain.Batc
 	}
 
 	util.GetLogger().Infoln("not written", notYetWritten)
-	err = s.repo.CreateBatch(ctx, notYetWritten)
+	err := s.repo.CreateBatch(domain.WithDedupScope(ctx, s.scope), notYetWritten)
 	if err != nil {
 		return nil, err
 	}
 
-	curURLsPtr.Lock()
 	for _, url := range notYetWritten {
-		(*curURLsPtr.Urls)[url.OriginalURL] = *url
+		s.store.Add(*url)
 	}
 
-	curURLsPtr.Unlock()
-
 	return batchToReturn, nil
 }
 
 // ReadOriginal gets original URL using shortened.
 func (s *URL) ReadOriginal(ctx context.Context, shortened string, errChan chan error) (string, error) {
-	curURLsPtr, err := state.GetCurrentURLsPtr()
-	if err != nil {
-		return "", err
-	}
-
 	if deleted, err := s.repo.IsURLDeleted(ctx, shortened); !deleted {
 		if err != nil {
 			util.GetLogger().Infoln(err)
 		}
-		for _, url := range *curURLsPtr.Urls {
-			if url.ShortURL == shortened {
-				return url.OriginalURL, nil
-			}
+		url, ok := s.store.GetByShort(shortened)
+		if !ok {
+			return "", errors.New("no such value")
+		}
+
+		// disabled URL is treated as deleted, but it can't be restored by its owner
+		if url.IsDisabled {
+			errDisabled := errors.New("the requested URL was disabled")
+			errChan <- errDisabled
+			return "", errDisabled
+		}
+
+		// URL which is not active yet or has expired (but was not marked as deleted yet) is treated as deleted
+		if !url.IsActive(time.Now()) {
+			errInactive := errors.New("the requested URL is not active")
+			errChan <- errInactive
+			return "", errInactive
 		}
-		return "", errors.New("no such value")
+
+		return url.OriginalURL, nil
 	} else if err != nil {
 		util.GetLogger().Infoln(err)
 		return "", err
@@ -133,7 +256,15 @@ func (s *URL) ReadOriginal(ctx context.Context, shortened string, errChan chan e
 }
 
 // CreateShortened creates shorten URL and calls repository level to save it to database.
-func (s *URL) CreateShortened(ctx context.Context, original string) (string, error) {
+// If alias is set in options, it is used as shorten URL instead of generated one. URL is active only in the time window from options.
+// Original URL is saved in the canonical form, so URLs which differ only in the way they are written get the same short URL.
+func (s *URL) CreateShortened(ctx context.Context, original string, opts domain.ShortenOptions) (string, error) {
+	id := domain.UserIDFromContext(ctx)
+	original, err := s.check(id, original)
+	if err != nil {
+		return "", err
+	}
+
 	var random *rand.Rand
 	if rSeed := ctx.Value(domain.Key("seed")); rSeed != nil {
 		util.GetLogger().Infoln(rSeed)
@@ -143,113 +274,145 @@ func (s *URL) CreateShortened(ctx context.Context, original string) (string, err
 		random = rand.New(rand.NewSource(time.Now().Unix()))
 	}
 
-	curURLsPtr, err := state.GetCurrentURLsPtr()
+	now := time.Now()
+	if err := validateWindow(opts.ExpiresAt, opts.NotBefore, now); err != nil {
+		return "", err
+	}
+
+	tags, err := normalizeMetadata(opts.Title, opts.Note, opts.Tags)
 	if err != nil {
 		return "", err
 	}
 
 	var shortenedURL string
 
-	const shrtURLReqLen = 7
+	if opts.Alias != "" {
+		if err := validateAlias(opts.Alias); err != nil {
+			return "", err
+		}
 
-	curShrtURLs := make(map[string]bool, 0)
+		if url, ok := s.store.GetByShort(opts.Alias); ok {
+			if url.OriginalURL == original && (s.scope == domain.DedupGlobal || url.UserID == id) {
+				return url.ShortURL, domain.NewUniqueError(errors.New("original url already exists"))
+			}
+			return "", domain.NewAliasConflictError(opts.Alias)
+		}
 
-	for _, curURL := range *curURLsPtr.Urls {
-		curShrtURLs[curURL.ShortURL] = true
-	}
+		shortenedURL = opts.Alias
+	} else {
+		if url, ok := s.findSaved(id, original); ok {
+			return url.ShortURL, domain.NewUniqueError(errors.New("original url already exists"))
+		}
 
-	for {
-		shortenedURL = util.GenerateRandomString(shrtURLReqLen, random)
-		if _, shortExists := curShrtURLs[shortenedURL]; !shortExists {
-			break
+		for attempt := 0; ; attempt++ {
+			shortenedURL = s.gen.Generate(domain.ShortCodeRequest{OriginalURL: original, Random: random, Used: s.store.Len(), Attempt: attempt})
+			if !s.store.ShortExists(shortenedURL) {
+				break
+			}
 		}
 	}
 
-	createdURLStruct := state.URLStringJSON{UUID: len(*curURLsPtr.Urls), ShortURL: shortenedURL, OriginalURL: original}
+	createdURLStruct := state.URLStringJSON{UUID: s.store.Len(), ShortURL: shortenedURL, OriginalURL: original,
+		UserID: id, ExpiresAt: opts.ExpiresAt, NotBefore: opts.NotBefore, CreatedAt: &now, Title: opts.Title, Note: opts.Note, Tags: tags}
 
-	shrt, err := s.repo.Create(ctx, []state.URLStringJSON{createdURLStruct})
-	if err != nil {
+	// the store may miss URLs which are being saved concurrently, so the repository checks original URL again in the same scope
+	shrt, err := s.repo.Create(domain.WithDedupScope(ctx, s.scope), []state.URLStringJSON{createdURLStruct})
+	if errors.Is(err, domain.ErrShortURLExists) && opts.Alias != "" {
+		return "", domain.NewAliasConflictError(opts.Alias)
+	} else if err != nil {
 		return shrt, err
 	}
 
-	curURLsPtr.Lock()
-	if _, ok := (*curURLsPtr.Urls)[createdURLStruct.OriginalURL]; !ok {
-		(*curURLsPtr.Urls)[createdURLStruct.OriginalURL] = createdURLStruct
-	} else {
-		shortenedURL = (*curURLsPtr.Urls)[createdURLStruct.OriginalURL].ShortURL
+	shortenedURL = s.store.AddIfAbsent(createdURLStruct).ShortURL
+
+	return shortenedURL, nil
+}
+
+// UpdateShortened changes original URL of short URL of the user whose id is in context, the change is saved as a revision.
+// Original URL is checked for uniqueness the same way as when short URL is created, so if the original URL was already saved,
+// its short URL is returned with UniqueError. Short URL is returned if the change was saved.
+func (s *URL) UpdateShortened(ctx context.Context, shortened string, original string) (string, error) {
+	id := domain.UserIDFromContext(ctx)
+	original, err := s.check(id, original)
+	if err != nil {
+		return "", err
 	}
 
-	curURLsPtr.Unlock()
-	util.GetLogger().Infoln(curURLsPtr.Urls)
+	url, ok := s.store.GetByShort(shortened)
+	if !ok || url.UserID != id {
+		return "", domain.ErrURLNotFound
+	}
 
-	return shortenedURL, nil
+	if url.OriginalURL == original {
+		return shortened, nil
+	}
+
+	if saved, ok := s.findSaved(id, original); ok {
+		return saved.ShortURL, domain.NewUniqueError(errors.New("original url already exists"))
+	}
+
+	now := time.Now()
+	shrt, err := s.repo.UpdateOriginal(domain.WithDedupScope(ctx, s.scope), shortened, original, now)
+	if err != nil {
+		return shrt, err
+	}
+
+	url.OriginalURL, url.UpdatedAt = original, &now
+	s.store.Replace(url)
+
+	return shortened, nil
 }
 
-func (s *URL) DeleteUserURLs(ctx context.Context, short []domain.URLWithID, shortURLsChan *domain.MutexChanString, once *sync.Once, wg *sync.WaitGroup) {
-	shortURLs := struct {
-		URLs []string
-		uid  []int64
-		*sync.Mutex
-	}{
-		URLs:  make([]string, 0),
-		Mutex: &sync.Mutex{},
-	}
-
-	var deleteErr error
-	go func() {
-		once.Do(func() {
-			timer := time.Now()
-			for {
-				select {
-				case shrt := <-shortURLsChan.Channel:
-					shortURLs.Lock()
-					shortURLs.URLs = append(shortURLs.URLs, shrt.URL)
-					shortURLs.uid = append(shortURLs.uid, shrt.ID)
-					wg.Add(1)
-					for len(shortURLsChan.Channel) > 0 {
-						shrt = <-shortURLsChan.Channel
-						shortURLs.URLs = append(shortURLs.URLs, shrt.URL)
-						shortURLs.uid = append(shortURLs.uid, shrt.ID)
-						wg.Add(1)
-					}
-					shortURLs.Unlock()
-				default:
-					if len(shortURLs.URLs) >= 10 || (time.Since(timer) > time.Millisecond*450) && len(shortURLs.URLs) > 0 {
-						deleteErr = s.repo.DeleteUserURLs(ctx, shortURLs.URLs, shortURLs.uid)
-						if deleteErr != nil {
-							util.GetLogger().Infoln(deleteErr)
-						}
-						_, erro := s.repo.IsURLDeleted(ctx, shortURLs.URLs[0])
-						for range shortURLs.uid {
-							wg.Done()
-						}
-
-						if erro != nil {
-							util.GetLogger().Infoln(erro)
-						}
-						shortURLs.Lock()
-						shortURLs.URLs = shortURLs.URLs[:0]
-						shortURLs.uid = shortURLs.uid[:0]
-						shortURLs.Unlock()
-						timer = time.Now()
-					}
-				}
-			}
-		})
-	}()
-
-	go func() {
-		if len(short) != 0 {
-			shortURLsChan.Lock()
-			for _, url := range short {
-				shortURLsChan.Channel <- url
-			}
-			shortURLsChan.Unlock()
-			short = short[:0]
-		}
-	}()
+// ReadRevisions gets changes of original URL of short URL of the user whose id is in context in order they were made.
+func (s *URL) ReadRevisions(ctx context.Context, shortened string) ([]domain.URLRevision, error) {
+	return s.repo.ReadRevisions(ctx, shortened)
 }
 
 func (s *URL) CountURLsAndUsers(ctx context.Context) (int, int, error) {
 	return s.repo.CountURLsAndUsers(ctx)
 }
+
+// RunExpirationSweeper marks expired URLs as deleted every interval until the context is done.
+func (s *URL) RunExpirationSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			marked, err := s.repo.DeleteExpiredURLs(ctx, time.Now())
+			if err != nil {
+				util.GetLogger().Infoln("expiration sweep", err)
+			} else if marked > 0 {
+				util.GetLogger().Infoln("expired urls marked as deleted:", marked)
+			}
+		}
+	}
+}
+
+// RunPurger removes URLs which were deleted more than gracePeriod ago every interval until the context is done.
+// Removed URLs are removed from the store too, so their short versions may be used again.
+func (s *URL) RunPurger(ctx context.Context, interval time.Duration, gracePeriod time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.repo.PurgeDeletedURLs(ctx, time.Now().Add(-gracePeriod))
+			if err != nil {
+				util.GetLogger().Infoln("purge", err)
+				continue
+			}
+
+			if len(purged) > 0 {
+				s.store.Remove(purged...)
+				util.GetLogger().Infoln("deleted urls purged:", len(purged))
+			}
+		}
+	}
+}
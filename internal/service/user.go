@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// User is a type which registers new users, so every user gets an id which was never given to anybody else.
+type User struct {
+	repo domain.UserRepository
+}
+
+func NewUser(repo domain.UserRepository) *User {
+	return &User{repo: repo}
+}
+
+// Register allocates id for a new user.
+func (s *User) Register(ctx context.Context) (int64, error) {
+	return s.repo.CreateUser(ctx)
+}
+
+// RegisterLegacy saves the user who got random id before the registry of users existed, so the user keeps the id and URLs.
+func (s *User) RegisterLegacy(ctx context.Context, id int64) error {
+	return s.repo.CreateLegacyUser(ctx, id)
+}
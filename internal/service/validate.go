@@ -0,0 +1,116 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// maxAliasLength is a maximal length of alias which user can request.
+const maxAliasLength = 64
+
+// maxTitleLength, maxNoteLength and maxTagLength are maximal amounts of symbols in title, note and tag of URL.
+const (
+	maxTitleLength = 256
+	maxNoteLength  = 2048
+	maxTagLength   = 64
+)
+
+var aliasPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// tagPattern is the same as pattern of tags in api/proto/urlshrt.proto, tags are matched after they are lower-cased.
+var tagPattern = regexp.MustCompile(`^[\p{L}\p{N}][\p{L}\p{N}_.-]*$`)
+
+// reservedAliases are the first parts of paths which are handled by the app itself, so they can't be used as short URLs.
+var reservedAliases = map[string]struct{}{
+	"api":   {},
+	"ping":  {},
+	"debug": {},
+}
+
+// validateAlias checks if alias can be used as short URL.
+func validateAlias(alias string) error {
+	if len(alias) > maxAliasLength {
+		return fmt.Errorf("%w: alias should not be longer than %d symbols", domain.ErrInvalidAlias, maxAliasLength)
+	}
+
+	if !aliasPattern.MatchString(alias) {
+		return fmt.Errorf("%w: only latin letters, digits, '-' and '_' are allowed", domain.ErrInvalidAlias)
+	}
+
+	if _, ok := reservedAliases[strings.ToLower(alias)]; ok {
+		return fmt.Errorf("%w: %s is a reserved word", domain.ErrInvalidAlias, alias)
+	}
+
+	return nil
+}
+
+// validateWindow checks if URL with such expiration and activation times would ever be active.
+func validateWindow(expiresAt *time.Time, notBefore *time.Time, now time.Time) error {
+	if expiresAt == nil {
+		return nil
+	}
+
+	if !expiresAt.After(now) {
+		return fmt.Errorf("%w: expiration time has already come", domain.ErrInvalidWindow)
+	}
+
+	if notBefore != nil && !expiresAt.After(*notBefore) {
+		return fmt.Errorf("%w: expiration time should be after activation time", domain.ErrInvalidWindow)
+	}
+
+	return nil
+}
+
+// normalizeMetadata checks if URL can be given title, note and tags. Tags are trimmed, lower-cased and sorted, duplicates are removed.
+func normalizeMetadata(title string, note string, tags []string) ([]string, error) {
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		return nil, fmt.Errorf("%w: title should not be longer than %d symbols", domain.ErrInvalidMetadata, maxTitleLength)
+	}
+
+	if utf8.RuneCountInString(note) > maxNoteLength {
+		return nil, fmt.Errorf("%w: note should not be longer than %d symbols", domain.ErrInvalidMetadata, maxNoteLength)
+	}
+
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	return normalizeTags(tags)
+}
+
+// normalizeTags checks if tags can be given to URL. Tags are trimmed, lower-cased and sorted, duplicates are removed.
+func normalizeTags(tags []string) ([]string, error) {
+	if len(tags) > domain.MaxTags {
+		return nil, fmt.Errorf("%w: there should be no more than %d tags", domain.ErrInvalidMetadata, domain.MaxTags)
+	}
+
+	seen := make(map[string]struct{}, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, fmt.Errorf("%w: tag should be from 1 to %d symbols long", domain.ErrInvalidMetadata, maxTagLength)
+		}
+
+		if !tagPattern.MatchString(tag) {
+			return nil, fmt.Errorf("%w: tag should start with a letter or a digit and contain only letters, digits, '_', '.' and '-'",
+				domain.ErrInvalidMetadata)
+		}
+
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+
+	sort.Strings(normalized)
+
+	return normalized, nil
+}
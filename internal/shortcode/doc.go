@@ -0,0 +1,2 @@
+// shortcode is a package which contains strategies of short codes generation.
+package shortcode
@@ -0,0 +1,40 @@
+package shortcode
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"strconv"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// Hash is a strategy which makes codes of hash of original URL, so the same URL always gets the same code.
+type Hash struct {
+	alphabet  string
+	minLength int
+}
+
+func NewHash(alphabet string, minLength int) *Hash {
+	return &Hash{alphabet: alphabet, minLength: minLength}
+}
+
+func (g *Hash) Generate(req domain.ShortCodeRequest) string {
+	data := req.OriginalURL
+	if req.Attempt > 0 {
+		// if the code collides with another one, the hash is taken from URL with number of the attempt
+		data += "#" + strconv.Itoa(req.Attempt)
+	}
+
+	sum := sha256.Sum256([]byte(data))
+	n := new(big.Int).SetBytes(sum[:])
+	base := big.NewInt(int64(len(g.alphabet)))
+	digit := new(big.Int)
+
+	code := make([]byte, codeLength(len(g.alphabet), g.minLength, req.Used, req.Attempt))
+	for i := range code {
+		n.DivMod(n, base, digit)
+		code[i] = g.alphabet[digit.Int64()]
+	}
+
+	return string(code)
+}
@@ -0,0 +1,76 @@
+package shortcode
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// Hashids is a strategy which makes codes of numbers from counter like the Hashids library does: codes don't look sequential,
+// but they can be decoded to the numbers if salt is known.
+type Hashids struct {
+	alphabet  string
+	salt      string
+	minLength int
+	counter   *atomic.Uint64
+}
+
+func NewHashids(alphabet string, salt string, minLength int) *Hashids {
+	return &Hashids{alphabet: shuffle(alphabet, salt), salt: salt, minLength: minLength, counter: &atomic.Uint64{}}
+}
+
+// shuffle is a function to mix symbols of alphabet in the order which depends only on salt.
+func shuffle(alphabet string, salt string) string {
+	if salt == "" {
+		return alphabet
+	}
+
+	a := []byte(alphabet)
+	for i, v, p := len(a)-1, 0, 0; i > 0; i, v = i-1, v+1 {
+		v %= len(salt)
+		p += int(salt[v])
+		j := (int(salt[v]) + v + p) % i
+		a[i], a[j] = a[j], a[i]
+	}
+
+	return string(a)
+}
+
+func (g *Hashids) Generate(req domain.ShortCodeRequest) string {
+	return g.Encode(nextID(g.counter, req.Used))
+}
+
+// Encode is a method to get code of the number. The first symbol of the code (lottery) is used to shuffle the alphabet
+// which encodes the rest of the code, so consecutive numbers give codes which are not alike.
+func (g *Hashids) Encode(id uint64) string {
+	lottery := g.alphabet[id%uint64(len(g.alphabet))]
+	alphabet := shuffle(g.alphabet, string(lottery)+g.salt)
+
+	minLength := g.minLength - 1
+	if minLength < 1 {
+		minLength = 1
+	}
+
+	return string(lottery) + encode(id, alphabet, minLength)
+}
+
+// Decode is a method to get number which was encoded to the code.
+func (g *Hashids) Decode(code string) (uint64, error) {
+	if len(code) < 2 || strings.IndexByte(g.alphabet, code[0]) < 0 {
+		return 0, errors.New("incorrect code")
+	}
+
+	id, err := decode(code[1:], shuffle(g.alphabet, code[:1]+g.salt))
+	if err != nil {
+		return 0, err
+	}
+
+	// every number has only one code, so codes which were changed are not accepted
+	if g.Encode(id) != code {
+		return 0, errors.New("incorrect code")
+	}
+
+	return id, nil
+}
@@ -0,0 +1,22 @@
+package shortcode
+
+import "github.com/PoorMercymain/urlshrt/internal/domain"
+
+// Random is a strategy which makes codes of random symbols of alphabet.
+type Random struct {
+	alphabet  string
+	minLength int
+}
+
+func NewRandom(alphabet string, minLength int) *Random {
+	return &Random{alphabet: alphabet, minLength: minLength}
+}
+
+func (g *Random) Generate(req domain.ShortCodeRequest) string {
+	code := make([]byte, codeLength(len(g.alphabet), g.minLength, req.Used, req.Attempt))
+	for i := range code {
+		code[i] = g.alphabet[req.Random.Intn(len(g.alphabet))]
+	}
+
+	return string(code)
+}
@@ -0,0 +1,38 @@
+package shortcode
+
+import (
+	"sync/atomic"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// nextID is a function to increment counter and get its new value. Values which are not greater than amount of used codes
+// are skipped, so after restart the counter doesn't give ids which were given before.
+func nextID(counter *atomic.Uint64, used int) uint64 {
+	for {
+		cur := counter.Load()
+		next := cur + 1
+		if next <= uint64(used) {
+			next = uint64(used) + 1
+		}
+
+		if counter.CompareAndSwap(cur, next) {
+			return next
+		}
+	}
+}
+
+// Sequential is a strategy which makes codes of numbers from counter, so codes are as short as possible.
+type Sequential struct {
+	alphabet  string
+	minLength int
+	counter   *atomic.Uint64
+}
+
+func NewSequential(alphabet string, minLength int) *Sequential {
+	return &Sequential{alphabet: alphabet, minLength: minLength, counter: &atomic.Uint64{}}
+}
+
+func (g *Sequential) Generate(req domain.ShortCodeRequest) string {
+	return encode(nextID(g.counter, req.Used), g.alphabet, g.minLength)
+}
@@ -0,0 +1,152 @@
+package shortcode
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+)
+
+// names of the strategies which can be chosen in configuration
+const (
+	StrategyRandom     = "random"
+	StrategySequential = "sequential"
+	StrategyHashids    = "hashids"
+	StrategyHash       = "hash"
+)
+
+const (
+	// RandomAlphabet is an alphabet of random codes which were generated by the first versions of the app.
+	RandomAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxy"
+	// Base62Alphabet is an alphabet of codes which are encoded numbers.
+	Base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// DefaultLength is a minimal length of codes if it was not configured.
+	DefaultLength = 7
+
+	// keyspaceReserve is how many times amount of possible codes should be greater than amount of used codes,
+	// so random codes rarely collide
+	keyspaceReserve = 64
+	// attemptsPerSymbol is an amount of collisions after which generated code becomes one symbol longer
+	attemptsPerSymbol = 3
+)
+
+// New is a function to create generator by name of its strategy. If alphabet is empty, default alphabet of the strategy is used.
+// Minimal length of codes is length (or DefaultLength if it is not positive), salt is used only by hashids strategy.
+func New(strategy string, alphabet string, length int, salt string) (domain.ShortCodeGenerator, error) {
+	if length <= 0 {
+		length = DefaultLength
+	}
+
+	if alphabet != "" {
+		if err := checkAlphabet(alphabet); err != nil {
+			return nil, err
+		}
+	}
+
+	withDefault := func(def string) string {
+		if alphabet == "" {
+			return def
+		}
+		return alphabet
+	}
+
+	switch strategy {
+	case "", StrategyRandom:
+		return NewRandom(withDefault(RandomAlphabet), length), nil
+	case StrategySequential:
+		return NewSequential(withDefault(Base62Alphabet), length), nil
+	case StrategyHashids:
+		return NewHashids(withDefault(Base62Alphabet), salt, length), nil
+	case StrategyHash:
+		return NewHash(withDefault(Base62Alphabet), length), nil
+	default:
+		return nil, fmt.Errorf("unknown short code strategy %s", strategy)
+	}
+}
+
+// checkAlphabet checks if alphabet has at least two symbols, all of them are unique and can be used in URL path.
+func checkAlphabet(alphabet string) error {
+	if len(alphabet) < 2 {
+		return errors.New("alphabet should contain at least two symbols")
+	}
+
+	seen := make(map[byte]struct{}, len(alphabet))
+	for i := 0; i < len(alphabet); i++ {
+		c := alphabet[i]
+		isLetterOrDigit := c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z'
+		if !isLetterOrDigit && c != '-' && c != '_' && c != '.' && c != '~' {
+			return fmt.Errorf("symbol %q can't be used in short codes", c)
+		}
+
+		if _, ok := seen[c]; ok {
+			return fmt.Errorf("symbol %q is repeated in alphabet", c)
+		}
+		seen[c] = struct{}{}
+	}
+
+	return nil
+}
+
+// codeLength is a function to get length of code, it grows when there are too many used codes for minimal length
+// and when codes collide again and again.
+func codeLength(alphabetSize int, minLength int, used int, attempt int) int {
+	length := minLength
+	needed := float64(used+1) * keyspaceReserve
+	for math.Pow(float64(alphabetSize), float64(length)) < needed {
+		length++
+	}
+
+	return length + attempt/attemptsPerSymbol
+}
+
+// encode is a function to write number using symbols of alphabet as digits. Code is padded with zero digits up to minLength.
+func encode(n uint64, alphabet string, minLength int) string {
+	base := uint64(len(alphabet))
+
+	digits := make([]byte, 0, minLength)
+	for {
+		digits = append(digits, alphabet[n%base])
+		n /= base
+		if n == 0 {
+			break
+		}
+	}
+
+	for len(digits) < minLength {
+		digits = append(digits, alphabet[0])
+	}
+
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+
+	return string(digits)
+}
+
+// decode is a function to get number which was encoded with alphabet.
+func decode(code string, alphabet string) (uint64, error) {
+	base := uint64(len(alphabet))
+
+	var n uint64
+	for i := 0; i < len(code); i++ {
+		digit := -1
+		for j := 0; j < len(alphabet); j++ {
+			if alphabet[j] == code[i] {
+				digit = j
+				break
+			}
+		}
+
+		if digit < 0 {
+			return 0, fmt.Errorf("symbol %q is not in alphabet", code[i])
+		}
+
+		if n > (math.MaxUint64-uint64(digit))/base {
+			return 0, errors.New("code is too long")
+		}
+		n = n*base + uint64(digit)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,96 @@
+package shortcode
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/PoorMercymain/urlshrt/internal/domain"
+	"github.com/PoorMercymain/urlshrt/pkg/util"
+)
+
+func TestNew(t *testing.T) {
+	for _, strategy := range []string{"", StrategyRandom, StrategySequential, StrategyHashids, StrategyHash} {
+		g, err := New(strategy, "", 0, "salt")
+		require.NoError(t, err)
+		require.NotNil(t, g)
+	}
+
+	_, err := New("unknown", "", 0, "")
+	require.Error(t, err)
+
+	_, err = New(StrategyRandom, "aa", 0, "")
+	require.Error(t, err)
+
+	_, err = New(StrategyRandom, "a/", 0, "")
+	require.Error(t, err)
+}
+
+func TestRandom(t *testing.T) {
+	g := NewRandom(RandomAlphabet, DefaultLength)
+
+	// codes should be the same as codes which were generated before strategies appeared
+	code := g.Generate(domain.ShortCodeRequest{Random: rand.New(rand.NewSource(123))})
+	require.Equal(t, util.GenerateRandomString(DefaultLength, rand.New(rand.NewSource(123))), code)
+
+	g = NewRandom("ab", 2)
+	// keyspace should be much greater than amount of used codes
+	require.Len(t, g.Generate(domain.ShortCodeRequest{Random: rand.New(rand.NewSource(1))}), 6)
+	require.Len(t, g.Generate(domain.ShortCodeRequest{Random: rand.New(rand.NewSource(1)), Used: 100}), 13)
+	require.Len(t, g.Generate(domain.ShortCodeRequest{Random: rand.New(rand.NewSource(1)), Attempt: 3}), 7)
+}
+
+func TestSequential(t *testing.T) {
+	g := NewSequential(Base62Alphabet, 1)
+
+	require.Equal(t, "1", g.Generate(domain.ShortCodeRequest{}))
+	require.Equal(t, "2", g.Generate(domain.ShortCodeRequest{}))
+	require.Equal(t, "10", g.Generate(domain.ShortCodeRequest{Used: 61}))
+	require.Equal(t, "11", g.Generate(domain.ShortCodeRequest{}))
+
+	g = NewSequential(Base62Alphabet, 3)
+	require.Equal(t, "001", g.Generate(domain.ShortCodeRequest{}))
+}
+
+func TestHashids(t *testing.T) {
+	g := NewHashids(Base62Alphabet, "salt", 4)
+	other := NewHashids(Base62Alphabet, "pepper", 4)
+
+	codes := make(map[string]struct{})
+	for i := 0; i < 1000; i++ {
+		code := g.Generate(domain.ShortCodeRequest{})
+		require.GreaterOrEqual(t, len(code), 4)
+
+		_, ok := codes[code]
+		require.False(t, ok)
+		codes[code] = struct{}{}
+
+		id, err := g.Decode(code)
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+1), id)
+	}
+
+	require.NotEqual(t, g.Encode(1), other.Encode(1))
+
+	_, err := g.Decode("a")
+	require.Error(t, err)
+
+	code := g.Encode(42)
+	_, err = g.Decode(code[:1] + "-" + code[1:])
+	require.Error(t, err)
+
+	// code with extra zero digit is not the code of the number
+	_, err = g.Decode(code[:1] + shuffle(g.alphabet, code[:1]+"salt")[:1] + code[1:])
+	require.Error(t, err)
+}
+
+func TestHash(t *testing.T) {
+	g := NewHash(Base62Alphabet, DefaultLength)
+
+	code := g.Generate(domain.ShortCodeRequest{OriginalURL: "https://ya.ru"})
+	require.Len(t, code, DefaultLength)
+	require.Equal(t, code, g.Generate(domain.ShortCodeRequest{OriginalURL: "https://ya.ru"}))
+	require.NotEqual(t, code, g.Generate(domain.ShortCodeRequest{OriginalURL: "https://ya.ru", Attempt: 1}))
+	require.NotEqual(t, code, g.Generate(domain.ShortCodeRequest{OriginalURL: "https://mail.ru"}))
+}
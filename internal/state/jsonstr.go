@@ -1,8 +1,41 @@
 package state
 
+import "time"
+
 // URLStringJSON is a type which contains data which is needed for saving URLs in a database.
 type URLStringJSON struct {
 	ShortURL    string `json:"short_url"`
 	OriginalURL string `json:"original_url"`
 	UUID        int    `json:"uuid"`
+	UserID      int64  `json:"user_id,omitempty"`
+	IsDeleted   bool   `json:"is_deleted,omitempty"`
+	// DeletedAt is a time when URL was marked as deleted, it is not set for URLs which were deleted before the time was saved
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// URL is active only after NotBefore and before ExpiresAt (if they are set)
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	NotBefore *time.Time `json:"not_before,omitempty"`
+	// CreatedAt and UpdatedAt are not set for URLs which were saved before the times were saved,
+	// UpdatedAt is set when original URL is changed
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Title     string     `json:"title,omitempty"`
+	Note      string     `json:"note,omitempty"`
+	Tags      []string   `json:"tags,omitempty"`
+	// IsDisabled is set for URLs whose original URLs matched a rule of destinations which can't be shortened after they were saved,
+	// disabled URLs don't redirect anywhere and can't be enabled by their owners
+	IsDisabled bool `json:"is_disabled,omitempty"`
+}
+
+// IsActive is a method to check if URL may be used at the moment.
+func (u URLStringJSON) IsActive(now time.Time) bool {
+	if u.NotBefore != nil && now.Before(*u.NotBefore) {
+		return false
+	}
+
+	return u.ExpiresAt == nil || now.Before(*u.ExpiresAt)
+}
+
+// IsExpired is a method to check if expiration time of URL has come.
+func (u URLStringJSON) IsExpired(now time.Time) bool {
+	return u.ExpiresAt != nil && !now.Before(*u.ExpiresAt)
 }
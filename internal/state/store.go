@@ -0,0 +1,172 @@
+package state
+
+import "sync"
+
+// ownerKey is a type which represents original URL of a user.
+type ownerKey struct {
+	userID   int64
+	original string
+}
+
+// Store is a type which keeps current URLs in memory, URLs can be found by short versions and by original versions
+// (among URLs of all the users or of one user). Several stores may be used in one process, every one of them has its own URLs.
+type Store struct {
+	byShort map[string]URLStringJSON
+	// byOriginal contains the first short URL saved for an original URL by any user
+	byOriginal map[string]string
+	byOwner    map[ownerKey]string
+	*sync.RWMutex
+}
+
+// NewStore is a function to create store filled with URLs (e.g. which were read from repository).
+func NewStore(urls []URLStringJSON) *Store {
+	s := &Store{
+		byShort:    make(map[string]URLStringJSON, len(urls)),
+		byOriginal: make(map[string]string, len(urls)),
+		byOwner:    make(map[ownerKey]string, len(urls)),
+		RWMutex:    &sync.RWMutex{},
+	}
+
+	for _, url := range urls {
+		s.put(url)
+	}
+
+	return s
+}
+
+// put saves URL to all the indexes, the caller should hold the lock.
+func (s *Store) put(url URLStringJSON) {
+	s.byShort[url.ShortURL] = url
+	s.byOwner[ownerKey{userID: url.UserID, original: url.OriginalURL}] = url.ShortURL
+
+	if _, ok := s.byOriginal[url.OriginalURL]; !ok {
+		s.byOriginal[url.OriginalURL] = url.ShortURL
+	}
+}
+
+// GetByShort is a method to get URL by its short version.
+func (s *Store) GetByShort(short string) (URLStringJSON, bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	url, ok := s.byShort[short]
+	return url, ok
+}
+
+// GetByOriginal is a method to get URL by its original version, the first saved URL is returned if several users saved it.
+func (s *Store) GetByOriginal(original string) (URLStringJSON, bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	short, ok := s.byOriginal[original]
+	if !ok {
+		return URLStringJSON{}, false
+	}
+
+	return s.byShort[short], true
+}
+
+// GetByOwner is a method to get URL of the user by its original version.
+func (s *Store) GetByOwner(userID int64, original string) (URLStringJSON, bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	short, ok := s.byOwner[ownerKey{userID: userID, original: original}]
+	if !ok {
+		return URLStringJSON{}, false
+	}
+
+	return s.byShort[short], true
+}
+
+// ShortExists is a method to check if short URL is already used.
+func (s *Store) ShortExists(short string) bool {
+	s.RLock()
+	defer s.RUnlock()
+
+	_, ok := s.byShort[short]
+	return ok
+}
+
+// Len is a method to get amount of URLs in the store.
+func (s *Store) Len() int {
+	s.RLock()
+	defer s.RUnlock()
+
+	return len(s.byShort)
+}
+
+// Add is a method to save URLs to the store. If the owner has already saved original version of a URL, the saved URL is kept.
+func (s *Store) Add(urls ...URLStringJSON) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, url := range urls {
+		if _, ok := s.byOwner[ownerKey{userID: url.UserID, original: url.OriginalURL}]; !ok {
+			s.put(url)
+		}
+	}
+}
+
+// AddIfAbsent is a method to save URL to the store if its owner has not saved its original version yet.
+// The URL which is in the store after the call is returned.
+func (s *Store) AddIfAbsent(url URLStringJSON) URLStringJSON {
+	s.Lock()
+	defer s.Unlock()
+
+	if short, ok := s.byOwner[ownerKey{userID: url.UserID, original: url.OriginalURL}]; ok {
+		return s.byShort[short]
+	}
+
+	s.put(url)
+	return url
+}
+
+// remove removes URL from all the indexes by its short version, the caller should hold the lock.
+func (s *Store) remove(short string) {
+	url, ok := s.byShort[short]
+	if !ok {
+		return
+	}
+
+	delete(s.byShort, short)
+
+	key := ownerKey{userID: url.UserID, original: url.OriginalURL}
+	if s.byOwner[key] == short {
+		delete(s.byOwner, key)
+	}
+
+	if s.byOriginal[url.OriginalURL] != short {
+		return
+	}
+
+	// another user may have saved the same original URL, then the original URL is found by the short URL of that user
+	delete(s.byOriginal, url.OriginalURL)
+	for _, other := range s.byShort {
+		if other.OriginalURL == url.OriginalURL {
+			s.byOriginal[url.OriginalURL] = other.ShortURL
+			break
+		}
+	}
+}
+
+// Remove is a method to remove URLs from the store by their short versions (e.g. after they were purged from repository),
+// so the short versions may be used again.
+func (s *Store) Remove(shortURLs ...string) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, short := range shortURLs {
+		s.remove(short)
+	}
+}
+
+// Replace is a method to save URL to the store instead of URL with the same short version (e.g. after its original URL was changed),
+// so the URL is found by its new original version and is not found by the old one.
+func (s *Store) Replace(url URLStringJSON) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.remove(url.ShortURL)
+	s.put(url)
+}
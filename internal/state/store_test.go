@@ -0,0 +1,76 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	s := NewStore([]URLStringJSON{{UUID: 1, ShortURL: "abc", OriginalURL: "https://ya.ru"}})
+	other := NewStore(nil)
+
+	url, ok := s.GetByShort("abc")
+	require.True(t, ok)
+	require.Equal(t, "https://ya.ru", url.OriginalURL)
+
+	url, ok = s.GetByOriginal("https://ya.ru")
+	require.True(t, ok)
+	require.Equal(t, "abc", url.ShortURL)
+
+	_, ok = other.GetByShort("abc")
+	require.False(t, ok)
+
+	url = s.AddIfAbsent(URLStringJSON{UUID: 2, ShortURL: "cba", OriginalURL: "https://ya.ru"})
+	require.Equal(t, "abc", url.ShortURL)
+	require.False(t, s.ShortExists("cba"))
+
+	s.Add(URLStringJSON{UUID: 2, ShortURL: "cba", OriginalURL: "https://mail.ru"}, URLStringJSON{UUID: 3, ShortURL: "bca", OriginalURL: "https://ya.ru"})
+	require.True(t, s.ShortExists("cba"))
+	require.False(t, s.ShortExists("bca"))
+	require.Equal(t, 2, s.Len())
+	require.Equal(t, 0, other.Len())
+
+	// another user gets own short URL for the same original URL
+	url = s.AddIfAbsent(URLStringJSON{UUID: 3, ShortURL: "bca", OriginalURL: "https://ya.ru", UserID: 1})
+	require.Equal(t, "bca", url.ShortURL)
+	require.True(t, s.ShortExists("bca"))
+
+	url, ok = s.GetByOwner(1, "https://ya.ru")
+	require.True(t, ok)
+	require.Equal(t, "bca", url.ShortURL)
+
+	url, ok = s.GetByOriginal("https://ya.ru")
+	require.True(t, ok)
+	require.Equal(t, "abc", url.ShortURL)
+
+	_, ok = s.GetByOwner(2, "https://ya.ru")
+	require.False(t, ok)
+
+	// after the first short URL is removed, the original URL is found by short URL of another user
+	s.Remove("abc", "nope")
+	require.False(t, s.ShortExists("abc"))
+	require.Equal(t, 2, s.Len())
+
+	url, ok = s.GetByOriginal("https://ya.ru")
+	require.True(t, ok)
+	require.Equal(t, "bca", url.ShortURL)
+
+	_, ok = s.GetByOwner(0, "https://ya.ru")
+	require.False(t, ok)
+
+	// URL with changed original URL is found by the new original URL only
+	s.Replace(URLStringJSON{UUID: 3, ShortURL: "bca", OriginalURL: "https://hh.ru", UserID: 1})
+	require.Equal(t, 2, s.Len())
+
+	_, ok = s.GetByOriginal("https://ya.ru")
+	require.False(t, ok)
+
+	url, ok = s.GetByOwner(1, "https://hh.ru")
+	require.True(t, ok)
+	require.Equal(t, "bca", url.ShortURL)
+
+	url, ok = s.GetByShort("bca")
+	require.True(t, ok)
+	require.Equal(t, "https://hh.ru", url.OriginalURL)
+}
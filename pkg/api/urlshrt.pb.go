@@ -13,7 +13,9 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -23,6 +25,184 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SortOrderV1 int32
+
+const (
+	// the same as ascending order
+	SortOrderV1_SORT_ORDER_V1_UNSPECIFIED SortOrderV1 = 0
+	// urls which were created first are sent first
+	SortOrderV1_SORT_ORDER_V1_ASC SortOrderV1 = 1
+	// urls which were created last are sent first
+	SortOrderV1_SORT_ORDER_V1_DESC SortOrderV1 = 2
+)
+
+// Enum value maps for SortOrderV1.
+var (
+	SortOrderV1_name = map[int32]string{
+		0: "SORT_ORDER_V1_UNSPECIFIED",
+		1: "SORT_ORDER_V1_ASC",
+		2: "SORT_ORDER_V1_DESC",
+	}
+	SortOrderV1_value = map[string]int32{
+		"SORT_ORDER_V1_UNSPECIFIED": 0,
+		"SORT_ORDER_V1_ASC":         1,
+		"SORT_ORDER_V1_DESC":        2,
+	}
+)
+
+func (x SortOrderV1) Enum() *SortOrderV1 {
+	p := new(SortOrderV1)
+	*p = x
+	return p
+}
+
+func (x SortOrderV1) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrderV1) Descriptor() protoreflect.EnumDescriptor {
+	return file_urlshrt_proto_enumTypes[0].Descriptor()
+}
+
+func (SortOrderV1) Type() protoreflect.EnumType {
+	return &file_urlshrt_proto_enumTypes[0]
+}
+
+func (x SortOrderV1) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrderV1.Descriptor instead.
+func (SortOrderV1) EnumDescriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{0}
+}
+
+type DeletionStatusV1 int32
+
+const (
+	DeletionStatusV1_DELETION_STATUS_V1_UNSPECIFIED DeletionStatusV1 = 0
+	// url is not processed yet
+	DeletionStatusV1_DELETION_STATUS_V1_PENDING DeletionStatusV1 = 1
+	// url belongs to the user and is deleted
+	DeletionStatusV1_DELETION_STATUS_V1_DELETED DeletionStatusV1 = 2
+	// url belongs to somebody else, so it was not deleted
+	DeletionStatusV1_DELETION_STATUS_V1_NOT_OWNED DeletionStatusV1 = 3
+	// there is no such url
+	DeletionStatusV1_DELETION_STATUS_V1_NOT_FOUND DeletionStatusV1 = 4
+	// url could not be deleted because of an error
+	DeletionStatusV1_DELETION_STATUS_V1_FAILED DeletionStatusV1 = 5
+)
+
+// Enum value maps for DeletionStatusV1.
+var (
+	DeletionStatusV1_name = map[int32]string{
+		0: "DELETION_STATUS_V1_UNSPECIFIED",
+		1: "DELETION_STATUS_V1_PENDING",
+		2: "DELETION_STATUS_V1_DELETED",
+		3: "DELETION_STATUS_V1_NOT_OWNED",
+		4: "DELETION_STATUS_V1_NOT_FOUND",
+		5: "DELETION_STATUS_V1_FAILED",
+	}
+	DeletionStatusV1_value = map[string]int32{
+		"DELETION_STATUS_V1_UNSPECIFIED": 0,
+		"DELETION_STATUS_V1_PENDING":     1,
+		"DELETION_STATUS_V1_DELETED":     2,
+		"DELETION_STATUS_V1_NOT_OWNED":   3,
+		"DELETION_STATUS_V1_NOT_FOUND":   4,
+		"DELETION_STATUS_V1_FAILED":      5,
+	}
+)
+
+func (x DeletionStatusV1) Enum() *DeletionStatusV1 {
+	p := new(DeletionStatusV1)
+	*p = x
+	return p
+}
+
+func (x DeletionStatusV1) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeletionStatusV1) Descriptor() protoreflect.EnumDescriptor {
+	return file_urlshrt_proto_enumTypes[1].Descriptor()
+}
+
+func (DeletionStatusV1) Type() protoreflect.EnumType {
+	return &file_urlshrt_proto_enumTypes[1]
+}
+
+func (x DeletionStatusV1) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeletionStatusV1.Descriptor instead.
+func (DeletionStatusV1) EnumDescriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{1}
+}
+
+type RestoreStatusV1 int32
+
+const (
+	RestoreStatusV1_RESTORE_STATUS_V1_UNSPECIFIED RestoreStatusV1 = 0
+	// url is restored and works again
+	RestoreStatusV1_RESTORE_STATUS_V1_RESTORED RestoreStatusV1 = 1
+	// url was not deleted
+	RestoreStatusV1_RESTORE_STATUS_V1_NOT_DELETED RestoreStatusV1 = 2
+	// url belongs to somebody else, so it was not restored
+	RestoreStatusV1_RESTORE_STATUS_V1_NOT_OWNED RestoreStatusV1 = 3
+	// there is no such url, it may be already purged
+	RestoreStatusV1_RESTORE_STATUS_V1_NOT_FOUND RestoreStatusV1 = 4
+	// grace period after deletion is over or expiration time of url has come, so it can't be restored
+	RestoreStatusV1_RESTORE_STATUS_V1_EXPIRED RestoreStatusV1 = 5
+)
+
+// Enum value maps for RestoreStatusV1.
+var (
+	RestoreStatusV1_name = map[int32]string{
+		0: "RESTORE_STATUS_V1_UNSPECIFIED",
+		1: "RESTORE_STATUS_V1_RESTORED",
+		2: "RESTORE_STATUS_V1_NOT_DELETED",
+		3: "RESTORE_STATUS_V1_NOT_OWNED",
+		4: "RESTORE_STATUS_V1_NOT_FOUND",
+		5: "RESTORE_STATUS_V1_EXPIRED",
+	}
+	RestoreStatusV1_value = map[string]int32{
+		"RESTORE_STATUS_V1_UNSPECIFIED": 0,
+		"RESTORE_STATUS_V1_RESTORED":    1,
+		"RESTORE_STATUS_V1_NOT_DELETED": 2,
+		"RESTORE_STATUS_V1_NOT_OWNED":   3,
+		"RESTORE_STATUS_V1_NOT_FOUND":   4,
+		"RESTORE_STATUS_V1_EXPIRED":     5,
+	}
+)
+
+func (x RestoreStatusV1) Enum() *RestoreStatusV1 {
+	p := new(RestoreStatusV1)
+	*p = x
+	return p
+}
+
+func (x RestoreStatusV1) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RestoreStatusV1) Descriptor() protoreflect.EnumDescriptor {
+	return file_urlshrt_proto_enumTypes[2].Descriptor()
+}
+
+func (RestoreStatusV1) Type() protoreflect.EnumType {
+	return &file_urlshrt_proto_enumTypes[2]
+}
+
+func (x RestoreStatusV1) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RestoreStatusV1.Descriptor instead.
+func (RestoreStatusV1) EnumDescriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{2}
+}
+
 type ReadOriginalRequestV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -123,6 +303,16 @@ type CreateShortenedRequestV1 struct {
 	unknownFields protoimpl.UnknownFields
 
 	Original string `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	// optional short url to use instead of generated one
+	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	// optional time after which short url stops working
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// optional time before which short url doesn't work yet
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	// optional title, note and tags which are shown to the user only
+	Title string   `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Note  string   `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	Tags  []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (x *CreateShortenedRequestV1) Reset() {
@@ -164,6 +354,48 @@ func (x *CreateShortenedRequestV1) GetOriginal() string {
 	return ""
 }
 
+func (x *CreateShortenedRequestV1) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *CreateShortenedRequestV1) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateShortenedRequestV1) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *CreateShortenedRequestV1) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateShortenedRequestV1) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateShortenedRequestV1) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type CreateShortenedReplyV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -211,6 +443,110 @@ func (x *CreateShortenedReplyV1) GetShortened() string {
 	return ""
 }
 
+type UpdateShortenedRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// short url without host
+	Shortened string `protobuf:"bytes,1,opt,name=shortened,proto3" json:"shortened,omitempty"`
+	// new original url
+	Original string `protobuf:"bytes,2,opt,name=original,proto3" json:"original,omitempty"`
+}
+
+func (x *UpdateShortenedRequestV1) Reset() {
+	*x = UpdateShortenedRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateShortenedRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShortenedRequestV1) ProtoMessage() {}
+
+func (x *UpdateShortenedRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShortenedRequestV1.ProtoReflect.Descriptor instead.
+func (*UpdateShortenedRequestV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateShortenedRequestV1) GetShortened() string {
+	if x != nil {
+		return x.Shortened
+	}
+	return ""
+}
+
+func (x *UpdateShortenedRequestV1) GetOriginal() string {
+	if x != nil {
+		return x.Original
+	}
+	return ""
+}
+
+type UpdateShortenedReplyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shortened string `protobuf:"bytes,1,opt,name=shortened,proto3" json:"shortened,omitempty"`
+}
+
+func (x *UpdateShortenedReplyV1) Reset() {
+	*x = UpdateShortenedReplyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateShortenedReplyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShortenedReplyV1) ProtoMessage() {}
+
+func (x *UpdateShortenedReplyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShortenedReplyV1.ProtoReflect.Descriptor instead.
+func (*UpdateShortenedReplyV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateShortenedReplyV1) GetShortened() string {
+	if x != nil {
+		return x.Shortened
+	}
+	return ""
+}
+
 type CreateShortenedFromBatchRequestV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -222,7 +558,7 @@ type CreateShortenedFromBatchRequestV1 struct {
 func (x *CreateShortenedFromBatchRequestV1) Reset() {
 	*x = CreateShortenedFromBatchRequestV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_urlshrt_proto_msgTypes[4]
+		mi := &file_urlshrt_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -235,7 +571,7 @@ func (x *CreateShortenedFromBatchRequestV1) String() string {
 func (*CreateShortenedFromBatchRequestV1) ProtoMessage() {}
 
 func (x *CreateShortenedFromBatchRequestV1) ProtoReflect() protoreflect.Message {
-	mi := &file_urlshrt_proto_msgTypes[4]
+	mi := &file_urlshrt_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,7 +584,7 @@ func (x *CreateShortenedFromBatchRequestV1) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateShortenedFromBatchRequestV1.ProtoReflect.Descriptor instead.
 func (*CreateShortenedFromBatchRequestV1) Descriptor() ([]byte, []int) {
-	return file_urlshrt_proto_rawDescGZIP(), []int{4}
+	return file_urlshrt_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateShortenedFromBatchRequestV1) GetOriginal() []*OriginalWithCorrelationV1 {
@@ -265,12 +601,22 @@ type OriginalWithCorrelationV1 struct {
 
 	Original    string `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
 	Correlation string `protobuf:"bytes,2,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	// optional short url to use instead of generated one
+	Alias string `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	// optional time after which short url stops working
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// optional time before which short url doesn't work yet
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	// optional title, note and tags which are shown to the user only
+	Title string   `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Note  string   `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	Tags  []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (x *OriginalWithCorrelationV1) Reset() {
 	*x = OriginalWithCorrelationV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_urlshrt_proto_msgTypes[5]
+		mi := &file_urlshrt_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -283,7 +629,7 @@ func (x *OriginalWithCorrelationV1) String() string {
 func (*OriginalWithCorrelationV1) ProtoMessage() {}
 
 func (x *OriginalWithCorrelationV1) ProtoReflect() protoreflect.Message {
-	mi := &file_urlshrt_proto_msgTypes[5]
+	mi := &file_urlshrt_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +642,7 @@ func (x *OriginalWithCorrelationV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OriginalWithCorrelationV1.ProtoReflect.Descriptor instead.
 func (*OriginalWithCorrelationV1) Descriptor() ([]byte, []int) {
-	return file_urlshrt_proto_rawDescGZIP(), []int{5}
+	return file_urlshrt_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *OriginalWithCorrelationV1) GetOriginal() string {
@@ -313,6 +659,48 @@ func (x *OriginalWithCorrelationV1) GetCorrelation() string {
 	return ""
 }
 
+func (x *OriginalWithCorrelationV1) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *OriginalWithCorrelationV1) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *OriginalWithCorrelationV1) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *OriginalWithCorrelationV1) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OriginalWithCorrelationV1) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *OriginalWithCorrelationV1) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type CreateShortenedFromBatchReplyV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -324,7 +712,7 @@ type CreateShortenedFromBatchReplyV1 struct {
 func (x *CreateShortenedFromBatchReplyV1) Reset() {
 	*x = CreateShortenedFromBatchReplyV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_urlshrt_proto_msgTypes[6]
+		mi := &file_urlshrt_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -337,7 +725,7 @@ func (x *CreateShortenedFromBatchReplyV1) String() string {
 func (*CreateShortenedFromBatchReplyV1) ProtoMessage() {}
 
 func (x *CreateShortenedFromBatchReplyV1) ProtoReflect() protoreflect.Message {
-	mi := &file_urlshrt_proto_msgTypes[6]
+	mi := &file_urlshrt_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +738,7 @@ func (x *CreateShortenedFromBatchReplyV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateShortenedFromBatchReplyV1.ProtoReflect.Descriptor instead.
 func (*CreateShortenedFromBatchReplyV1) Descriptor() ([]byte, []int) {
-	return file_urlshrt_proto_rawDescGZIP(), []int{6}
+	return file_urlshrt_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateShortenedFromBatchReplyV1) GetShortened() []*ShortenedWithCorrelationV1 {
@@ -372,7 +760,7 @@ type ShortenedWithCorrelationV1 struct {
 func (x *ShortenedWithCorrelationV1) Reset() {
 	*x = ShortenedWithCorrelationV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_urlshrt_proto_msgTypes[7]
+		mi := &file_urlshrt_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -385,7 +773,7 @@ func (x *ShortenedWithCorrelationV1) String() string {
 func (*ShortenedWithCorrelationV1) ProtoMessage() {}
 
 func (x *ShortenedWithCorrelationV1) ProtoReflect() protoreflect.Message {
-	mi := &file_urlshrt_proto_msgTypes[7]
+	mi := &file_urlshrt_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +786,7 @@ func (x *ShortenedWithCorrelationV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShortenedWithCorrelationV1.ProtoReflect.Descriptor instead.
 func (*ShortenedWithCorrelationV1) Descriptor() ([]byte, []int) {
-	return file_urlshrt_proto_rawDescGZIP(), []int{7}
+	return file_urlshrt_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ShortenedWithCorrelationV1) GetShortened() string {
@@ -426,7 +814,7 @@ type ReadUserURLsReplyV1 struct {
 func (x *ReadUserURLsReplyV1) Reset() {
 	*x = ReadUserURLsReplyV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_urlshrt_proto_msgTypes[8]
+		mi := &file_urlshrt_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -439,7 +827,7 @@ func (x *ReadUserURLsReplyV1) String() string {
 func (*ReadUserURLsReplyV1) ProtoMessage() {}
 
 func (x *ReadUserURLsReplyV1) ProtoReflect() protoreflect.Message {
-	mi := &file_urlshrt_proto_msgTypes[8]
+	mi := &file_urlshrt_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +840,7 @@ func (x *ReadUserURLsReplyV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadUserURLsReplyV1.ProtoReflect.Descriptor instead.
 func (*ReadUserURLsReplyV1) Descriptor() ([]byte, []int) {
-	return file_urlshrt_proto_rawDescGZIP(), []int{8}
+	return file_urlshrt_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ReadUserURLsReplyV1) GetOriginalWithShortened() []*OriginalWithShortenedV1 {
@@ -469,12 +857,19 @@ type OriginalWithShortenedV1 struct {
 
 	Original  string `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
 	Shortened string `protobuf:"bytes,2,opt,name=shortened,proto3" json:"shortened,omitempty"`
+	// not set for urls which were created before the time was saved
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// time of the last change of original url, not set if it was never changed
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Title     string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Note      string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	Tags      []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (x *OriginalWithShortenedV1) Reset() {
 	*x = OriginalWithShortenedV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_urlshrt_proto_msgTypes[9]
+		mi := &file_urlshrt_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -487,7 +882,7 @@ func (x *OriginalWithShortenedV1) String() string {
 func (*OriginalWithShortenedV1) ProtoMessage() {}
 
 func (x *OriginalWithShortenedV1) ProtoReflect() protoreflect.Message {
-	mi := &file_urlshrt_proto_msgTypes[9]
+	mi := &file_urlshrt_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +895,7 @@ func (x *OriginalWithShortenedV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OriginalWithShortenedV1.ProtoReflect.Descriptor instead.
 func (*OriginalWithShortenedV1) Descriptor() ([]byte, []int) {
-	return file_urlshrt_proto_rawDescGZIP(), []int{9}
+	return file_urlshrt_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *OriginalWithShortenedV1) GetOriginal() string {
@@ -517,19 +912,334 @@ func (x *OriginalWithShortenedV1) GetShortened() string {
 	return ""
 }
 
-type ReadAmountOfURLsAndUsersReplyV1 struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UrlsAmount  int64 `protobuf:"varint,1,opt,name=urls_amount,json=urlsAmount,proto3" json:"urls_amount,omitempty"`
-	UsersAmount int64 `protobuf:"varint,2,opt,name=users_amount,json=usersAmount,proto3" json:"users_amount,omitempty"`
+func (x *OriginalWithShortenedV1) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *ReadAmountOfURLsAndUsersReplyV1) Reset() {
-	*x = ReadAmountOfURLsAndUsersReplyV1{}
+func (x *OriginalWithShortenedV1) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *OriginalWithShortenedV1) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OriginalWithShortenedV1) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *OriginalWithShortenedV1) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type StreamUserURLsRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order SortOrderV1 `protobuf:"varint,1,opt,name=order,proto3,enum=api.v1.SortOrderV1" json:"order,omitempty"`
+	// optional substring of original url, letter case is ignored
+	Contains string `protobuf:"bytes,2,opt,name=contains,proto3" json:"contains,omitempty"`
+	// optional host of original url, urls of its subdomains are sent too
+	Domain string `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	// optional tag which urls should have, letter case is ignored
+	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *StreamUserURLsRequestV1) Reset() {
+	*x = StreamUserURLsRequestV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_urlshrt_proto_msgTypes[10]
+		mi := &file_urlshrt_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamUserURLsRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamUserURLsRequestV1) ProtoMessage() {}
+
+func (x *StreamUserURLsRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamUserURLsRequestV1.ProtoReflect.Descriptor instead.
+func (*StreamUserURLsRequestV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamUserURLsRequestV1) GetOrder() SortOrderV1 {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrderV1_SORT_ORDER_V1_UNSPECIFIED
+}
+
+func (x *StreamUserURLsRequestV1) GetContains() string {
+	if x != nil {
+		return x.Contains
+	}
+	return ""
+}
+
+func (x *StreamUserURLsRequestV1) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *StreamUserURLsRequestV1) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type UpdateTagsRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// short url without host
+	Shortened string `protobuf:"bytes,1,opt,name=shortened,proto3" json:"shortened,omitempty"`
+	// tag names, letter case is ignored
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *UpdateTagsRequestV1) Reset() {
+	*x = UpdateTagsRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTagsRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagsRequestV1) ProtoMessage() {}
+
+func (x *UpdateTagsRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagsRequestV1.ProtoReflect.Descriptor instead.
+func (*UpdateTagsRequestV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateTagsRequestV1) GetShortened() string {
+	if x != nil {
+		return x.Shortened
+	}
+	return ""
+}
+
+func (x *UpdateTagsRequestV1) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UpdateTagsReplyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *UpdateTagsReplyV1) Reset() {
+	*x = UpdateTagsReplyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTagsReplyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagsReplyV1) ProtoMessage() {}
+
+func (x *UpdateTagsReplyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagsReplyV1.ProtoReflect.Descriptor instead.
+func (*UpdateTagsReplyV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateTagsReplyV1) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagCountV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag   string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TagCountV1) Reset() {
+	*x = TagCountV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagCountV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCountV1) ProtoMessage() {}
+
+func (x *TagCountV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCountV1.ProtoReflect.Descriptor instead.
+func (*TagCountV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TagCountV1) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagCountV1) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReadTagsReplyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*TagCountV1 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ReadTagsReplyV1) Reset() {
+	*x = ReadTagsReplyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadTagsReplyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTagsReplyV1) ProtoMessage() {}
+
+func (x *ReadTagsReplyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadTagsReplyV1.ProtoReflect.Descriptor instead.
+func (*ReadTagsReplyV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReadTagsReplyV1) GetTags() []*TagCountV1 {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ReadAmountOfURLsAndUsersReplyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UrlsAmount  int64 `protobuf:"varint,1,opt,name=urls_amount,json=urlsAmount,proto3" json:"urls_amount,omitempty"`
+	UsersAmount int64 `protobuf:"varint,2,opt,name=users_amount,json=usersAmount,proto3" json:"users_amount,omitempty"`
+}
+
+func (x *ReadAmountOfURLsAndUsersReplyV1) Reset() {
+	*x = ReadAmountOfURLsAndUsersReplyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -542,7 +1252,7 @@ func (x *ReadAmountOfURLsAndUsersReplyV1) String() string {
 func (*ReadAmountOfURLsAndUsersReplyV1) ProtoMessage() {}
 
 func (x *ReadAmountOfURLsAndUsersReplyV1) ProtoReflect() protoreflect.Message {
-	mi := &file_urlshrt_proto_msgTypes[10]
+	mi := &file_urlshrt_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,7 +1265,7 @@ func (x *ReadAmountOfURLsAndUsersReplyV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAmountOfURLsAndUsersReplyV1.ProtoReflect.Descriptor instead.
 func (*ReadAmountOfURLsAndUsersReplyV1) Descriptor() ([]byte, []int) {
-	return file_urlshrt_proto_rawDescGZIP(), []int{10}
+	return file_urlshrt_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReadAmountOfURLsAndUsersReplyV1) GetUrlsAmount() int64 {
@@ -572,31 +1282,32 @@ func (x *ReadAmountOfURLsAndUsersReplyV1) GetUsersAmount() int64 {
 	return 0
 }
 
-type DeleteUserURLsRequestV1 struct {
+type EnforcePolicyReplyV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UrlsToDelete []string `protobuf:"bytes,1,rep,name=urls_to_delete,json=urlsToDelete,proto3" json:"urls_to_delete,omitempty"`
+	// disabled short urls with host
+	Disabled []string `protobuf:"bytes,1,rep,name=disabled,proto3" json:"disabled,omitempty"`
 }
 
-func (x *DeleteUserURLsRequestV1) Reset() {
-	*x = DeleteUserURLsRequestV1{}
+func (x *EnforcePolicyReplyV1) Reset() {
+	*x = EnforcePolicyReplyV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_urlshrt_proto_msgTypes[11]
+		mi := &file_urlshrt_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteUserURLsRequestV1) String() string {
+func (x *EnforcePolicyReplyV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserURLsRequestV1) ProtoMessage() {}
+func (*EnforcePolicyReplyV1) ProtoMessage() {}
 
-func (x *DeleteUserURLsRequestV1) ProtoReflect() protoreflect.Message {
-	mi := &file_urlshrt_proto_msgTypes[11]
+func (x *EnforcePolicyReplyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,134 +1318,1293 @@ func (x *DeleteUserURLsRequestV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserURLsRequestV1.ProtoReflect.Descriptor instead.
-func (*DeleteUserURLsRequestV1) Descriptor() ([]byte, []int) {
-	return file_urlshrt_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use EnforcePolicyReplyV1.ProtoReflect.Descriptor instead.
+func (*EnforcePolicyReplyV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteUserURLsRequestV1) GetUrlsToDelete() []string {
+func (x *EnforcePolicyReplyV1) GetDisabled() []string {
 	if x != nil {
-		return x.UrlsToDelete
+		return x.Disabled
+	}
+	return nil
+}
+
+type DeleteUserURLsRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UrlsToDelete []string `protobuf:"bytes,1,rep,name=urls_to_delete,json=urlsToDelete,proto3" json:"urls_to_delete,omitempty"`
+}
+
+func (x *DeleteUserURLsRequestV1) Reset() {
+	*x = DeleteUserURLsRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserURLsRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserURLsRequestV1) ProtoMessage() {}
+
+func (x *DeleteUserURLsRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserURLsRequestV1.ProtoReflect.Descriptor instead.
+func (*DeleteUserURLsRequestV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteUserURLsRequestV1) GetUrlsToDelete() []string {
+	if x != nil {
+		return x.UrlsToDelete
+	}
+	return nil
+}
+
+type DeleteUserURLsReplyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the job to read statuses of the urls with
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *DeleteUserURLsReplyV1) Reset() {
+	*x = DeleteUserURLsReplyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserURLsReplyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserURLsReplyV1) ProtoMessage() {}
+
+func (x *DeleteUserURLsReplyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserURLsReplyV1.ProtoReflect.Descriptor instead.
+func (*DeleteUserURLsReplyV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteUserURLsReplyV1) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type ReadDeletionJobRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *ReadDeletionJobRequestV1) Reset() {
+	*x = ReadDeletionJobRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDeletionJobRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeletionJobRequestV1) ProtoMessage() {}
+
+func (x *ReadDeletionJobRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeletionJobRequestV1.ProtoReflect.Descriptor instead.
+func (*ReadDeletionJobRequestV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReadDeletionJobRequestV1) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DeletionResultV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shortened string           `protobuf:"bytes,1,opt,name=shortened,proto3" json:"shortened,omitempty"`
+	Status    DeletionStatusV1 `protobuf:"varint,2,opt,name=status,proto3,enum=api.v1.DeletionStatusV1" json:"status,omitempty"`
+}
+
+func (x *DeletionResultV1) Reset() {
+	*x = DeletionResultV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletionResultV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletionResultV1) ProtoMessage() {}
+
+func (x *DeletionResultV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletionResultV1.ProtoReflect.Descriptor instead.
+func (*DeletionResultV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeletionResultV1) GetShortened() string {
+	if x != nil {
+		return x.Shortened
+	}
+	return ""
+}
+
+func (x *DeletionResultV1) GetStatus() DeletionStatusV1 {
+	if x != nil {
+		return x.Status
+	}
+	return DeletionStatusV1_DELETION_STATUS_V1_UNSPECIFIED
+}
+
+type ReadDeletionJobReplyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId     string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// true if none of the urls is pending
+	Done    bool                `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	Results []*DeletionResultV1 `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ReadDeletionJobReplyV1) Reset() {
+	*x = ReadDeletionJobReplyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDeletionJobReplyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeletionJobReplyV1) ProtoMessage() {}
+
+func (x *ReadDeletionJobReplyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeletionJobReplyV1.ProtoReflect.Descriptor instead.
+func (*ReadDeletionJobReplyV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReadDeletionJobReplyV1) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ReadDeletionJobReplyV1) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReadDeletionJobReplyV1) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ReadDeletionJobReplyV1) GetResults() []*DeletionResultV1 {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type RestoreUserURLsRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UrlsToRestore []string `protobuf:"bytes,1,rep,name=urls_to_restore,json=urlsToRestore,proto3" json:"urls_to_restore,omitempty"`
+}
+
+func (x *RestoreUserURLsRequestV1) Reset() {
+	*x = RestoreUserURLsRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreUserURLsRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserURLsRequestV1) ProtoMessage() {}
+
+func (x *RestoreUserURLsRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserURLsRequestV1.ProtoReflect.Descriptor instead.
+func (*RestoreUserURLsRequestV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RestoreUserURLsRequestV1) GetUrlsToRestore() []string {
+	if x != nil {
+		return x.UrlsToRestore
+	}
+	return nil
+}
+
+type RestoreResultV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shortened string          `protobuf:"bytes,1,opt,name=shortened,proto3" json:"shortened,omitempty"`
+	Status    RestoreStatusV1 `protobuf:"varint,2,opt,name=status,proto3,enum=api.v1.RestoreStatusV1" json:"status,omitempty"`
+}
+
+func (x *RestoreResultV1) Reset() {
+	*x = RestoreResultV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreResultV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResultV1) ProtoMessage() {}
+
+func (x *RestoreResultV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResultV1.ProtoReflect.Descriptor instead.
+func (*RestoreResultV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RestoreResultV1) GetShortened() string {
+	if x != nil {
+		return x.Shortened
+	}
+	return ""
+}
+
+func (x *RestoreResultV1) GetStatus() RestoreStatusV1 {
+	if x != nil {
+		return x.Status
+	}
+	return RestoreStatusV1_RESTORE_STATUS_V1_UNSPECIFIED
+}
+
+type RestoreUserURLsReplyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*RestoreResultV1 `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *RestoreUserURLsReplyV1) Reset() {
+	*x = RestoreUserURLsReplyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreUserURLsReplyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserURLsReplyV1) ProtoMessage() {}
+
+func (x *RestoreUserURLsReplyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserURLsReplyV1.ProtoReflect.Descriptor instead.
+func (*RestoreUserURLsReplyV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RestoreUserURLsReplyV1) GetResults() []*RestoreResultV1 {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ReadURLStatsRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// short url without host
+	Shortened string `protobuf:"bytes,1,opt,name=shortened,proto3" json:"shortened,omitempty"`
+	// length of periods by which clicks are counted, a day if not set
+	Bucket *durationpb.Duration `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (x *ReadURLStatsRequestV1) Reset() {
+	*x = ReadURLStatsRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadURLStatsRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadURLStatsRequestV1) ProtoMessage() {}
+
+func (x *ReadURLStatsRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadURLStatsRequestV1.ProtoReflect.Descriptor instead.
+func (*ReadURLStatsRequestV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReadURLStatsRequestV1) GetShortened() string {
+	if x != nil {
+		return x.Shortened
+	}
+	return ""
+}
+
+func (x *ReadURLStatsRequestV1) GetBucket() *durationpb.Duration {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+type ReadURLStatsReplyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shortened string           `protobuf:"bytes,1,opt,name=shortened,proto3" json:"shortened,omitempty"`
+	Total     int64            `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Buckets   []*ClickBucketV1 `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *ReadURLStatsReplyV1) Reset() {
+	*x = ReadURLStatsReplyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadURLStatsReplyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadURLStatsReplyV1) ProtoMessage() {}
+
+func (x *ReadURLStatsReplyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadURLStatsReplyV1.ProtoReflect.Descriptor instead.
+func (*ReadURLStatsReplyV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReadURLStatsReplyV1) GetShortened() string {
+	if x != nil {
+		return x.Shortened
+	}
+	return ""
+}
+
+func (x *ReadURLStatsReplyV1) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReadURLStatsReplyV1) GetBuckets() []*ClickBucketV1 {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type ClickBucketV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Clicks int64                  `protobuf:"varint,2,opt,name=clicks,proto3" json:"clicks,omitempty"`
+}
+
+func (x *ClickBucketV1) Reset() {
+	*x = ClickBucketV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClickBucketV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickBucketV1) ProtoMessage() {}
+
+func (x *ClickBucketV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickBucketV1.ProtoReflect.Descriptor instead.
+func (*ClickBucketV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ClickBucketV1) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ClickBucketV1) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+type APIKeyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// beginning of the key, so it could be recognized
+	Prefix    string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// not set if the key was not revoked
+	RevokedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+}
+
+func (x *APIKeyV1) Reset() {
+	*x = APIKeyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKeyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyV1) ProtoMessage() {}
+
+func (x *APIKeyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyV1.ProtoReflect.Descriptor instead.
+func (*APIKeyV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *APIKeyV1) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIKeyV1) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKeyV1) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIKeyV1) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *APIKeyV1) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+type CreateAPIKeyRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateAPIKeyRequestV1) Reset() {
+	*x = CreateAPIKeyRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequestV1) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequestV1.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequestV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateAPIKeyRequestV1) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateAPIKeyReplyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *APIKeyV1 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// the key to send in x-api-key or authorization metadata
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CreateAPIKeyReplyV1) Reset() {
+	*x = CreateAPIKeyReplyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyReplyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyReplyV1) ProtoMessage() {}
+
+func (x *CreateAPIKeyReplyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyReplyV1.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyReplyV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateAPIKeyReplyV1) GetApiKey() *APIKeyV1 {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyReplyV1) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ReadAPIKeysReplyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKeys []*APIKeyV1 `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+}
+
+func (x *ReadAPIKeysReplyV1) Reset() {
+	*x = ReadAPIKeysReplyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAPIKeysReplyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAPIKeysReplyV1) ProtoMessage() {}
+
+func (x *ReadAPIKeysReplyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAPIKeysReplyV1.ProtoReflect.Descriptor instead.
+func (*ReadAPIKeysReplyV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReadAPIKeysReplyV1) GetApiKeys() []*APIKeyV1 {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RevokeAPIKeyRequestV1) Reset() {
+	*x = RevokeAPIKeyRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_urlshrt_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAPIKeyRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequestV1) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_urlshrt_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequestV1.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequestV1) Descriptor() ([]byte, []int) {
+	return file_urlshrt_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RevokeAPIKeyRequestV1) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
 var File_urlshrt_proto protoreflect.FileDescriptor
 
 var file_urlshrt_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x75, 0x72, 0x6c, 0x73, 0x68, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x06, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x06, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3e, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x25, 0x0a,
-	0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74,
-	0x65, 0x6e, 0x65, 0x64, 0x22, 0x3a, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x12, 0x23, 0x0a, 0x08, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
-	0x22, 0x3f, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65,
-	0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x23, 0x0a, 0x08,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3e, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x25,
+	0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x3a, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x12, 0x23, 0x0a, 0x08,
 	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
 	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
-	0x6c, 0x22, 0x3f, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74,
-	0x65, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x73,
+	0x6c, 0x22, 0xea, 0x02, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x65, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x23,
+	0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x19, 0xfa, 0x42, 0x16, 0x72, 0x14, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0x18, 0x80, 0x02, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x6e, 0x6f,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x10, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x30, 0xfa, 0x42, 0x2d, 0x92, 0x01, 0x2a, 0x10, 0x20,
+	0x22, 0x26, 0x72, 0x24, 0x10, 0x01, 0x18, 0x40, 0x32, 0x1e, 0x5e, 0x5b, 0x5c, 0x70, 0x7b, 0x4c,
+	0x7d, 0x5c, 0x70, 0x7b, 0x4e, 0x7d, 0x5d, 0x5b, 0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x5c, 0x70, 0x7b,
+	0x4e, 0x7d, 0x5f, 0x2e, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3f,
+	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x22,
+	0x66, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e,
+	0x65, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x22, 0x3f, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56,
+	0x31, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x6c, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x47, 0x0a,
+	0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x22, 0x96, 0x03, 0x0a, 0x19, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x31, 0x12, 0x23, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x0b, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x19, 0xfa, 0x42, 0x16, 0x72, 0x14, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x05,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0x18, 0x80, 0x02, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0x18, 0x80, 0x10, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x30, 0xfa, 0x42, 0x2d, 0x92, 0x01, 0x2a, 0x10,
+	0x20, 0x22, 0x26, 0x72, 0x24, 0x10, 0x01, 0x18, 0x40, 0x32, 0x1e, 0x5e, 0x5b, 0x5c, 0x70, 0x7b,
+	0x4c, 0x7d, 0x5c, 0x70, 0x7b, 0x4e, 0x7d, 0x5d, 0x5b, 0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x5c, 0x70,
+	0x7b, 0x4e, 0x7d, 0x5f, 0x2e, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22,
+	0x6d, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e,
+	0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x56, 0x31, 0x12, 0x4a, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01,
+	0x02, 0x08, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x6e,
+	0x0a, 0x1a, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x43,
+	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x12, 0x25, 0x0a, 0x09,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65,
+	0x6e, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78,
+	0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x56, 0x31, 0x12, 0x61, 0x0a, 0x17, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x65, 0x6e, 0x65, 0x64, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08,
+	0x00, 0x52, 0x15, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x99, 0x02, 0x0a, 0x17, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e,
+	0x65, 0x64, 0x56, 0x31, 0x12, 0x23, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31,
+	0x12, 0x33, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x3c, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xfa, 0x42, 0x27, 0x72, 0x25, 0x18, 0x40, 0x32,
+	0x21, 0x5e, 0x28, 0x5b, 0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x5c, 0x70, 0x7b, 0x4e, 0x7d, 0x5d, 0x5b,
+	0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x5c, 0x70, 0x7b, 0x4e, 0x7d, 0x5f, 0x2e, 0x2d, 0x5d, 0x2a, 0x29,
+	0x3f, 0x24, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12,
+	0x25, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x32, 0xfa, 0x42, 0x2f, 0x92, 0x01, 0x2c, 0x08, 0x01, 0x10, 0x20,
+	0x22, 0x26, 0x72, 0x24, 0x10, 0x01, 0x18, 0x40, 0x32, 0x1e, 0x5e, 0x5b, 0x5c, 0x70, 0x7b, 0x4c,
+	0x7d, 0x5c, 0x70, 0x7b, 0x4e, 0x7d, 0x5d, 0x5b, 0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x5c, 0x70, 0x7b,
+	0x4e, 0x7d, 0x5f, 0x2e, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x27,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x56, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x46, 0x0a, 0x0a, 0x54, 0x61, 0x67, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x56, 0x31, 0x12, 0x19, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x43, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x56, 0x31, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x67, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x00, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x22, 0x77, 0x0a, 0x1f, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x4f, 0x66, 0x55, 0x52, 0x4c, 0x73, 0x41, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x12, 0x28, 0x0a, 0x0b, 0x75, 0x72, 0x6c, 0x73, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x75, 0x72, 0x6c, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2a, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00,
+	0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x32, 0x0a,
+	0x14, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x56, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x22, 0x4f, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55,
+	0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x34, 0x0a, 0x0e,
+	0x75, 0x72, 0x6c, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x92, 0x01, 0x08, 0x08, 0x01, 0x22, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x75, 0x72, 0x6c, 0x73, 0x54, 0x6f, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x22, 0x37, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x12, 0x1e, 0x0a, 0x06, 0x6a,
+	0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x18, 0x52,
+	0x65, 0x61, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x1e, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x56, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e,
+	0x65, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x31, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x12,
+	0x1e, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x3c,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01,
+	0x02, 0x08, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x52, 0x0a, 0x18,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x36, 0x0a, 0x0f, 0x75, 0x72, 0x6c, 0x73,
+	0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x92, 0x01, 0x08, 0x08, 0x01, 0x22, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x0d, 0x75, 0x72, 0x6c, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x22, 0x69, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x56, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x56, 0x31, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x55, 0x0a, 0x16, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x56, 0x31, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x56, 0x31, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0x71, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x73,
 	0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
 	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e,
-	0x65, 0x64, 0x22, 0x6c, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72,
-	0x74, 0x65, 0x6e, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x47, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x43,
-	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
-	0x22, 0x6b, 0x0a, 0x19, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68,
-	0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x12, 0x23, 0x0a,
-	0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x61, 0x6c, 0x12, 0x29, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
-	0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a,
-	0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64,
-	0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31,
-	0x12, 0x4a, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08,
-	0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x6e, 0x0a, 0x1a,
-	0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x72,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65,
-	0x64, 0x12, 0x29, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
-	0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x0a, 0x13,
-	0x52, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x56, 0x31, 0x12, 0x61, 0x0a, 0x17, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f,
-	0x77, 0x69, 0x74, 0x68, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65,
-	0x6e, 0x65, 0x64, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x00, 0x52,
-	0x15, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x65, 0x0a, 0x17, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x56,
-	0x31, 0x12, 0x23, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65,
-	0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x77, 0x0a,
-	0x1f, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x55, 0x52, 0x4c,
-	0x73, 0x41, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31,
-	0x12, 0x28, 0x0a, 0x0b, 0x75, 0x72, 0x6c, 0x73, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0a,
-	0x75, 0x72, 0x6c, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0c, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
-	0x31, 0x12, 0x3a, 0x0a, 0x0e, 0x75, 0x72, 0x6c, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xfa, 0x42, 0x09, 0x92, 0x01,
-	0x06, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52,
-	0x0c, 0x75, 0x72, 0x6c, 0x73, 0x54, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x32, 0xa1, 0x04,
-	0x0a, 0x09, 0x55, 0x72, 0x6c, 0x73, 0x68, 0x72, 0x74, 0x56, 0x31, 0x12, 0x4e, 0x0a, 0x0e, 0x52,
-	0x65, 0x61, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x56, 0x31, 0x12, 0x1d, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x1b, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x56, 0x31,
-	0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x56, 0x31, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x56, 0x31, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68,
-	0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x56, 0x31, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x65, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x55, 0x52,
+	0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x12, 0x25, 0x0a,
+	0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x65, 0x6e, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x39, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x69, 0x63, 0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x92, 0x01, 0x02, 0x08, 0x00, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x62,
+	0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x56, 0x31, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x1f, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x63,
+	0x6b, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x08, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x56, 0x31, 0x12,
+	0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x34, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x1b, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x18, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31,
+	0x12, 0x29, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x56, 0x31, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x4b, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x12, 0x35, 0x0a, 0x08,
+	0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x56, 0x31,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x00, 0x52, 0x07, 0x61, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x73, 0x22, 0x30, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x17, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x5b, 0x0a, 0x0b, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x56, 0x31, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x56, 0x31, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x56, 0x31, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x56, 0x31, 0x5f, 0x44, 0x45, 0x53, 0x43,
+	0x10, 0x02, 0x2a, 0xd9, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x31, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x31, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56,
+	0x31, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56,
+	0x31, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56,
+	0x31, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x20, 0x0a,
+	0x1c, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x56, 0x31, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12,
+	0x1d, 0x0a, 0x19, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x56, 0x31, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0xd8,
+	0x01, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x56, 0x31, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x31, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x31, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f,
+	0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x31, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x53, 0x54,
+	0x4f, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x31, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x53,
+	0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x31, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45,
+	0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x31, 0x5f,
+	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x32, 0xdc, 0x0b, 0x0a, 0x09, 0x55, 0x72,
+	0x6c, 0x73, 0x68, 0x72, 0x74, 0x56, 0x31, 0x12, 0x4e, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x56, 0x31, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x56, 0x31, 0x12, 0x20, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x65, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x1e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65,
+	0x6e, 0x65, 0x64, 0x56, 0x31, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x1a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x31, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x31, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x27, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f,
-	0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x56, 0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x22,
-	0x00, 0x12, 0x5f, 0x0a, 0x1a, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
-	0x66, 0x55, 0x52, 0x4c, 0x73, 0x41, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x12,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x47, 0x0a,
+	0x0e, 0x52, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x56, 0x31, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x56, 0x31, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52,
+	0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x57, 0x69, 0x74,
+	0x68, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x56, 0x31, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x45, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x73, 0x56, 0x31, 0x12, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x54, 0x61, 0x67, 0x73, 0x56, 0x31, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x31, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x54, 0x61, 0x67, 0x73, 0x56, 0x31, 0x12,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x55, 0x52, 0x4c,
-	0x73, 0x41, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31,
-	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x55, 0x52, 0x4c, 0x73, 0x56, 0x31, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x50, 0x6f, 0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63, 0x79, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x75, 0x72,
-	0x6c, 0x73, 0x68, 0x72, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31,
+	0x22, 0x00, 0x12, 0x5f, 0x0a, 0x1a, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x66, 0x55, 0x52, 0x4c, 0x73, 0x41, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x66, 0x55, 0x52,
+	0x4c, 0x73, 0x41, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56,
+	0x31, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x56, 0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x54,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73,
+	0x56, 0x31, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x31, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x56, 0x31, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x56, 0x31, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x1e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73,
+	0x56, 0x31, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x31, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x55, 0x52,
+	0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x31, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x56, 0x31, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x73, 0x56, 0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x56, 0x31, 0x22, 0x00, 0x12, 0x49, 0x0a,
+	0x0e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x56, 0x31, 0x12,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x6f, 0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63, 0x79,
+	0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x75, 0x72, 0x6c, 0x73, 0x68, 0x72, 0x74, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -749,43 +2619,116 @@ func file_urlshrt_proto_rawDescGZIP() []byte {
 	return file_urlshrt_proto_rawDescData
 }
 
-var file_urlshrt_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_urlshrt_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_urlshrt_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_urlshrt_proto_goTypes = []interface{}{
-	(*ReadOriginalRequestV1)(nil),             // 0: api.v1.ReadOriginalRequestV1
-	(*ReadOriginalReplyV1)(nil),               // 1: api.v1.ReadOriginalReplyV1
-	(*CreateShortenedRequestV1)(nil),          // 2: api.v1.CreateShortenedRequestV1
-	(*CreateShortenedReplyV1)(nil),            // 3: api.v1.CreateShortenedReplyV1
-	(*CreateShortenedFromBatchRequestV1)(nil), // 4: api.v1.CreateShortenedFromBatchRequestV1
-	(*OriginalWithCorrelationV1)(nil),         // 5: api.v1.OriginalWithCorrelationV1
-	(*CreateShortenedFromBatchReplyV1)(nil),   // 6: api.v1.CreateShortenedFromBatchReplyV1
-	(*ShortenedWithCorrelationV1)(nil),        // 7: api.v1.ShortenedWithCorrelationV1
-	(*ReadUserURLsReplyV1)(nil),               // 8: api.v1.ReadUserURLsReplyV1
-	(*OriginalWithShortenedV1)(nil),           // 9: api.v1.OriginalWithShortenedV1
-	(*ReadAmountOfURLsAndUsersReplyV1)(nil),   // 10: api.v1.ReadAmountOfURLsAndUsersReplyV1
-	(*DeleteUserURLsRequestV1)(nil),           // 11: api.v1.DeleteUserURLsRequestV1
-	(*emptypb.Empty)(nil),                     // 12: google.protobuf.Empty
+	(SortOrderV1)(0),                          // 0: api.v1.SortOrderV1
+	(DeletionStatusV1)(0),                     // 1: api.v1.DeletionStatusV1
+	(RestoreStatusV1)(0),                      // 2: api.v1.RestoreStatusV1
+	(*ReadOriginalRequestV1)(nil),             // 3: api.v1.ReadOriginalRequestV1
+	(*ReadOriginalReplyV1)(nil),               // 4: api.v1.ReadOriginalReplyV1
+	(*CreateShortenedRequestV1)(nil),          // 5: api.v1.CreateShortenedRequestV1
+	(*CreateShortenedReplyV1)(nil),            // 6: api.v1.CreateShortenedReplyV1
+	(*UpdateShortenedRequestV1)(nil),          // 7: api.v1.UpdateShortenedRequestV1
+	(*UpdateShortenedReplyV1)(nil),            // 8: api.v1.UpdateShortenedReplyV1
+	(*CreateShortenedFromBatchRequestV1)(nil), // 9: api.v1.CreateShortenedFromBatchRequestV1
+	(*OriginalWithCorrelationV1)(nil),         // 10: api.v1.OriginalWithCorrelationV1
+	(*CreateShortenedFromBatchReplyV1)(nil),   // 11: api.v1.CreateShortenedFromBatchReplyV1
+	(*ShortenedWithCorrelationV1)(nil),        // 12: api.v1.ShortenedWithCorrelationV1
+	(*ReadUserURLsReplyV1)(nil),               // 13: api.v1.ReadUserURLsReplyV1
+	(*OriginalWithShortenedV1)(nil),           // 14: api.v1.OriginalWithShortenedV1
+	(*StreamUserURLsRequestV1)(nil),           // 15: api.v1.StreamUserURLsRequestV1
+	(*UpdateTagsRequestV1)(nil),               // 16: api.v1.UpdateTagsRequestV1
+	(*UpdateTagsReplyV1)(nil),                 // 17: api.v1.UpdateTagsReplyV1
+	(*TagCountV1)(nil),                        // 18: api.v1.TagCountV1
+	(*ReadTagsReplyV1)(nil),                   // 19: api.v1.ReadTagsReplyV1
+	(*ReadAmountOfURLsAndUsersReplyV1)(nil),   // 20: api.v1.ReadAmountOfURLsAndUsersReplyV1
+	(*EnforcePolicyReplyV1)(nil),              // 21: api.v1.EnforcePolicyReplyV1
+	(*DeleteUserURLsRequestV1)(nil),           // 22: api.v1.DeleteUserURLsRequestV1
+	(*DeleteUserURLsReplyV1)(nil),             // 23: api.v1.DeleteUserURLsReplyV1
+	(*ReadDeletionJobRequestV1)(nil),          // 24: api.v1.ReadDeletionJobRequestV1
+	(*DeletionResultV1)(nil),                  // 25: api.v1.DeletionResultV1
+	(*ReadDeletionJobReplyV1)(nil),            // 26: api.v1.ReadDeletionJobReplyV1
+	(*RestoreUserURLsRequestV1)(nil),          // 27: api.v1.RestoreUserURLsRequestV1
+	(*RestoreResultV1)(nil),                   // 28: api.v1.RestoreResultV1
+	(*RestoreUserURLsReplyV1)(nil),            // 29: api.v1.RestoreUserURLsReplyV1
+	(*ReadURLStatsRequestV1)(nil),             // 30: api.v1.ReadURLStatsRequestV1
+	(*ReadURLStatsReplyV1)(nil),               // 31: api.v1.ReadURLStatsReplyV1
+	(*ClickBucketV1)(nil),                     // 32: api.v1.ClickBucketV1
+	(*APIKeyV1)(nil),                          // 33: api.v1.APIKeyV1
+	(*CreateAPIKeyRequestV1)(nil),             // 34: api.v1.CreateAPIKeyRequestV1
+	(*CreateAPIKeyReplyV1)(nil),               // 35: api.v1.CreateAPIKeyReplyV1
+	(*ReadAPIKeysReplyV1)(nil),                // 36: api.v1.ReadAPIKeysReplyV1
+	(*RevokeAPIKeyRequestV1)(nil),             // 37: api.v1.RevokeAPIKeyRequestV1
+	(*timestamppb.Timestamp)(nil),             // 38: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),               // 39: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                     // 40: google.protobuf.Empty
 }
 var file_urlshrt_proto_depIdxs = []int32{
-	5,  // 0: api.v1.CreateShortenedFromBatchRequestV1.original:type_name -> api.v1.OriginalWithCorrelationV1
-	7,  // 1: api.v1.CreateShortenedFromBatchReplyV1.shortened:type_name -> api.v1.ShortenedWithCorrelationV1
-	9,  // 2: api.v1.ReadUserURLsReplyV1.original_with_shortened:type_name -> api.v1.OriginalWithShortenedV1
-	0,  // 3: api.v1.UrlshrtV1.ReadOriginalV1:input_type -> api.v1.ReadOriginalRequestV1
-	2,  // 4: api.v1.UrlshrtV1.CreateShortenedV1:input_type -> api.v1.CreateShortenedRequestV1
-	4,  // 5: api.v1.UrlshrtV1.CreateShortenedFromBatchV1:input_type -> api.v1.CreateShortenedFromBatchRequestV1
-	12, // 6: api.v1.UrlshrtV1.ReadUserURLsV1:input_type -> google.protobuf.Empty
-	12, // 7: api.v1.UrlshrtV1.ReadAmountOfURLsAndUsersV1:input_type -> google.protobuf.Empty
-	11, // 8: api.v1.UrlshrtV1.DeleteUserURLsV1:input_type -> api.v1.DeleteUserURLsRequestV1
-	1,  // 9: api.v1.UrlshrtV1.ReadOriginalV1:output_type -> api.v1.ReadOriginalReplyV1
-	3,  // 10: api.v1.UrlshrtV1.CreateShortenedV1:output_type -> api.v1.CreateShortenedReplyV1
-	6,  // 11: api.v1.UrlshrtV1.CreateShortenedFromBatchV1:output_type -> api.v1.CreateShortenedFromBatchReplyV1
-	8,  // 12: api.v1.UrlshrtV1.ReadUserURLsV1:output_type -> api.v1.ReadUserURLsReplyV1
-	10, // 13: api.v1.UrlshrtV1.ReadAmountOfURLsAndUsersV1:output_type -> api.v1.ReadAmountOfURLsAndUsersReplyV1
-	12, // 14: api.v1.UrlshrtV1.DeleteUserURLsV1:output_type -> google.protobuf.Empty
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	38, // 0: api.v1.CreateShortenedRequestV1.expires_at:type_name -> google.protobuf.Timestamp
+	38, // 1: api.v1.CreateShortenedRequestV1.not_before:type_name -> google.protobuf.Timestamp
+	10, // 2: api.v1.CreateShortenedFromBatchRequestV1.original:type_name -> api.v1.OriginalWithCorrelationV1
+	38, // 3: api.v1.OriginalWithCorrelationV1.expires_at:type_name -> google.protobuf.Timestamp
+	38, // 4: api.v1.OriginalWithCorrelationV1.not_before:type_name -> google.protobuf.Timestamp
+	12, // 5: api.v1.CreateShortenedFromBatchReplyV1.shortened:type_name -> api.v1.ShortenedWithCorrelationV1
+	14, // 6: api.v1.ReadUserURLsReplyV1.original_with_shortened:type_name -> api.v1.OriginalWithShortenedV1
+	38, // 7: api.v1.OriginalWithShortenedV1.created_at:type_name -> google.protobuf.Timestamp
+	38, // 8: api.v1.OriginalWithShortenedV1.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: api.v1.StreamUserURLsRequestV1.order:type_name -> api.v1.SortOrderV1
+	18, // 10: api.v1.ReadTagsReplyV1.tags:type_name -> api.v1.TagCountV1
+	1,  // 11: api.v1.DeletionResultV1.status:type_name -> api.v1.DeletionStatusV1
+	38, // 12: api.v1.ReadDeletionJobReplyV1.created_at:type_name -> google.protobuf.Timestamp
+	25, // 13: api.v1.ReadDeletionJobReplyV1.results:type_name -> api.v1.DeletionResultV1
+	2,  // 14: api.v1.RestoreResultV1.status:type_name -> api.v1.RestoreStatusV1
+	28, // 15: api.v1.RestoreUserURLsReplyV1.results:type_name -> api.v1.RestoreResultV1
+	39, // 16: api.v1.ReadURLStatsRequestV1.bucket:type_name -> google.protobuf.Duration
+	32, // 17: api.v1.ReadURLStatsReplyV1.buckets:type_name -> api.v1.ClickBucketV1
+	38, // 18: api.v1.ClickBucketV1.start:type_name -> google.protobuf.Timestamp
+	38, // 19: api.v1.APIKeyV1.created_at:type_name -> google.protobuf.Timestamp
+	38, // 20: api.v1.APIKeyV1.revoked_at:type_name -> google.protobuf.Timestamp
+	33, // 21: api.v1.CreateAPIKeyReplyV1.api_key:type_name -> api.v1.APIKeyV1
+	33, // 22: api.v1.ReadAPIKeysReplyV1.api_keys:type_name -> api.v1.APIKeyV1
+	3,  // 23: api.v1.UrlshrtV1.ReadOriginalV1:input_type -> api.v1.ReadOriginalRequestV1
+	5,  // 24: api.v1.UrlshrtV1.CreateShortenedV1:input_type -> api.v1.CreateShortenedRequestV1
+	7,  // 25: api.v1.UrlshrtV1.UpdateShortenedV1:input_type -> api.v1.UpdateShortenedRequestV1
+	9,  // 26: api.v1.UrlshrtV1.CreateShortenedFromBatchV1:input_type -> api.v1.CreateShortenedFromBatchRequestV1
+	40, // 27: api.v1.UrlshrtV1.ReadUserURLsV1:input_type -> google.protobuf.Empty
+	15, // 28: api.v1.UrlshrtV1.StreamUserURLsV1:input_type -> api.v1.StreamUserURLsRequestV1
+	16, // 29: api.v1.UrlshrtV1.AddTagsV1:input_type -> api.v1.UpdateTagsRequestV1
+	16, // 30: api.v1.UrlshrtV1.RemoveTagsV1:input_type -> api.v1.UpdateTagsRequestV1
+	40, // 31: api.v1.UrlshrtV1.ReadTagsV1:input_type -> google.protobuf.Empty
+	40, // 32: api.v1.UrlshrtV1.ReadAmountOfURLsAndUsersV1:input_type -> google.protobuf.Empty
+	40, // 33: api.v1.UrlshrtV1.EnforcePolicyV1:input_type -> google.protobuf.Empty
+	22, // 34: api.v1.UrlshrtV1.DeleteUserURLsV1:input_type -> api.v1.DeleteUserURLsRequestV1
+	24, // 35: api.v1.UrlshrtV1.ReadDeletionJobV1:input_type -> api.v1.ReadDeletionJobRequestV1
+	27, // 36: api.v1.UrlshrtV1.RestoreUserURLsV1:input_type -> api.v1.RestoreUserURLsRequestV1
+	30, // 37: api.v1.UrlshrtV1.ReadURLStatsV1:input_type -> api.v1.ReadURLStatsRequestV1
+	34, // 38: api.v1.UrlshrtV1.CreateAPIKeyV1:input_type -> api.v1.CreateAPIKeyRequestV1
+	40, // 39: api.v1.UrlshrtV1.ReadAPIKeysV1:input_type -> google.protobuf.Empty
+	37, // 40: api.v1.UrlshrtV1.RevokeAPIKeyV1:input_type -> api.v1.RevokeAPIKeyRequestV1
+	4,  // 41: api.v1.UrlshrtV1.ReadOriginalV1:output_type -> api.v1.ReadOriginalReplyV1
+	6,  // 42: api.v1.UrlshrtV1.CreateShortenedV1:output_type -> api.v1.CreateShortenedReplyV1
+	8,  // 43: api.v1.UrlshrtV1.UpdateShortenedV1:output_type -> api.v1.UpdateShortenedReplyV1
+	11, // 44: api.v1.UrlshrtV1.CreateShortenedFromBatchV1:output_type -> api.v1.CreateShortenedFromBatchReplyV1
+	13, // 45: api.v1.UrlshrtV1.ReadUserURLsV1:output_type -> api.v1.ReadUserURLsReplyV1
+	14, // 46: api.v1.UrlshrtV1.StreamUserURLsV1:output_type -> api.v1.OriginalWithShortenedV1
+	17, // 47: api.v1.UrlshrtV1.AddTagsV1:output_type -> api.v1.UpdateTagsReplyV1
+	17, // 48: api.v1.UrlshrtV1.RemoveTagsV1:output_type -> api.v1.UpdateTagsReplyV1
+	19, // 49: api.v1.UrlshrtV1.ReadTagsV1:output_type -> api.v1.ReadTagsReplyV1
+	20, // 50: api.v1.UrlshrtV1.ReadAmountOfURLsAndUsersV1:output_type -> api.v1.ReadAmountOfURLsAndUsersReplyV1
+	21, // 51: api.v1.UrlshrtV1.EnforcePolicyV1:output_type -> api.v1.EnforcePolicyReplyV1
+	23, // 52: api.v1.UrlshrtV1.DeleteUserURLsV1:output_type -> api.v1.DeleteUserURLsReplyV1
+	26, // 53: api.v1.UrlshrtV1.ReadDeletionJobV1:output_type -> api.v1.ReadDeletionJobReplyV1
+	29, // 54: api.v1.UrlshrtV1.RestoreUserURLsV1:output_type -> api.v1.RestoreUserURLsReplyV1
+	31, // 55: api.v1.UrlshrtV1.ReadURLStatsV1:output_type -> api.v1.ReadURLStatsReplyV1
+	35, // 56: api.v1.UrlshrtV1.CreateAPIKeyV1:output_type -> api.v1.CreateAPIKeyReplyV1
+	36, // 57: api.v1.UrlshrtV1.ReadAPIKeysV1:output_type -> api.v1.ReadAPIKeysReplyV1
+	40, // 58: api.v1.UrlshrtV1.RevokeAPIKeyV1:output_type -> google.protobuf.Empty
+	41, // [41:59] is the sub-list for method output_type
+	23, // [23:41] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_urlshrt_proto_init() }
@@ -843,7 +2786,7 @@ func file_urlshrt_proto_init() {
 			}
 		}
 		file_urlshrt_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateShortenedFromBatchRequestV1); i {
+			switch v := v.(*UpdateShortenedRequestV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -855,7 +2798,7 @@ func file_urlshrt_proto_init() {
 			}
 		}
 		file_urlshrt_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OriginalWithCorrelationV1); i {
+			switch v := v.(*UpdateShortenedReplyV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -867,7 +2810,7 @@ func file_urlshrt_proto_init() {
 			}
 		}
 		file_urlshrt_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateShortenedFromBatchReplyV1); i {
+			switch v := v.(*CreateShortenedFromBatchRequestV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -879,7 +2822,7 @@ func file_urlshrt_proto_init() {
 			}
 		}
 		file_urlshrt_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortenedWithCorrelationV1); i {
+			switch v := v.(*OriginalWithCorrelationV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -891,7 +2834,7 @@ func file_urlshrt_proto_init() {
 			}
 		}
 		file_urlshrt_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadUserURLsReplyV1); i {
+			switch v := v.(*CreateShortenedFromBatchReplyV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -903,7 +2846,7 @@ func file_urlshrt_proto_init() {
 			}
 		}
 		file_urlshrt_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OriginalWithShortenedV1); i {
+			switch v := v.(*ShortenedWithCorrelationV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -915,7 +2858,7 @@ func file_urlshrt_proto_init() {
 			}
 		}
 		file_urlshrt_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAmountOfURLsAndUsersReplyV1); i {
+			switch v := v.(*ReadUserURLsReplyV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -927,6 +2870,102 @@ func file_urlshrt_proto_init() {
 			}
 		}
 		file_urlshrt_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OriginalWithShortenedV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamUserURLsRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTagsRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTagsReplyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagCountV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadTagsReplyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAmountOfURLsAndUsersReplyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnforcePolicyReplyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteUserURLsRequestV1); i {
 			case 0:
 				return &v.state
@@ -938,19 +2977,200 @@ func file_urlshrt_proto_init() {
 				return nil
 			}
 		}
+		file_urlshrt_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserURLsReplyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDeletionJobRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletionResultV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDeletionJobReplyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreUserURLsRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreResultV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreUserURLsReplyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadURLStatsRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadURLStatsReplyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClickBucketV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKeyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyReplyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAPIKeysReplyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_urlshrt_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAPIKeyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_urlshrt_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      3,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_urlshrt_proto_goTypes,
 		DependencyIndexes: file_urlshrt_proto_depIdxs,
+		EnumInfos:         file_urlshrt_proto_enumTypes,
 		MessageInfos:      file_urlshrt_proto_msgTypes,
 	}.Build()
 	File_urlshrt_proto = out.File
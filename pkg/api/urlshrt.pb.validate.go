@@ -294,6 +294,146 @@ func (m *CreateShortenedRequestV1) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetAlias()) > 64 {
+		err := CreateShortenedRequestV1ValidationError{
+			field:  "Alias",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateShortenedRequestV1_Alias_Pattern.MatchString(m.GetAlias()) {
+		err := CreateShortenedRequestV1ValidationError{
+			field:  "Alias",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateShortenedRequestV1ValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateShortenedRequestV1ValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateShortenedRequestV1ValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNotBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateShortenedRequestV1ValidationError{
+					field:  "NotBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateShortenedRequestV1ValidationError{
+					field:  "NotBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateShortenedRequestV1ValidationError{
+				field:  "NotBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) > 256 {
+		err := CreateShortenedRequestV1ValidationError{
+			field:  "Title",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 2048 {
+		err := CreateShortenedRequestV1ValidationError{
+			field:  "Note",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 32 {
+		err := CreateShortenedRequestV1ValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 32 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := CreateShortenedRequestV1ValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_CreateShortenedRequestV1_Tags_Pattern.MatchString(item) {
+			err := CreateShortenedRequestV1ValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value does not match regex pattern \"^[\\\\p{L}\\\\p{N}][\\\\p{L}\\\\p{N}_.-]*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateShortenedRequestV1MultiError(errors)
 	}
@@ -374,6 +514,10 @@ var _ interface {
 	ErrorName() string
 } = CreateShortenedRequestV1ValidationError{}
 
+var _CreateShortenedRequestV1_Alias_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]*$")
+
+var _CreateShortenedRequestV1_Tags_Pattern = regexp.MustCompile("^[\\p{L}\\p{N}][\\p{L}\\p{N}_.-]*$")
+
 // Validate checks the field values on CreateShortenedReplyV1 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -487,33 +631,32 @@ var _ interface {
 	ErrorName() string
 } = CreateShortenedReplyV1ValidationError{}
 
-// Validate checks the field values on CreateShortenedFromBatchRequestV1 with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *CreateShortenedFromBatchRequestV1) Validate() error {
+// Validate checks the field values on UpdateShortenedRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateShortenedRequestV1) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateShortenedFromBatchRequestV1
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CreateShortenedFromBatchRequestV1MultiError, or nil if none found.
-func (m *CreateShortenedFromBatchRequestV1) ValidateAll() error {
+// ValidateAll checks the field values on UpdateShortenedRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateShortenedRequestV1MultiError, or nil if none found.
+func (m *UpdateShortenedRequestV1) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateShortenedFromBatchRequestV1) validate(all bool) error {
+func (m *UpdateShortenedRequestV1) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetOriginal()) < 1 {
-		err := CreateShortenedFromBatchRequestV1ValidationError{
-			field:  "Original",
-			reason: "value must contain at least 1 item(s)",
+	if utf8.RuneCountInString(m.GetShortened()) < 1 {
+		err := UpdateShortenedRequestV1ValidationError{
+			field:  "Shortened",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -521,55 +664,31 @@ func (m *CreateShortenedFromBatchRequestV1) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetOriginal() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateShortenedFromBatchRequestV1ValidationError{
-						field:  fmt.Sprintf("Original[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateShortenedFromBatchRequestV1ValidationError{
-						field:  fmt.Sprintf("Original[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateShortenedFromBatchRequestV1ValidationError{
-					field:  fmt.Sprintf("Original[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetOriginal()) < 1 {
+		err := UpdateShortenedRequestV1ValidationError{
+			field:  "Original",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateShortenedFromBatchRequestV1MultiError(errors)
+		return UpdateShortenedRequestV1MultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateShortenedFromBatchRequestV1MultiError is an error wrapping multiple
-// validation errors returned by
-// CreateShortenedFromBatchRequestV1.ValidateAll() if the designated
+// UpdateShortenedRequestV1MultiError is an error wrapping multiple validation
+// errors returned by UpdateShortenedRequestV1.ValidateAll() if the designated
 // constraints aren't met.
-type CreateShortenedFromBatchRequestV1MultiError []error
+type UpdateShortenedRequestV1MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateShortenedFromBatchRequestV1MultiError) Error() string {
+func (m UpdateShortenedRequestV1MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -578,12 +697,11 @@ func (m CreateShortenedFromBatchRequestV1MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateShortenedFromBatchRequestV1MultiError) AllErrors() []error { return m }
+func (m UpdateShortenedRequestV1MultiError) AllErrors() []error { return m }
 
-// CreateShortenedFromBatchRequestV1ValidationError is the validation error
-// returned by CreateShortenedFromBatchRequestV1.Validate if the designated
-// constraints aren't met.
-type CreateShortenedFromBatchRequestV1ValidationError struct {
+// UpdateShortenedRequestV1ValidationError is the validation error returned by
+// UpdateShortenedRequestV1.Validate if the designated constraints aren't met.
+type UpdateShortenedRequestV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -591,24 +709,24 @@ type CreateShortenedFromBatchRequestV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateShortenedFromBatchRequestV1ValidationError) Field() string { return e.field }
+func (e UpdateShortenedRequestV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateShortenedFromBatchRequestV1ValidationError) Reason() string { return e.reason }
+func (e UpdateShortenedRequestV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateShortenedFromBatchRequestV1ValidationError) Cause() error { return e.cause }
+func (e UpdateShortenedRequestV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateShortenedFromBatchRequestV1ValidationError) Key() bool { return e.key }
+func (e UpdateShortenedRequestV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateShortenedFromBatchRequestV1ValidationError) ErrorName() string {
-	return "CreateShortenedFromBatchRequestV1ValidationError"
+func (e UpdateShortenedRequestV1ValidationError) ErrorName() string {
+	return "UpdateShortenedRequestV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateShortenedFromBatchRequestV1ValidationError) Error() string {
+func (e UpdateShortenedRequestV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -620,14 +738,14 @@ func (e CreateShortenedFromBatchRequestV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateShortenedFromBatchRequestV1.%s: %s%s",
+		"invalid %sUpdateShortenedRequestV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateShortenedFromBatchRequestV1ValidationError{}
+var _ error = UpdateShortenedRequestV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -635,44 +753,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateShortenedFromBatchRequestV1ValidationError{}
+} = UpdateShortenedRequestV1ValidationError{}
 
-// Validate checks the field values on OriginalWithCorrelationV1 with the rules
+// Validate checks the field values on UpdateShortenedReplyV1 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OriginalWithCorrelationV1) Validate() error {
+func (m *UpdateShortenedReplyV1) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OriginalWithCorrelationV1 with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateShortenedReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OriginalWithCorrelationV1MultiError, or nil if none found.
-func (m *OriginalWithCorrelationV1) ValidateAll() error {
+// UpdateShortenedReplyV1MultiError, or nil if none found.
+func (m *UpdateShortenedReplyV1) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OriginalWithCorrelationV1) validate(all bool) error {
+func (m *UpdateShortenedReplyV1) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOriginal()) < 1 {
-		err := OriginalWithCorrelationV1ValidationError{
-			field:  "Original",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetCorrelation()) < 1 {
-		err := OriginalWithCorrelationV1ValidationError{
-			field:  "Correlation",
+	if utf8.RuneCountInString(m.GetShortened()) < 1 {
+		err := UpdateShortenedReplyV1ValidationError{
+			field:  "Shortened",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -682,19 +789,19 @@ func (m *OriginalWithCorrelationV1) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return OriginalWithCorrelationV1MultiError(errors)
+		return UpdateShortenedReplyV1MultiError(errors)
 	}
 
 	return nil
 }
 
-// OriginalWithCorrelationV1MultiError is an error wrapping multiple validation
-// errors returned by OriginalWithCorrelationV1.ValidateAll() if the
-// designated constraints aren't met.
-type OriginalWithCorrelationV1MultiError []error
+// UpdateShortenedReplyV1MultiError is an error wrapping multiple validation
+// errors returned by UpdateShortenedReplyV1.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateShortenedReplyV1MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OriginalWithCorrelationV1MultiError) Error() string {
+func (m UpdateShortenedReplyV1MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -703,11 +810,11 @@ func (m OriginalWithCorrelationV1MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OriginalWithCorrelationV1MultiError) AllErrors() []error { return m }
+func (m UpdateShortenedReplyV1MultiError) AllErrors() []error { return m }
 
-// OriginalWithCorrelationV1ValidationError is the validation error returned by
-// OriginalWithCorrelationV1.Validate if the designated constraints aren't met.
-type OriginalWithCorrelationV1ValidationError struct {
+// UpdateShortenedReplyV1ValidationError is the validation error returned by
+// UpdateShortenedReplyV1.Validate if the designated constraints aren't met.
+type UpdateShortenedReplyV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -715,24 +822,24 @@ type OriginalWithCorrelationV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e OriginalWithCorrelationV1ValidationError) Field() string { return e.field }
+func (e UpdateShortenedReplyV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OriginalWithCorrelationV1ValidationError) Reason() string { return e.reason }
+func (e UpdateShortenedReplyV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OriginalWithCorrelationV1ValidationError) Cause() error { return e.cause }
+func (e UpdateShortenedReplyV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OriginalWithCorrelationV1ValidationError) Key() bool { return e.key }
+func (e UpdateShortenedReplyV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OriginalWithCorrelationV1ValidationError) ErrorName() string {
-	return "OriginalWithCorrelationV1ValidationError"
+func (e UpdateShortenedReplyV1ValidationError) ErrorName() string {
+	return "UpdateShortenedReplyV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e OriginalWithCorrelationV1ValidationError) Error() string {
+func (e UpdateShortenedReplyV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -744,14 +851,14 @@ func (e OriginalWithCorrelationV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOriginalWithCorrelationV1.%s: %s%s",
+		"invalid %sUpdateShortenedReplyV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OriginalWithCorrelationV1ValidationError{}
+var _ error = UpdateShortenedReplyV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -759,33 +866,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OriginalWithCorrelationV1ValidationError{}
+} = UpdateShortenedReplyV1ValidationError{}
 
-// Validate checks the field values on CreateShortenedFromBatchReplyV1 with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateShortenedFromBatchReplyV1) Validate() error {
+// Validate checks the field values on CreateShortenedFromBatchRequestV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateShortenedFromBatchRequestV1) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateShortenedFromBatchReplyV1 with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateShortenedFromBatchReplyV1MultiError, or nil if none found.
-func (m *CreateShortenedFromBatchReplyV1) ValidateAll() error {
+// ValidateAll checks the field values on CreateShortenedFromBatchRequestV1
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateShortenedFromBatchRequestV1MultiError, or nil if none found.
+func (m *CreateShortenedFromBatchRequestV1) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateShortenedFromBatchReplyV1) validate(all bool) error {
+func (m *CreateShortenedFromBatchRequestV1) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetShortened()) < 1 {
-		err := CreateShortenedFromBatchReplyV1ValidationError{
-			field:  "Shortened",
+	if len(m.GetOriginal()) < 1 {
+		err := CreateShortenedFromBatchRequestV1ValidationError{
+			field:  "Original",
 			reason: "value must contain at least 1 item(s)",
 		}
 		if !all {
@@ -794,23 +902,23 @@ func (m *CreateShortenedFromBatchReplyV1) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetShortened() {
+	for idx, item := range m.GetOriginal() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateShortenedFromBatchReplyV1ValidationError{
-						field:  fmt.Sprintf("Shortened[%v]", idx),
+					errors = append(errors, CreateShortenedFromBatchRequestV1ValidationError{
+						field:  fmt.Sprintf("Original[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateShortenedFromBatchReplyV1ValidationError{
-						field:  fmt.Sprintf("Shortened[%v]", idx),
+					errors = append(errors, CreateShortenedFromBatchRequestV1ValidationError{
+						field:  fmt.Sprintf("Original[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -818,8 +926,8 @@ func (m *CreateShortenedFromBatchReplyV1) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateShortenedFromBatchReplyV1ValidationError{
-					field:  fmt.Sprintf("Shortened[%v]", idx),
+				return CreateShortenedFromBatchRequestV1ValidationError{
+					field:  fmt.Sprintf("Original[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -829,19 +937,20 @@ func (m *CreateShortenedFromBatchReplyV1) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateShortenedFromBatchReplyV1MultiError(errors)
+		return CreateShortenedFromBatchRequestV1MultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateShortenedFromBatchReplyV1MultiError is an error wrapping multiple
-// validation errors returned by CreateShortenedFromBatchReplyV1.ValidateAll()
-// if the designated constraints aren't met.
-type CreateShortenedFromBatchReplyV1MultiError []error
+// CreateShortenedFromBatchRequestV1MultiError is an error wrapping multiple
+// validation errors returned by
+// CreateShortenedFromBatchRequestV1.ValidateAll() if the designated
+// constraints aren't met.
+type CreateShortenedFromBatchRequestV1MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateShortenedFromBatchReplyV1MultiError) Error() string {
+func (m CreateShortenedFromBatchRequestV1MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -850,12 +959,12 @@ func (m CreateShortenedFromBatchReplyV1MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateShortenedFromBatchReplyV1MultiError) AllErrors() []error { return m }
+func (m CreateShortenedFromBatchRequestV1MultiError) AllErrors() []error { return m }
 
-// CreateShortenedFromBatchReplyV1ValidationError is the validation error
-// returned by CreateShortenedFromBatchReplyV1.Validate if the designated
+// CreateShortenedFromBatchRequestV1ValidationError is the validation error
+// returned by CreateShortenedFromBatchRequestV1.Validate if the designated
 // constraints aren't met.
-type CreateShortenedFromBatchReplyV1ValidationError struct {
+type CreateShortenedFromBatchRequestV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -863,24 +972,24 @@ type CreateShortenedFromBatchReplyV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateShortenedFromBatchReplyV1ValidationError) Field() string { return e.field }
+func (e CreateShortenedFromBatchRequestV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateShortenedFromBatchReplyV1ValidationError) Reason() string { return e.reason }
+func (e CreateShortenedFromBatchRequestV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateShortenedFromBatchReplyV1ValidationError) Cause() error { return e.cause }
+func (e CreateShortenedFromBatchRequestV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateShortenedFromBatchReplyV1ValidationError) Key() bool { return e.key }
+func (e CreateShortenedFromBatchRequestV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateShortenedFromBatchReplyV1ValidationError) ErrorName() string {
-	return "CreateShortenedFromBatchReplyV1ValidationError"
+func (e CreateShortenedFromBatchRequestV1ValidationError) ErrorName() string {
+	return "CreateShortenedFromBatchRequestV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateShortenedFromBatchReplyV1ValidationError) Error() string {
+func (e CreateShortenedFromBatchRequestV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -892,14 +1001,14 @@ func (e CreateShortenedFromBatchReplyV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateShortenedFromBatchReplyV1.%s: %s%s",
+		"invalid %sCreateShortenedFromBatchRequestV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateShortenedFromBatchReplyV1ValidationError{}
+var _ error = CreateShortenedFromBatchRequestV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -907,33 +1016,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateShortenedFromBatchReplyV1ValidationError{}
+} = CreateShortenedFromBatchRequestV1ValidationError{}
 
-// Validate checks the field values on ShortenedWithCorrelationV1 with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on OriginalWithCorrelationV1 with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ShortenedWithCorrelationV1) Validate() error {
+func (m *OriginalWithCorrelationV1) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ShortenedWithCorrelationV1 with the
+// ValidateAll checks the field values on OriginalWithCorrelationV1 with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ShortenedWithCorrelationV1MultiError, or nil if none found.
-func (m *ShortenedWithCorrelationV1) ValidateAll() error {
+// OriginalWithCorrelationV1MultiError, or nil if none found.
+func (m *OriginalWithCorrelationV1) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ShortenedWithCorrelationV1) validate(all bool) error {
+func (m *OriginalWithCorrelationV1) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetShortened()) < 1 {
-		err := ShortenedWithCorrelationV1ValidationError{
-			field:  "Shortened",
+	if utf8.RuneCountInString(m.GetOriginal()) < 1 {
+		err := OriginalWithCorrelationV1ValidationError{
+			field:  "Original",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -943,30 +1052,3304 @@ func (m *ShortenedWithCorrelationV1) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetCorrelation()) < 1 {
-		err := ShortenedWithCorrelationV1ValidationError{
+		err := OriginalWithCorrelationV1ValidationError{
 			field:  "Correlation",
 			reason: "value length must be at least 1 runes",
 		}
-		if !all {
-			return err
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAlias()) > 64 {
+		err := OriginalWithCorrelationV1ValidationError{
+			field:  "Alias",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_OriginalWithCorrelationV1_Alias_Pattern.MatchString(m.GetAlias()) {
+		err := OriginalWithCorrelationV1ValidationError{
+			field:  "Alias",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OriginalWithCorrelationV1ValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OriginalWithCorrelationV1ValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OriginalWithCorrelationV1ValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNotBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OriginalWithCorrelationV1ValidationError{
+					field:  "NotBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OriginalWithCorrelationV1ValidationError{
+					field:  "NotBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OriginalWithCorrelationV1ValidationError{
+				field:  "NotBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) > 256 {
+		err := OriginalWithCorrelationV1ValidationError{
+			field:  "Title",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 2048 {
+		err := OriginalWithCorrelationV1ValidationError{
+			field:  "Note",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 32 {
+		err := OriginalWithCorrelationV1ValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 32 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := OriginalWithCorrelationV1ValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_OriginalWithCorrelationV1_Tags_Pattern.MatchString(item) {
+			err := OriginalWithCorrelationV1ValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value does not match regex pattern \"^[\\\\p{L}\\\\p{N}][\\\\p{L}\\\\p{N}_.-]*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OriginalWithCorrelationV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// OriginalWithCorrelationV1MultiError is an error wrapping multiple validation
+// errors returned by OriginalWithCorrelationV1.ValidateAll() if the
+// designated constraints aren't met.
+type OriginalWithCorrelationV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OriginalWithCorrelationV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OriginalWithCorrelationV1MultiError) AllErrors() []error { return m }
+
+// OriginalWithCorrelationV1ValidationError is the validation error returned by
+// OriginalWithCorrelationV1.Validate if the designated constraints aren't met.
+type OriginalWithCorrelationV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OriginalWithCorrelationV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OriginalWithCorrelationV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OriginalWithCorrelationV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OriginalWithCorrelationV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OriginalWithCorrelationV1ValidationError) ErrorName() string {
+	return "OriginalWithCorrelationV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OriginalWithCorrelationV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOriginalWithCorrelationV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OriginalWithCorrelationV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OriginalWithCorrelationV1ValidationError{}
+
+var _OriginalWithCorrelationV1_Alias_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]*$")
+
+var _OriginalWithCorrelationV1_Tags_Pattern = regexp.MustCompile("^[\\p{L}\\p{N}][\\p{L}\\p{N}_.-]*$")
+
+// Validate checks the field values on CreateShortenedFromBatchReplyV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateShortenedFromBatchReplyV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateShortenedFromBatchReplyV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateShortenedFromBatchReplyV1MultiError, or nil if none found.
+func (m *CreateShortenedFromBatchReplyV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateShortenedFromBatchReplyV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetShortened()) < 1 {
+		err := CreateShortenedFromBatchReplyV1ValidationError{
+			field:  "Shortened",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetShortened() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateShortenedFromBatchReplyV1ValidationError{
+						field:  fmt.Sprintf("Shortened[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateShortenedFromBatchReplyV1ValidationError{
+						field:  fmt.Sprintf("Shortened[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateShortenedFromBatchReplyV1ValidationError{
+					field:  fmt.Sprintf("Shortened[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateShortenedFromBatchReplyV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateShortenedFromBatchReplyV1MultiError is an error wrapping multiple
+// validation errors returned by CreateShortenedFromBatchReplyV1.ValidateAll()
+// if the designated constraints aren't met.
+type CreateShortenedFromBatchReplyV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateShortenedFromBatchReplyV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateShortenedFromBatchReplyV1MultiError) AllErrors() []error { return m }
+
+// CreateShortenedFromBatchReplyV1ValidationError is the validation error
+// returned by CreateShortenedFromBatchReplyV1.Validate if the designated
+// constraints aren't met.
+type CreateShortenedFromBatchReplyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateShortenedFromBatchReplyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateShortenedFromBatchReplyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateShortenedFromBatchReplyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateShortenedFromBatchReplyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateShortenedFromBatchReplyV1ValidationError) ErrorName() string {
+	return "CreateShortenedFromBatchReplyV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateShortenedFromBatchReplyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateShortenedFromBatchReplyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateShortenedFromBatchReplyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateShortenedFromBatchReplyV1ValidationError{}
+
+// Validate checks the field values on ShortenedWithCorrelationV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShortenedWithCorrelationV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShortenedWithCorrelationV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShortenedWithCorrelationV1MultiError, or nil if none found.
+func (m *ShortenedWithCorrelationV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShortenedWithCorrelationV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortened()) < 1 {
+		err := ShortenedWithCorrelationV1ValidationError{
+			field:  "Shortened",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCorrelation()) < 1 {
+		err := ShortenedWithCorrelationV1ValidationError{
+			field:  "Correlation",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ShortenedWithCorrelationV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// ShortenedWithCorrelationV1MultiError is an error wrapping multiple
+// validation errors returned by ShortenedWithCorrelationV1.ValidateAll() if
+// the designated constraints aren't met.
+type ShortenedWithCorrelationV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShortenedWithCorrelationV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShortenedWithCorrelationV1MultiError) AllErrors() []error { return m }
+
+// ShortenedWithCorrelationV1ValidationError is the validation error returned
+// by ShortenedWithCorrelationV1.Validate if the designated constraints aren't met.
+type ShortenedWithCorrelationV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShortenedWithCorrelationV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShortenedWithCorrelationV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShortenedWithCorrelationV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShortenedWithCorrelationV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShortenedWithCorrelationV1ValidationError) ErrorName() string {
+	return "ShortenedWithCorrelationV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShortenedWithCorrelationV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShortenedWithCorrelationV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShortenedWithCorrelationV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShortenedWithCorrelationV1ValidationError{}
+
+// Validate checks the field values on ReadUserURLsReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadUserURLsReplyV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadUserURLsReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadUserURLsReplyV1MultiError, or nil if none found.
+func (m *ReadUserURLsReplyV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadUserURLsReplyV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOriginalWithShortened() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadUserURLsReplyV1ValidationError{
+						field:  fmt.Sprintf("OriginalWithShortened[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadUserURLsReplyV1ValidationError{
+						field:  fmt.Sprintf("OriginalWithShortened[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadUserURLsReplyV1ValidationError{
+					field:  fmt.Sprintf("OriginalWithShortened[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadUserURLsReplyV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadUserURLsReplyV1MultiError is an error wrapping multiple validation
+// errors returned by ReadUserURLsReplyV1.ValidateAll() if the designated
+// constraints aren't met.
+type ReadUserURLsReplyV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadUserURLsReplyV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadUserURLsReplyV1MultiError) AllErrors() []error { return m }
+
+// ReadUserURLsReplyV1ValidationError is the validation error returned by
+// ReadUserURLsReplyV1.Validate if the designated constraints aren't met.
+type ReadUserURLsReplyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadUserURLsReplyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadUserURLsReplyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadUserURLsReplyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadUserURLsReplyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadUserURLsReplyV1ValidationError) ErrorName() string {
+	return "ReadUserURLsReplyV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadUserURLsReplyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadUserURLsReplyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadUserURLsReplyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadUserURLsReplyV1ValidationError{}
+
+// Validate checks the field values on OriginalWithShortenedV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OriginalWithShortenedV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OriginalWithShortenedV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OriginalWithShortenedV1MultiError, or nil if none found.
+func (m *OriginalWithShortenedV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OriginalWithShortenedV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOriginal()) < 1 {
+		err := OriginalWithShortenedV1ValidationError{
+			field:  "Original",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetShortened()) < 1 {
+		err := OriginalWithShortenedV1ValidationError{
+			field:  "Shortened",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OriginalWithShortenedV1ValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OriginalWithShortenedV1ValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OriginalWithShortenedV1ValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OriginalWithShortenedV1ValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OriginalWithShortenedV1ValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OriginalWithShortenedV1ValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Title
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return OriginalWithShortenedV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// OriginalWithShortenedV1MultiError is an error wrapping multiple validation
+// errors returned by OriginalWithShortenedV1.ValidateAll() if the designated
+// constraints aren't met.
+type OriginalWithShortenedV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OriginalWithShortenedV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OriginalWithShortenedV1MultiError) AllErrors() []error { return m }
+
+// OriginalWithShortenedV1ValidationError is the validation error returned by
+// OriginalWithShortenedV1.Validate if the designated constraints aren't met.
+type OriginalWithShortenedV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OriginalWithShortenedV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OriginalWithShortenedV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OriginalWithShortenedV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OriginalWithShortenedV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OriginalWithShortenedV1ValidationError) ErrorName() string {
+	return "OriginalWithShortenedV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OriginalWithShortenedV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOriginalWithShortenedV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OriginalWithShortenedV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OriginalWithShortenedV1ValidationError{}
+
+// Validate checks the field values on StreamUserURLsRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamUserURLsRequestV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamUserURLsRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamUserURLsRequestV1MultiError, or nil if none found.
+func (m *StreamUserURLsRequestV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamUserURLsRequestV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := SortOrderV1_name[int32(m.GetOrder())]; !ok {
+		err := StreamUserURLsRequestV1ValidationError{
+			field:  "Order",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Contains
+
+	// no validation rules for Domain
+
+	if utf8.RuneCountInString(m.GetTag()) > 64 {
+		err := StreamUserURLsRequestV1ValidationError{
+			field:  "Tag",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StreamUserURLsRequestV1_Tag_Pattern.MatchString(m.GetTag()) {
+		err := StreamUserURLsRequestV1ValidationError{
+			field:  "Tag",
+			reason: "value does not match regex pattern \"^([\\\\p{L}\\\\p{N}][\\\\p{L}\\\\p{N}_.-]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StreamUserURLsRequestV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamUserURLsRequestV1MultiError is an error wrapping multiple validation
+// errors returned by StreamUserURLsRequestV1.ValidateAll() if the designated
+// constraints aren't met.
+type StreamUserURLsRequestV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamUserURLsRequestV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamUserURLsRequestV1MultiError) AllErrors() []error { return m }
+
+// StreamUserURLsRequestV1ValidationError is the validation error returned by
+// StreamUserURLsRequestV1.Validate if the designated constraints aren't met.
+type StreamUserURLsRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamUserURLsRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamUserURLsRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamUserURLsRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamUserURLsRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamUserURLsRequestV1ValidationError) ErrorName() string {
+	return "StreamUserURLsRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamUserURLsRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamUserURLsRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamUserURLsRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamUserURLsRequestV1ValidationError{}
+
+var _StreamUserURLsRequestV1_Tag_Pattern = regexp.MustCompile("^([\\p{L}\\p{N}][\\p{L}\\p{N}_.-]*)?$")
+
+// Validate checks the field values on UpdateTagsRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagsRequestV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagsRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTagsRequestV1MultiError, or nil if none found.
+func (m *UpdateTagsRequestV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagsRequestV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortened()) < 1 {
+		err := UpdateTagsRequestV1ValidationError{
+			field:  "Shortened",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetTags()); l < 1 || l > 32 {
+		err := UpdateTagsRequestV1ValidationError{
+			field:  "Tags",
+			reason: "value must contain between 1 and 32 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := UpdateTagsRequestV1ValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UpdateTagsRequestV1_Tags_Pattern.MatchString(item) {
+			err := UpdateTagsRequestV1ValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value does not match regex pattern \"^[\\\\p{L}\\\\p{N}][\\\\p{L}\\\\p{N}_.-]*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateTagsRequestV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTagsRequestV1MultiError is an error wrapping multiple validation
+// errors returned by UpdateTagsRequestV1.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTagsRequestV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagsRequestV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagsRequestV1MultiError) AllErrors() []error { return m }
+
+// UpdateTagsRequestV1ValidationError is the validation error returned by
+// UpdateTagsRequestV1.Validate if the designated constraints aren't met.
+type UpdateTagsRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagsRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagsRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagsRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagsRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagsRequestV1ValidationError) ErrorName() string {
+	return "UpdateTagsRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTagsRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagsRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagsRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagsRequestV1ValidationError{}
+
+var _UpdateTagsRequestV1_Tags_Pattern = regexp.MustCompile("^[\\p{L}\\p{N}][\\p{L}\\p{N}_.-]*$")
+
+// Validate checks the field values on UpdateTagsReplyV1 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagsReplyV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagsReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTagsReplyV1MultiError, or nil if none found.
+func (m *UpdateTagsReplyV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagsReplyV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateTagsReplyV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTagsReplyV1MultiError is an error wrapping multiple validation errors
+// returned by UpdateTagsReplyV1.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTagsReplyV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagsReplyV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagsReplyV1MultiError) AllErrors() []error { return m }
+
+// UpdateTagsReplyV1ValidationError is the validation error returned by
+// UpdateTagsReplyV1.Validate if the designated constraints aren't met.
+type UpdateTagsReplyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagsReplyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagsReplyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagsReplyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagsReplyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagsReplyV1ValidationError) ErrorName() string {
+	return "UpdateTagsReplyV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTagsReplyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagsReplyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagsReplyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagsReplyV1ValidationError{}
+
+// Validate checks the field values on TagCountV1 with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TagCountV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TagCountV1 with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagCountV1MultiError, or
+// nil if none found.
+func (m *TagCountV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TagCountV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTag()) < 1 {
+		err := TagCountV1ValidationError{
+			field:  "Tag",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() <= 0 {
+		err := TagCountV1ValidationError{
+			field:  "Count",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TagCountV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// TagCountV1MultiError is an error wrapping multiple validation errors
+// returned by TagCountV1.ValidateAll() if the designated constraints aren't met.
+type TagCountV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagCountV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagCountV1MultiError) AllErrors() []error { return m }
+
+// TagCountV1ValidationError is the validation error returned by
+// TagCountV1.Validate if the designated constraints aren't met.
+type TagCountV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagCountV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagCountV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagCountV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagCountV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagCountV1ValidationError) ErrorName() string { return "TagCountV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagCountV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTagCountV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagCountV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagCountV1ValidationError{}
+
+// Validate checks the field values on ReadTagsReplyV1 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReadTagsReplyV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadTagsReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadTagsReplyV1MultiError, or nil if none found.
+func (m *ReadTagsReplyV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadTagsReplyV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadTagsReplyV1ValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadTagsReplyV1ValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadTagsReplyV1ValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadTagsReplyV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadTagsReplyV1MultiError is an error wrapping multiple validation errors
+// returned by ReadTagsReplyV1.ValidateAll() if the designated constraints
+// aren't met.
+type ReadTagsReplyV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadTagsReplyV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadTagsReplyV1MultiError) AllErrors() []error { return m }
+
+// ReadTagsReplyV1ValidationError is the validation error returned by
+// ReadTagsReplyV1.Validate if the designated constraints aren't met.
+type ReadTagsReplyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadTagsReplyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadTagsReplyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadTagsReplyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadTagsReplyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadTagsReplyV1ValidationError) ErrorName() string { return "ReadTagsReplyV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadTagsReplyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadTagsReplyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadTagsReplyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadTagsReplyV1ValidationError{}
+
+// Validate checks the field values on ReadAmountOfURLsAndUsersReplyV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadAmountOfURLsAndUsersReplyV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadAmountOfURLsAndUsersReplyV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReadAmountOfURLsAndUsersReplyV1MultiError, or nil if none found.
+func (m *ReadAmountOfURLsAndUsersReplyV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadAmountOfURLsAndUsersReplyV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUrlsAmount() < 0 {
+		err := ReadAmountOfURLsAndUsersReplyV1ValidationError{
+			field:  "UrlsAmount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUsersAmount() < 0 {
+		err := ReadAmountOfURLsAndUsersReplyV1ValidationError{
+			field:  "UsersAmount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReadAmountOfURLsAndUsersReplyV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadAmountOfURLsAndUsersReplyV1MultiError is an error wrapping multiple
+// validation errors returned by ReadAmountOfURLsAndUsersReplyV1.ValidateAll()
+// if the designated constraints aren't met.
+type ReadAmountOfURLsAndUsersReplyV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadAmountOfURLsAndUsersReplyV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadAmountOfURLsAndUsersReplyV1MultiError) AllErrors() []error { return m }
+
+// ReadAmountOfURLsAndUsersReplyV1ValidationError is the validation error
+// returned by ReadAmountOfURLsAndUsersReplyV1.Validate if the designated
+// constraints aren't met.
+type ReadAmountOfURLsAndUsersReplyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) ErrorName() string {
+	return "ReadAmountOfURLsAndUsersReplyV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadAmountOfURLsAndUsersReplyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadAmountOfURLsAndUsersReplyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadAmountOfURLsAndUsersReplyV1ValidationError{}
+
+// Validate checks the field values on EnforcePolicyReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnforcePolicyReplyV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnforcePolicyReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnforcePolicyReplyV1MultiError, or nil if none found.
+func (m *EnforcePolicyReplyV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnforcePolicyReplyV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EnforcePolicyReplyV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// EnforcePolicyReplyV1MultiError is an error wrapping multiple validation
+// errors returned by EnforcePolicyReplyV1.ValidateAll() if the designated
+// constraints aren't met.
+type EnforcePolicyReplyV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnforcePolicyReplyV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnforcePolicyReplyV1MultiError) AllErrors() []error { return m }
+
+// EnforcePolicyReplyV1ValidationError is the validation error returned by
+// EnforcePolicyReplyV1.Validate if the designated constraints aren't met.
+type EnforcePolicyReplyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnforcePolicyReplyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnforcePolicyReplyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnforcePolicyReplyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnforcePolicyReplyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnforcePolicyReplyV1ValidationError) ErrorName() string {
+	return "EnforcePolicyReplyV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnforcePolicyReplyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnforcePolicyReplyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnforcePolicyReplyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnforcePolicyReplyV1ValidationError{}
+
+// Validate checks the field values on DeleteUserURLsRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUserURLsRequestV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUserURLsRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUserURLsRequestV1MultiError, or nil if none found.
+func (m *DeleteUserURLsRequestV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUserURLsRequestV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUrlsToDelete()) < 1 {
+		err := DeleteUserURLsRequestV1ValidationError{
+			field:  "UrlsToDelete",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUrlsToDelete() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := DeleteUserURLsRequestV1ValidationError{
+				field:  fmt.Sprintf("UrlsToDelete[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeleteUserURLsRequestV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUserURLsRequestV1MultiError is an error wrapping multiple validation
+// errors returned by DeleteUserURLsRequestV1.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUserURLsRequestV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUserURLsRequestV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUserURLsRequestV1MultiError) AllErrors() []error { return m }
+
+// DeleteUserURLsRequestV1ValidationError is the validation error returned by
+// DeleteUserURLsRequestV1.Validate if the designated constraints aren't met.
+type DeleteUserURLsRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUserURLsRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUserURLsRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUserURLsRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUserURLsRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUserURLsRequestV1ValidationError) ErrorName() string {
+	return "DeleteUserURLsRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUserURLsRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUserURLsRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUserURLsRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUserURLsRequestV1ValidationError{}
+
+// Validate checks the field values on DeleteUserURLsReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUserURLsReplyV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUserURLsReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUserURLsReplyV1MultiError, or nil if none found.
+func (m *DeleteUserURLsReplyV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUserURLsReplyV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetJobId()) < 1 {
+		err := DeleteUserURLsReplyV1ValidationError{
+			field:  "JobId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteUserURLsReplyV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUserURLsReplyV1MultiError is an error wrapping multiple validation
+// errors returned by DeleteUserURLsReplyV1.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUserURLsReplyV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUserURLsReplyV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUserURLsReplyV1MultiError) AllErrors() []error { return m }
+
+// DeleteUserURLsReplyV1ValidationError is the validation error returned by
+// DeleteUserURLsReplyV1.Validate if the designated constraints aren't met.
+type DeleteUserURLsReplyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUserURLsReplyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUserURLsReplyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUserURLsReplyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUserURLsReplyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUserURLsReplyV1ValidationError) ErrorName() string {
+	return "DeleteUserURLsReplyV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUserURLsReplyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUserURLsReplyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUserURLsReplyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUserURLsReplyV1ValidationError{}
+
+// Validate checks the field values on ReadDeletionJobRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadDeletionJobRequestV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadDeletionJobRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadDeletionJobRequestV1MultiError, or nil if none found.
+func (m *ReadDeletionJobRequestV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadDeletionJobRequestV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetJobId()) < 1 {
+		err := ReadDeletionJobRequestV1ValidationError{
+			field:  "JobId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReadDeletionJobRequestV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadDeletionJobRequestV1MultiError is an error wrapping multiple validation
+// errors returned by ReadDeletionJobRequestV1.ValidateAll() if the designated
+// constraints aren't met.
+type ReadDeletionJobRequestV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadDeletionJobRequestV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadDeletionJobRequestV1MultiError) AllErrors() []error { return m }
+
+// ReadDeletionJobRequestV1ValidationError is the validation error returned by
+// ReadDeletionJobRequestV1.Validate if the designated constraints aren't met.
+type ReadDeletionJobRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadDeletionJobRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadDeletionJobRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadDeletionJobRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadDeletionJobRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadDeletionJobRequestV1ValidationError) ErrorName() string {
+	return "ReadDeletionJobRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadDeletionJobRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadDeletionJobRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadDeletionJobRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadDeletionJobRequestV1ValidationError{}
+
+// Validate checks the field values on DeletionResultV1 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeletionResultV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletionResultV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletionResultV1MultiError, or nil if none found.
+func (m *DeletionResultV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletionResultV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortened()) < 1 {
+		err := DeletionResultV1ValidationError{
+			field:  "Shortened",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeletionResultV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletionResultV1MultiError is an error wrapping multiple validation errors
+// returned by DeletionResultV1.ValidateAll() if the designated constraints
+// aren't met.
+type DeletionResultV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletionResultV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletionResultV1MultiError) AllErrors() []error { return m }
+
+// DeletionResultV1ValidationError is the validation error returned by
+// DeletionResultV1.Validate if the designated constraints aren't met.
+type DeletionResultV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletionResultV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletionResultV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletionResultV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletionResultV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletionResultV1ValidationError) ErrorName() string { return "DeletionResultV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeletionResultV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletionResultV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletionResultV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletionResultV1ValidationError{}
+
+// Validate checks the field values on ReadDeletionJobReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadDeletionJobReplyV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadDeletionJobReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadDeletionJobReplyV1MultiError, or nil if none found.
+func (m *ReadDeletionJobReplyV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadDeletionJobReplyV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetJobId()) < 1 {
+		err := ReadDeletionJobReplyV1ValidationError{
+			field:  "JobId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadDeletionJobReplyV1ValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadDeletionJobReplyV1ValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadDeletionJobReplyV1ValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Done
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadDeletionJobReplyV1ValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadDeletionJobReplyV1ValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadDeletionJobReplyV1ValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadDeletionJobReplyV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadDeletionJobReplyV1MultiError is an error wrapping multiple validation
+// errors returned by ReadDeletionJobReplyV1.ValidateAll() if the designated
+// constraints aren't met.
+type ReadDeletionJobReplyV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadDeletionJobReplyV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadDeletionJobReplyV1MultiError) AllErrors() []error { return m }
+
+// ReadDeletionJobReplyV1ValidationError is the validation error returned by
+// ReadDeletionJobReplyV1.Validate if the designated constraints aren't met.
+type ReadDeletionJobReplyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadDeletionJobReplyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadDeletionJobReplyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadDeletionJobReplyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadDeletionJobReplyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadDeletionJobReplyV1ValidationError) ErrorName() string {
+	return "ReadDeletionJobReplyV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadDeletionJobReplyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadDeletionJobReplyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadDeletionJobReplyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadDeletionJobReplyV1ValidationError{}
+
+// Validate checks the field values on RestoreUserURLsRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreUserURLsRequestV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreUserURLsRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreUserURLsRequestV1MultiError, or nil if none found.
+func (m *RestoreUserURLsRequestV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreUserURLsRequestV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUrlsToRestore()) < 1 {
+		err := RestoreUserURLsRequestV1ValidationError{
+			field:  "UrlsToRestore",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUrlsToRestore() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := RestoreUserURLsRequestV1ValidationError{
+				field:  fmt.Sprintf("UrlsToRestore[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RestoreUserURLsRequestV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreUserURLsRequestV1MultiError is an error wrapping multiple validation
+// errors returned by RestoreUserURLsRequestV1.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreUserURLsRequestV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreUserURLsRequestV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreUserURLsRequestV1MultiError) AllErrors() []error { return m }
+
+// RestoreUserURLsRequestV1ValidationError is the validation error returned by
+// RestoreUserURLsRequestV1.Validate if the designated constraints aren't met.
+type RestoreUserURLsRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreUserURLsRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreUserURLsRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreUserURLsRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreUserURLsRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreUserURLsRequestV1ValidationError) ErrorName() string {
+	return "RestoreUserURLsRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreUserURLsRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreUserURLsRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreUserURLsRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreUserURLsRequestV1ValidationError{}
+
+// Validate checks the field values on RestoreResultV1 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RestoreResultV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreResultV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreResultV1MultiError, or nil if none found.
+func (m *RestoreResultV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreResultV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortened()) < 1 {
+		err := RestoreResultV1ValidationError{
+			field:  "Shortened",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return RestoreResultV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreResultV1MultiError is an error wrapping multiple validation errors
+// returned by RestoreResultV1.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreResultV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreResultV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreResultV1MultiError) AllErrors() []error { return m }
+
+// RestoreResultV1ValidationError is the validation error returned by
+// RestoreResultV1.Validate if the designated constraints aren't met.
+type RestoreResultV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreResultV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreResultV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreResultV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreResultV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreResultV1ValidationError) ErrorName() string { return "RestoreResultV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RestoreResultV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreResultV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreResultV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreResultV1ValidationError{}
+
+// Validate checks the field values on RestoreUserURLsReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreUserURLsReplyV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreUserURLsReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreUserURLsReplyV1MultiError, or nil if none found.
+func (m *RestoreUserURLsReplyV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreUserURLsReplyV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetResults()) < 1 {
+		err := RestoreUserURLsReplyV1ValidationError{
+			field:  "Results",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RestoreUserURLsReplyV1ValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RestoreUserURLsReplyV1ValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RestoreUserURLsReplyV1ValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RestoreUserURLsReplyV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreUserURLsReplyV1MultiError is an error wrapping multiple validation
+// errors returned by RestoreUserURLsReplyV1.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreUserURLsReplyV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreUserURLsReplyV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreUserURLsReplyV1MultiError) AllErrors() []error { return m }
+
+// RestoreUserURLsReplyV1ValidationError is the validation error returned by
+// RestoreUserURLsReplyV1.Validate if the designated constraints aren't met.
+type RestoreUserURLsReplyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreUserURLsReplyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreUserURLsReplyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreUserURLsReplyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreUserURLsReplyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreUserURLsReplyV1ValidationError) ErrorName() string {
+	return "RestoreUserURLsReplyV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreUserURLsReplyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreUserURLsReplyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreUserURLsReplyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreUserURLsReplyV1ValidationError{}
+
+// Validate checks the field values on ReadURLStatsRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadURLStatsRequestV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadURLStatsRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadURLStatsRequestV1MultiError, or nil if none found.
+func (m *ReadURLStatsRequestV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadURLStatsRequestV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortened()) < 1 {
+		err := ReadURLStatsRequestV1ValidationError{
+			field:  "Shortened",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBucket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadURLStatsRequestV1ValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadURLStatsRequestV1ValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBucket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadURLStatsRequestV1ValidationError{
+				field:  "Bucket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReadURLStatsRequestV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadURLStatsRequestV1MultiError is an error wrapping multiple validation
+// errors returned by ReadURLStatsRequestV1.ValidateAll() if the designated
+// constraints aren't met.
+type ReadURLStatsRequestV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadURLStatsRequestV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadURLStatsRequestV1MultiError) AllErrors() []error { return m }
+
+// ReadURLStatsRequestV1ValidationError is the validation error returned by
+// ReadURLStatsRequestV1.Validate if the designated constraints aren't met.
+type ReadURLStatsRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadURLStatsRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadURLStatsRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadURLStatsRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadURLStatsRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadURLStatsRequestV1ValidationError) ErrorName() string {
+	return "ReadURLStatsRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadURLStatsRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadURLStatsRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadURLStatsRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadURLStatsRequestV1ValidationError{}
+
+// Validate checks the field values on ReadURLStatsReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadURLStatsReplyV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadURLStatsReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadURLStatsReplyV1MultiError, or nil if none found.
+func (m *ReadURLStatsReplyV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadURLStatsReplyV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortened()) < 1 {
+		err := ReadURLStatsReplyV1ValidationError{
+			field:  "Shortened",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTotal() < 0 {
+		err := ReadURLStatsReplyV1ValidationError{
+			field:  "Total",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetBuckets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadURLStatsReplyV1ValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadURLStatsReplyV1ValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadURLStatsReplyV1ValidationError{
+					field:  fmt.Sprintf("Buckets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadURLStatsReplyV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadURLStatsReplyV1MultiError is an error wrapping multiple validation
+// errors returned by ReadURLStatsReplyV1.ValidateAll() if the designated
+// constraints aren't met.
+type ReadURLStatsReplyV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadURLStatsReplyV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadURLStatsReplyV1MultiError) AllErrors() []error { return m }
+
+// ReadURLStatsReplyV1ValidationError is the validation error returned by
+// ReadURLStatsReplyV1.Validate if the designated constraints aren't met.
+type ReadURLStatsReplyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadURLStatsReplyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadURLStatsReplyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadURLStatsReplyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadURLStatsReplyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadURLStatsReplyV1ValidationError) ErrorName() string {
+	return "ReadURLStatsReplyV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadURLStatsReplyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadURLStatsReplyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadURLStatsReplyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadURLStatsReplyV1ValidationError{}
+
+// Validate checks the field values on ClickBucketV1 with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClickBucketV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClickBucketV1 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClickBucketV1MultiError, or
+// nil if none found.
+func (m *ClickBucketV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClickBucketV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClickBucketV1ValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClickBucketV1ValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClickBucketV1ValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetClicks() < 0 {
+		err := ClickBucketV1ValidationError{
+			field:  "Clicks",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClickBucketV1MultiError(errors)
+	}
+
+	return nil
+}
+
+// ClickBucketV1MultiError is an error wrapping multiple validation errors
+// returned by ClickBucketV1.ValidateAll() if the designated constraints
+// aren't met.
+type ClickBucketV1MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClickBucketV1MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClickBucketV1MultiError) AllErrors() []error { return m }
+
+// ClickBucketV1ValidationError is the validation error returned by
+// ClickBucketV1.Validate if the designated constraints aren't met.
+type ClickBucketV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClickBucketV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClickBucketV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClickBucketV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClickBucketV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClickBucketV1ValidationError) ErrorName() string { return "ClickBucketV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClickBucketV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClickBucketV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClickBucketV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClickBucketV1ValidationError{}
+
+// Validate checks the field values on APIKeyV1 with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIKeyV1) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIKeyV1 with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in APIKeyV1MultiError, or nil
+// if none found.
+func (m *APIKeyV1) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIKeyV1) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := APIKeyV1ValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	if utf8.RuneCountInString(m.GetPrefix()) < 1 {
+		err := APIKeyV1ValidationError{
+			field:  "Prefix",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyV1ValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyV1ValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyV1ValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRevokedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyV1ValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyV1ValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyV1ValidationError{
+				field:  "RevokedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return ShortenedWithCorrelationV1MultiError(errors)
+		return APIKeyV1MultiError(errors)
 	}
 
 	return nil
 }
 
-// ShortenedWithCorrelationV1MultiError is an error wrapping multiple
-// validation errors returned by ShortenedWithCorrelationV1.ValidateAll() if
-// the designated constraints aren't met.
-type ShortenedWithCorrelationV1MultiError []error
+// APIKeyV1MultiError is an error wrapping multiple validation errors returned
+// by APIKeyV1.ValidateAll() if the designated constraints aren't met.
+type APIKeyV1MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ShortenedWithCorrelationV1MultiError) Error() string {
+func (m APIKeyV1MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -975,11 +4358,11 @@ func (m ShortenedWithCorrelationV1MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ShortenedWithCorrelationV1MultiError) AllErrors() []error { return m }
+func (m APIKeyV1MultiError) AllErrors() []error { return m }
 
-// ShortenedWithCorrelationV1ValidationError is the validation error returned
-// by ShortenedWithCorrelationV1.Validate if the designated constraints aren't met.
-type ShortenedWithCorrelationV1ValidationError struct {
+// APIKeyV1ValidationError is the validation error returned by
+// APIKeyV1.Validate if the designated constraints aren't met.
+type APIKeyV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -987,24 +4370,22 @@ type ShortenedWithCorrelationV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e ShortenedWithCorrelationV1ValidationError) Field() string { return e.field }
+func (e APIKeyV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ShortenedWithCorrelationV1ValidationError) Reason() string { return e.reason }
+func (e APIKeyV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ShortenedWithCorrelationV1ValidationError) Cause() error { return e.cause }
+func (e APIKeyV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ShortenedWithCorrelationV1ValidationError) Key() bool { return e.key }
+func (e APIKeyV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ShortenedWithCorrelationV1ValidationError) ErrorName() string {
-	return "ShortenedWithCorrelationV1ValidationError"
-}
+func (e APIKeyV1ValidationError) ErrorName() string { return "APIKeyV1ValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ShortenedWithCorrelationV1ValidationError) Error() string {
+func (e APIKeyV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1016,14 +4397,14 @@ func (e ShortenedWithCorrelationV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sShortenedWithCorrelationV1.%s: %s%s",
+		"invalid %sAPIKeyV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ShortenedWithCorrelationV1ValidationError{}
+var _ error = APIKeyV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -1031,78 +4412,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ShortenedWithCorrelationV1ValidationError{}
+} = APIKeyV1ValidationError{}
 
-// Validate checks the field values on ReadUserURLsReplyV1 with the rules
+// Validate checks the field values on CreateAPIKeyRequestV1 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReadUserURLsReplyV1) Validate() error {
+func (m *CreateAPIKeyRequestV1) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReadUserURLsReplyV1 with the rules
+// ValidateAll checks the field values on CreateAPIKeyRequestV1 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReadUserURLsReplyV1MultiError, or nil if none found.
-func (m *ReadUserURLsReplyV1) ValidateAll() error {
+// CreateAPIKeyRequestV1MultiError, or nil if none found.
+func (m *CreateAPIKeyRequestV1) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReadUserURLsReplyV1) validate(all bool) error {
+func (m *CreateAPIKeyRequestV1) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetOriginalWithShortened() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ReadUserURLsReplyV1ValidationError{
-						field:  fmt.Sprintf("OriginalWithShortened[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ReadUserURLsReplyV1ValidationError{
-						field:  fmt.Sprintf("OriginalWithShortened[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ReadUserURLsReplyV1ValidationError{
-					field:  fmt.Sprintf("OriginalWithShortened[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetName()) > 100 {
+		err := CreateAPIKeyRequestV1ValidationError{
+			field:  "Name",
+			reason: "value length must be at most 100 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return ReadUserURLsReplyV1MultiError(errors)
+		return CreateAPIKeyRequestV1MultiError(errors)
 	}
 
 	return nil
 }
 
-// ReadUserURLsReplyV1MultiError is an error wrapping multiple validation
-// errors returned by ReadUserURLsReplyV1.ValidateAll() if the designated
+// CreateAPIKeyRequestV1MultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyRequestV1.ValidateAll() if the designated
 // constraints aren't met.
-type ReadUserURLsReplyV1MultiError []error
+type CreateAPIKeyRequestV1MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReadUserURLsReplyV1MultiError) Error() string {
+func (m CreateAPIKeyRequestV1MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1111,11 +4469,11 @@ func (m ReadUserURLsReplyV1MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReadUserURLsReplyV1MultiError) AllErrors() []error { return m }
+func (m CreateAPIKeyRequestV1MultiError) AllErrors() []error { return m }
 
-// ReadUserURLsReplyV1ValidationError is the validation error returned by
-// ReadUserURLsReplyV1.Validate if the designated constraints aren't met.
-type ReadUserURLsReplyV1ValidationError struct {
+// CreateAPIKeyRequestV1ValidationError is the validation error returned by
+// CreateAPIKeyRequestV1.Validate if the designated constraints aren't met.
+type CreateAPIKeyRequestV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1123,24 +4481,24 @@ type ReadUserURLsReplyV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReadUserURLsReplyV1ValidationError) Field() string { return e.field }
+func (e CreateAPIKeyRequestV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReadUserURLsReplyV1ValidationError) Reason() string { return e.reason }
+func (e CreateAPIKeyRequestV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReadUserURLsReplyV1ValidationError) Cause() error { return e.cause }
+func (e CreateAPIKeyRequestV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReadUserURLsReplyV1ValidationError) Key() bool { return e.key }
+func (e CreateAPIKeyRequestV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReadUserURLsReplyV1ValidationError) ErrorName() string {
-	return "ReadUserURLsReplyV1ValidationError"
+func (e CreateAPIKeyRequestV1ValidationError) ErrorName() string {
+	return "CreateAPIKeyRequestV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReadUserURLsReplyV1ValidationError) Error() string {
+func (e CreateAPIKeyRequestV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1152,14 +4510,14 @@ func (e ReadUserURLsReplyV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadUserURLsReplyV1.%s: %s%s",
+		"invalid %sCreateAPIKeyRequestV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReadUserURLsReplyV1ValidationError{}
+var _ error = CreateAPIKeyRequestV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -1167,44 +4525,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReadUserURLsReplyV1ValidationError{}
+} = CreateAPIKeyRequestV1ValidationError{}
 
-// Validate checks the field values on OriginalWithShortenedV1 with the rules
+// Validate checks the field values on CreateAPIKeyReplyV1 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OriginalWithShortenedV1) Validate() error {
+func (m *CreateAPIKeyReplyV1) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OriginalWithShortenedV1 with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateAPIKeyReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OriginalWithShortenedV1MultiError, or nil if none found.
-func (m *OriginalWithShortenedV1) ValidateAll() error {
+// CreateAPIKeyReplyV1MultiError, or nil if none found.
+func (m *CreateAPIKeyReplyV1) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OriginalWithShortenedV1) validate(all bool) error {
+func (m *CreateAPIKeyReplyV1) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOriginal()) < 1 {
-		err := OriginalWithShortenedV1ValidationError{
-			field:  "Original",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetApiKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAPIKeyReplyV1ValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAPIKeyReplyV1ValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKeyReplyV1ValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetShortened()) < 1 {
-		err := OriginalWithShortenedV1ValidationError{
-			field:  "Shortened",
+	if utf8.RuneCountInString(m.GetKey()) < 1 {
+		err := CreateAPIKeyReplyV1ValidationError{
+			field:  "Key",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -1214,19 +4590,19 @@ func (m *OriginalWithShortenedV1) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return OriginalWithShortenedV1MultiError(errors)
+		return CreateAPIKeyReplyV1MultiError(errors)
 	}
 
 	return nil
 }
 
-// OriginalWithShortenedV1MultiError is an error wrapping multiple validation
-// errors returned by OriginalWithShortenedV1.ValidateAll() if the designated
+// CreateAPIKeyReplyV1MultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyReplyV1.ValidateAll() if the designated
 // constraints aren't met.
-type OriginalWithShortenedV1MultiError []error
+type CreateAPIKeyReplyV1MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OriginalWithShortenedV1MultiError) Error() string {
+func (m CreateAPIKeyReplyV1MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1235,11 +4611,11 @@ func (m OriginalWithShortenedV1MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OriginalWithShortenedV1MultiError) AllErrors() []error { return m }
+func (m CreateAPIKeyReplyV1MultiError) AllErrors() []error { return m }
 
-// OriginalWithShortenedV1ValidationError is the validation error returned by
-// OriginalWithShortenedV1.Validate if the designated constraints aren't met.
-type OriginalWithShortenedV1ValidationError struct {
+// CreateAPIKeyReplyV1ValidationError is the validation error returned by
+// CreateAPIKeyReplyV1.Validate if the designated constraints aren't met.
+type CreateAPIKeyReplyV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1247,24 +4623,24 @@ type OriginalWithShortenedV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e OriginalWithShortenedV1ValidationError) Field() string { return e.field }
+func (e CreateAPIKeyReplyV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OriginalWithShortenedV1ValidationError) Reason() string { return e.reason }
+func (e CreateAPIKeyReplyV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OriginalWithShortenedV1ValidationError) Cause() error { return e.cause }
+func (e CreateAPIKeyReplyV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OriginalWithShortenedV1ValidationError) Key() bool { return e.key }
+func (e CreateAPIKeyReplyV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OriginalWithShortenedV1ValidationError) ErrorName() string {
-	return "OriginalWithShortenedV1ValidationError"
+func (e CreateAPIKeyReplyV1ValidationError) ErrorName() string {
+	return "CreateAPIKeyReplyV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e OriginalWithShortenedV1ValidationError) Error() string {
+func (e CreateAPIKeyReplyV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1276,14 +4652,14 @@ func (e OriginalWithShortenedV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOriginalWithShortenedV1.%s: %s%s",
+		"invalid %sCreateAPIKeyReplyV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OriginalWithShortenedV1ValidationError{}
+var _ error = CreateAPIKeyReplyV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -1291,66 +4667,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OriginalWithShortenedV1ValidationError{}
+} = CreateAPIKeyReplyV1ValidationError{}
 
-// Validate checks the field values on ReadAmountOfURLsAndUsersReplyV1 with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReadAPIKeysReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReadAmountOfURLsAndUsersReplyV1) Validate() error {
+func (m *ReadAPIKeysReplyV1) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReadAmountOfURLsAndUsersReplyV1 with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ReadAmountOfURLsAndUsersReplyV1MultiError, or nil if none found.
-func (m *ReadAmountOfURLsAndUsersReplyV1) ValidateAll() error {
+// ValidateAll checks the field values on ReadAPIKeysReplyV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadAPIKeysReplyV1MultiError, or nil if none found.
+func (m *ReadAPIKeysReplyV1) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReadAmountOfURLsAndUsersReplyV1) validate(all bool) error {
+func (m *ReadAPIKeysReplyV1) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUrlsAmount() < 0 {
-		err := ReadAmountOfURLsAndUsersReplyV1ValidationError{
-			field:  "UrlsAmount",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetApiKeys() {
+		_, _ = idx, item
 
-	if m.GetUsersAmount() < 0 {
-		err := ReadAmountOfURLsAndUsersReplyV1ValidationError{
-			field:  "UsersAmount",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadAPIKeysReplyV1ValidationError{
+						field:  fmt.Sprintf("ApiKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadAPIKeysReplyV1ValidationError{
+						field:  fmt.Sprintf("ApiKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadAPIKeysReplyV1ValidationError{
+					field:  fmt.Sprintf("ApiKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return ReadAmountOfURLsAndUsersReplyV1MultiError(errors)
+		return ReadAPIKeysReplyV1MultiError(errors)
 	}
 
 	return nil
 }
 
-// ReadAmountOfURLsAndUsersReplyV1MultiError is an error wrapping multiple
-// validation errors returned by ReadAmountOfURLsAndUsersReplyV1.ValidateAll()
-// if the designated constraints aren't met.
-type ReadAmountOfURLsAndUsersReplyV1MultiError []error
+// ReadAPIKeysReplyV1MultiError is an error wrapping multiple validation errors
+// returned by ReadAPIKeysReplyV1.ValidateAll() if the designated constraints
+// aren't met.
+type ReadAPIKeysReplyV1MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReadAmountOfURLsAndUsersReplyV1MultiError) Error() string {
+func (m ReadAPIKeysReplyV1MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1359,12 +4747,11 @@ func (m ReadAmountOfURLsAndUsersReplyV1MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReadAmountOfURLsAndUsersReplyV1MultiError) AllErrors() []error { return m }
+func (m ReadAPIKeysReplyV1MultiError) AllErrors() []error { return m }
 
-// ReadAmountOfURLsAndUsersReplyV1ValidationError is the validation error
-// returned by ReadAmountOfURLsAndUsersReplyV1.Validate if the designated
-// constraints aren't met.
-type ReadAmountOfURLsAndUsersReplyV1ValidationError struct {
+// ReadAPIKeysReplyV1ValidationError is the validation error returned by
+// ReadAPIKeysReplyV1.Validate if the designated constraints aren't met.
+type ReadAPIKeysReplyV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1372,24 +4759,24 @@ type ReadAmountOfURLsAndUsersReplyV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) Field() string { return e.field }
+func (e ReadAPIKeysReplyV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) Reason() string { return e.reason }
+func (e ReadAPIKeysReplyV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) Cause() error { return e.cause }
+func (e ReadAPIKeysReplyV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) Key() bool { return e.key }
+func (e ReadAPIKeysReplyV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) ErrorName() string {
-	return "ReadAmountOfURLsAndUsersReplyV1ValidationError"
+func (e ReadAPIKeysReplyV1ValidationError) ErrorName() string {
+	return "ReadAPIKeysReplyV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) Error() string {
+func (e ReadAPIKeysReplyV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1401,14 +4788,14 @@ func (e ReadAmountOfURLsAndUsersReplyV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadAmountOfURLsAndUsersReplyV1.%s: %s%s",
+		"invalid %sReadAPIKeysReplyV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReadAmountOfURLsAndUsersReplyV1ValidationError{}
+var _ error = ReadAPIKeysReplyV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -1416,34 +4803,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReadAmountOfURLsAndUsersReplyV1ValidationError{}
+} = ReadAPIKeysReplyV1ValidationError{}
 
-// Validate checks the field values on DeleteUserURLsRequestV1 with the rules
+// Validate checks the field values on RevokeAPIKeyRequestV1 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteUserURLsRequestV1) Validate() error {
+func (m *RevokeAPIKeyRequestV1) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteUserURLsRequestV1 with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeAPIKeyRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteUserURLsRequestV1MultiError, or nil if none found.
-func (m *DeleteUserURLsRequestV1) ValidateAll() error {
+// RevokeAPIKeyRequestV1MultiError, or nil if none found.
+func (m *RevokeAPIKeyRequestV1) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteUserURLsRequestV1) validate(all bool) error {
+func (m *RevokeAPIKeyRequestV1) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetUrlsToDelete()) < 1 {
-		err := DeleteUserURLsRequestV1ValidationError{
-			field:  "UrlsToDelete",
-			reason: "value must contain at least 1 item(s)",
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := RevokeAPIKeyRequestV1ValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -1451,36 +4838,20 @@ func (m *DeleteUserURLsRequestV1) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetUrlsToDelete() {
-		_, _ = idx, item
-
-		if utf8.RuneCountInString(item) < 1 {
-			err := DeleteUserURLsRequestV1ValidationError{
-				field:  fmt.Sprintf("UrlsToDelete[%v]", idx),
-				reason: "value length must be at least 1 runes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return DeleteUserURLsRequestV1MultiError(errors)
+		return RevokeAPIKeyRequestV1MultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteUserURLsRequestV1MultiError is an error wrapping multiple validation
-// errors returned by DeleteUserURLsRequestV1.ValidateAll() if the designated
+// RevokeAPIKeyRequestV1MultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKeyRequestV1.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteUserURLsRequestV1MultiError []error
+type RevokeAPIKeyRequestV1MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteUserURLsRequestV1MultiError) Error() string {
+func (m RevokeAPIKeyRequestV1MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1489,11 +4860,11 @@ func (m DeleteUserURLsRequestV1MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteUserURLsRequestV1MultiError) AllErrors() []error { return m }
+func (m RevokeAPIKeyRequestV1MultiError) AllErrors() []error { return m }
 
-// DeleteUserURLsRequestV1ValidationError is the validation error returned by
-// DeleteUserURLsRequestV1.Validate if the designated constraints aren't met.
-type DeleteUserURLsRequestV1ValidationError struct {
+// RevokeAPIKeyRequestV1ValidationError is the validation error returned by
+// RevokeAPIKeyRequestV1.Validate if the designated constraints aren't met.
+type RevokeAPIKeyRequestV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1501,24 +4872,24 @@ type DeleteUserURLsRequestV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteUserURLsRequestV1ValidationError) Field() string { return e.field }
+func (e RevokeAPIKeyRequestV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteUserURLsRequestV1ValidationError) Reason() string { return e.reason }
+func (e RevokeAPIKeyRequestV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteUserURLsRequestV1ValidationError) Cause() error { return e.cause }
+func (e RevokeAPIKeyRequestV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteUserURLsRequestV1ValidationError) Key() bool { return e.key }
+func (e RevokeAPIKeyRequestV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteUserURLsRequestV1ValidationError) ErrorName() string {
-	return "DeleteUserURLsRequestV1ValidationError"
+func (e RevokeAPIKeyRequestV1ValidationError) ErrorName() string {
+	return "RevokeAPIKeyRequestV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteUserURLsRequestV1ValidationError) Error() string {
+func (e RevokeAPIKeyRequestV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1530,14 +4901,14 @@ func (e DeleteUserURLsRequestV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteUserURLsRequestV1.%s: %s%s",
+		"invalid %sRevokeAPIKeyRequestV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteUserURLsRequestV1ValidationError{}
+var _ error = RevokeAPIKeyRequestV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -1545,4 +4916,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteUserURLsRequestV1ValidationError{}
+} = RevokeAPIKeyRequestV1ValidationError{}
@@ -28,14 +28,38 @@ type UrlshrtV1Client interface {
 	ReadOriginalV1(ctx context.Context, in *ReadOriginalRequestV1, opts ...grpc.CallOption) (*ReadOriginalReplyV1, error)
 	// create shortened url from original
 	CreateShortenedV1(ctx context.Context, in *CreateShortenedRequestV1, opts ...grpc.CallOption) (*CreateShortenedReplyV1, error)
+	// change original url of current user's shortened url, original urls are checked for uniqueness the same way as on creation
+	UpdateShortenedV1(ctx context.Context, in *UpdateShortenedRequestV1, opts ...grpc.CallOption) (*UpdateShortenedReplyV1, error)
 	// create shortened urls providing batch of original urls with correlation ids
 	CreateShortenedFromBatchV1(ctx context.Context, in *CreateShortenedFromBatchRequestV1, opts ...grpc.CallOption) (*CreateShortenedFromBatchReplyV1, error)
 	// read all current user's urls
 	ReadUserURLsV1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadUserURLsReplyV1, error)
+	// stream current user's urls in order of their creation, urls may be filtered by their original urls
+	StreamUserURLsV1(ctx context.Context, in *StreamUserURLsRequestV1, opts ...grpc.CallOption) (UrlshrtV1_StreamUserURLsV1Client, error)
+	// add tags to current user's url, all tags of the url are returned
+	AddTagsV1(ctx context.Context, in *UpdateTagsRequestV1, opts ...grpc.CallOption) (*UpdateTagsReplyV1, error)
+	// remove tags from current user's url, tags which are left are returned
+	RemoveTagsV1(ctx context.Context, in *UpdateTagsRequestV1, opts ...grpc.CallOption) (*UpdateTagsReplyV1, error)
+	// read tags of current user's urls with amounts of urls which have them
+	ReadTagsV1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadTagsReplyV1, error)
 	// read amount of urls and users, excluding deleted urls and those users, who have deleted all their urls
 	ReadAmountOfURLsAndUsersV1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadAmountOfURLsAndUsersReplyV1, error)
-	// delete user's urls providing their short versions without host
-	DeleteUserURLsV1(ctx context.Context, in *DeleteUserURLsRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// reload rules of destinations and disable saved urls which match them, only trusted subnet is allowed to do that
+	EnforcePolicyV1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EnforcePolicyReplyV1, error)
+	// delete user's urls providing their short versions without host, urls are deleted in background
+	DeleteUserURLsV1(ctx context.Context, in *DeleteUserURLsRequestV1, opts ...grpc.CallOption) (*DeleteUserURLsReplyV1, error)
+	// read statuses of urls which current user asked to delete
+	ReadDeletionJobV1(ctx context.Context, in *ReadDeletionJobRequestV1, opts ...grpc.CallOption) (*ReadDeletionJobReplyV1, error)
+	// restore current user's deleted urls if grace period after their deletion is not over yet
+	RestoreUserURLsV1(ctx context.Context, in *RestoreUserURLsRequestV1, opts ...grpc.CallOption) (*RestoreUserURLsReplyV1, error)
+	// read amount of clicks made by current user's url, in total and by periods of time
+	ReadURLStatsV1(ctx context.Context, in *ReadURLStatsRequestV1, opts ...grpc.CallOption) (*ReadURLStatsReplyV1, error)
+	// create api key for current user, the key itself is returned only once
+	CreateAPIKeyV1(ctx context.Context, in *CreateAPIKeyRequestV1, opts ...grpc.CallOption) (*CreateAPIKeyReplyV1, error)
+	// read all current user's api keys, including revoked ones
+	ReadAPIKeysV1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadAPIKeysReplyV1, error)
+	// revoke current user's api key, so it can't be used anymore
+	RevokeAPIKeyV1(ctx context.Context, in *RevokeAPIKeyRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type urlshrtV1Client struct {
@@ -64,6 +88,15 @@ func (c *urlshrtV1Client) CreateShortenedV1(ctx context.Context, in *CreateShort
 	return out, nil
 }
 
+func (c *urlshrtV1Client) UpdateShortenedV1(ctx context.Context, in *UpdateShortenedRequestV1, opts ...grpc.CallOption) (*UpdateShortenedReplyV1, error) {
+	out := new(UpdateShortenedReplyV1)
+	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/UpdateShortenedV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *urlshrtV1Client) CreateShortenedFromBatchV1(ctx context.Context, in *CreateShortenedFromBatchRequestV1, opts ...grpc.CallOption) (*CreateShortenedFromBatchReplyV1, error) {
 	out := new(CreateShortenedFromBatchReplyV1)
 	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/CreateShortenedFromBatchV1", in, out, opts...)
@@ -82,6 +115,65 @@ func (c *urlshrtV1Client) ReadUserURLsV1(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
+func (c *urlshrtV1Client) StreamUserURLsV1(ctx context.Context, in *StreamUserURLsRequestV1, opts ...grpc.CallOption) (UrlshrtV1_StreamUserURLsV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &UrlshrtV1_ServiceDesc.Streams[0], "/api.v1.UrlshrtV1/StreamUserURLsV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &urlshrtV1StreamUserURLsV1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UrlshrtV1_StreamUserURLsV1Client interface {
+	Recv() (*OriginalWithShortenedV1, error)
+	grpc.ClientStream
+}
+
+type urlshrtV1StreamUserURLsV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *urlshrtV1StreamUserURLsV1Client) Recv() (*OriginalWithShortenedV1, error) {
+	m := new(OriginalWithShortenedV1)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *urlshrtV1Client) AddTagsV1(ctx context.Context, in *UpdateTagsRequestV1, opts ...grpc.CallOption) (*UpdateTagsReplyV1, error) {
+	out := new(UpdateTagsReplyV1)
+	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/AddTagsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlshrtV1Client) RemoveTagsV1(ctx context.Context, in *UpdateTagsRequestV1, opts ...grpc.CallOption) (*UpdateTagsReplyV1, error) {
+	out := new(UpdateTagsReplyV1)
+	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/RemoveTagsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlshrtV1Client) ReadTagsV1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadTagsReplyV1, error) {
+	out := new(ReadTagsReplyV1)
+	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/ReadTagsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *urlshrtV1Client) ReadAmountOfURLsAndUsersV1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadAmountOfURLsAndUsersReplyV1, error) {
 	out := new(ReadAmountOfURLsAndUsersReplyV1)
 	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/ReadAmountOfURLsAndUsersV1", in, out, opts...)
@@ -91,8 +183,17 @@ func (c *urlshrtV1Client) ReadAmountOfURLsAndUsersV1(ctx context.Context, in *em
 	return out, nil
 }
 
-func (c *urlshrtV1Client) DeleteUserURLsV1(ctx context.Context, in *DeleteUserURLsRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *urlshrtV1Client) EnforcePolicyV1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EnforcePolicyReplyV1, error) {
+	out := new(EnforcePolicyReplyV1)
+	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/EnforcePolicyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlshrtV1Client) DeleteUserURLsV1(ctx context.Context, in *DeleteUserURLsRequestV1, opts ...grpc.CallOption) (*DeleteUserURLsReplyV1, error) {
+	out := new(DeleteUserURLsReplyV1)
 	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/DeleteUserURLsV1", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -100,6 +201,60 @@ func (c *urlshrtV1Client) DeleteUserURLsV1(ctx context.Context, in *DeleteUserUR
 	return out, nil
 }
 
+func (c *urlshrtV1Client) ReadDeletionJobV1(ctx context.Context, in *ReadDeletionJobRequestV1, opts ...grpc.CallOption) (*ReadDeletionJobReplyV1, error) {
+	out := new(ReadDeletionJobReplyV1)
+	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/ReadDeletionJobV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlshrtV1Client) RestoreUserURLsV1(ctx context.Context, in *RestoreUserURLsRequestV1, opts ...grpc.CallOption) (*RestoreUserURLsReplyV1, error) {
+	out := new(RestoreUserURLsReplyV1)
+	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/RestoreUserURLsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlshrtV1Client) ReadURLStatsV1(ctx context.Context, in *ReadURLStatsRequestV1, opts ...grpc.CallOption) (*ReadURLStatsReplyV1, error) {
+	out := new(ReadURLStatsReplyV1)
+	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/ReadURLStatsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlshrtV1Client) CreateAPIKeyV1(ctx context.Context, in *CreateAPIKeyRequestV1, opts ...grpc.CallOption) (*CreateAPIKeyReplyV1, error) {
+	out := new(CreateAPIKeyReplyV1)
+	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/CreateAPIKeyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlshrtV1Client) ReadAPIKeysV1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReadAPIKeysReplyV1, error) {
+	out := new(ReadAPIKeysReplyV1)
+	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/ReadAPIKeysV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlshrtV1Client) RevokeAPIKeyV1(ctx context.Context, in *RevokeAPIKeyRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.v1.UrlshrtV1/RevokeAPIKeyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UrlshrtV1Server is the server API for UrlshrtV1 service.
 // All implementations must embed UnimplementedUrlshrtV1Server
 // for forward compatibility
@@ -108,14 +263,38 @@ type UrlshrtV1Server interface {
 	ReadOriginalV1(context.Context, *ReadOriginalRequestV1) (*ReadOriginalReplyV1, error)
 	// create shortened url from original
 	CreateShortenedV1(context.Context, *CreateShortenedRequestV1) (*CreateShortenedReplyV1, error)
+	// change original url of current user's shortened url, original urls are checked for uniqueness the same way as on creation
+	UpdateShortenedV1(context.Context, *UpdateShortenedRequestV1) (*UpdateShortenedReplyV1, error)
 	// create shortened urls providing batch of original urls with correlation ids
 	CreateShortenedFromBatchV1(context.Context, *CreateShortenedFromBatchRequestV1) (*CreateShortenedFromBatchReplyV1, error)
 	// read all current user's urls
 	ReadUserURLsV1(context.Context, *emptypb.Empty) (*ReadUserURLsReplyV1, error)
+	// stream current user's urls in order of their creation, urls may be filtered by their original urls
+	StreamUserURLsV1(*StreamUserURLsRequestV1, UrlshrtV1_StreamUserURLsV1Server) error
+	// add tags to current user's url, all tags of the url are returned
+	AddTagsV1(context.Context, *UpdateTagsRequestV1) (*UpdateTagsReplyV1, error)
+	// remove tags from current user's url, tags which are left are returned
+	RemoveTagsV1(context.Context, *UpdateTagsRequestV1) (*UpdateTagsReplyV1, error)
+	// read tags of current user's urls with amounts of urls which have them
+	ReadTagsV1(context.Context, *emptypb.Empty) (*ReadTagsReplyV1, error)
 	// read amount of urls and users, excluding deleted urls and those users, who have deleted all their urls
 	ReadAmountOfURLsAndUsersV1(context.Context, *emptypb.Empty) (*ReadAmountOfURLsAndUsersReplyV1, error)
-	// delete user's urls providing their short versions without host
-	DeleteUserURLsV1(context.Context, *DeleteUserURLsRequestV1) (*emptypb.Empty, error)
+	// reload rules of destinations and disable saved urls which match them, only trusted subnet is allowed to do that
+	EnforcePolicyV1(context.Context, *emptypb.Empty) (*EnforcePolicyReplyV1, error)
+	// delete user's urls providing their short versions without host, urls are deleted in background
+	DeleteUserURLsV1(context.Context, *DeleteUserURLsRequestV1) (*DeleteUserURLsReplyV1, error)
+	// read statuses of urls which current user asked to delete
+	ReadDeletionJobV1(context.Context, *ReadDeletionJobRequestV1) (*ReadDeletionJobReplyV1, error)
+	// restore current user's deleted urls if grace period after their deletion is not over yet
+	RestoreUserURLsV1(context.Context, *RestoreUserURLsRequestV1) (*RestoreUserURLsReplyV1, error)
+	// read amount of clicks made by current user's url, in total and by periods of time
+	ReadURLStatsV1(context.Context, *ReadURLStatsRequestV1) (*ReadURLStatsReplyV1, error)
+	// create api key for current user, the key itself is returned only once
+	CreateAPIKeyV1(context.Context, *CreateAPIKeyRequestV1) (*CreateAPIKeyReplyV1, error)
+	// read all current user's api keys, including revoked ones
+	ReadAPIKeysV1(context.Context, *emptypb.Empty) (*ReadAPIKeysReplyV1, error)
+	// revoke current user's api key, so it can't be used anymore
+	RevokeAPIKeyV1(context.Context, *RevokeAPIKeyRequestV1) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUrlshrtV1Server()
 }
 
@@ -129,18 +308,54 @@ func (UnimplementedUrlshrtV1Server) ReadOriginalV1(context.Context, *ReadOrigina
 func (UnimplementedUrlshrtV1Server) CreateShortenedV1(context.Context, *CreateShortenedRequestV1) (*CreateShortenedReplyV1, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateShortenedV1 not implemented")
 }
+func (UnimplementedUrlshrtV1Server) UpdateShortenedV1(context.Context, *UpdateShortenedRequestV1) (*UpdateShortenedReplyV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShortenedV1 not implemented")
+}
 func (UnimplementedUrlshrtV1Server) CreateShortenedFromBatchV1(context.Context, *CreateShortenedFromBatchRequestV1) (*CreateShortenedFromBatchReplyV1, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateShortenedFromBatchV1 not implemented")
 }
 func (UnimplementedUrlshrtV1Server) ReadUserURLsV1(context.Context, *emptypb.Empty) (*ReadUserURLsReplyV1, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadUserURLsV1 not implemented")
 }
+func (UnimplementedUrlshrtV1Server) StreamUserURLsV1(*StreamUserURLsRequestV1, UrlshrtV1_StreamUserURLsV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUserURLsV1 not implemented")
+}
+func (UnimplementedUrlshrtV1Server) AddTagsV1(context.Context, *UpdateTagsRequestV1) (*UpdateTagsReplyV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTagsV1 not implemented")
+}
+func (UnimplementedUrlshrtV1Server) RemoveTagsV1(context.Context, *UpdateTagsRequestV1) (*UpdateTagsReplyV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTagsV1 not implemented")
+}
+func (UnimplementedUrlshrtV1Server) ReadTagsV1(context.Context, *emptypb.Empty) (*ReadTagsReplyV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadTagsV1 not implemented")
+}
 func (UnimplementedUrlshrtV1Server) ReadAmountOfURLsAndUsersV1(context.Context, *emptypb.Empty) (*ReadAmountOfURLsAndUsersReplyV1, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadAmountOfURLsAndUsersV1 not implemented")
 }
-func (UnimplementedUrlshrtV1Server) DeleteUserURLsV1(context.Context, *DeleteUserURLsRequestV1) (*emptypb.Empty, error) {
+func (UnimplementedUrlshrtV1Server) EnforcePolicyV1(context.Context, *emptypb.Empty) (*EnforcePolicyReplyV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnforcePolicyV1 not implemented")
+}
+func (UnimplementedUrlshrtV1Server) DeleteUserURLsV1(context.Context, *DeleteUserURLsRequestV1) (*DeleteUserURLsReplyV1, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserURLsV1 not implemented")
 }
+func (UnimplementedUrlshrtV1Server) ReadDeletionJobV1(context.Context, *ReadDeletionJobRequestV1) (*ReadDeletionJobReplyV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeletionJobV1 not implemented")
+}
+func (UnimplementedUrlshrtV1Server) RestoreUserURLsV1(context.Context, *RestoreUserURLsRequestV1) (*RestoreUserURLsReplyV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUserURLsV1 not implemented")
+}
+func (UnimplementedUrlshrtV1Server) ReadURLStatsV1(context.Context, *ReadURLStatsRequestV1) (*ReadURLStatsReplyV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadURLStatsV1 not implemented")
+}
+func (UnimplementedUrlshrtV1Server) CreateAPIKeyV1(context.Context, *CreateAPIKeyRequestV1) (*CreateAPIKeyReplyV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKeyV1 not implemented")
+}
+func (UnimplementedUrlshrtV1Server) ReadAPIKeysV1(context.Context, *emptypb.Empty) (*ReadAPIKeysReplyV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAPIKeysV1 not implemented")
+}
+func (UnimplementedUrlshrtV1Server) RevokeAPIKeyV1(context.Context, *RevokeAPIKeyRequestV1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKeyV1 not implemented")
+}
 func (UnimplementedUrlshrtV1Server) mustEmbedUnimplementedUrlshrtV1Server() {}
 
 // UnsafeUrlshrtV1Server may be embedded to opt out of forward compatibility for this service.
@@ -190,6 +405,24 @@ func _UrlshrtV1_CreateShortenedV1_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UrlshrtV1_UpdateShortenedV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShortenedRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlshrtV1Server).UpdateShortenedV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.UrlshrtV1/UpdateShortenedV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlshrtV1Server).UpdateShortenedV1(ctx, req.(*UpdateShortenedRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UrlshrtV1_CreateShortenedFromBatchV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateShortenedFromBatchRequestV1)
 	if err := dec(in); err != nil {
@@ -226,6 +459,81 @@ func _UrlshrtV1_ReadUserURLsV1_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UrlshrtV1_StreamUserURLsV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamUserURLsRequestV1)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UrlshrtV1Server).StreamUserURLsV1(m, &urlshrtV1StreamUserURLsV1Server{stream})
+}
+
+type UrlshrtV1_StreamUserURLsV1Server interface {
+	Send(*OriginalWithShortenedV1) error
+	grpc.ServerStream
+}
+
+type urlshrtV1StreamUserURLsV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *urlshrtV1StreamUserURLsV1Server) Send(m *OriginalWithShortenedV1) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UrlshrtV1_AddTagsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlshrtV1Server).AddTagsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.UrlshrtV1/AddTagsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlshrtV1Server).AddTagsV1(ctx, req.(*UpdateTagsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlshrtV1_RemoveTagsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlshrtV1Server).RemoveTagsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.UrlshrtV1/RemoveTagsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlshrtV1Server).RemoveTagsV1(ctx, req.(*UpdateTagsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlshrtV1_ReadTagsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlshrtV1Server).ReadTagsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.UrlshrtV1/ReadTagsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlshrtV1Server).ReadTagsV1(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UrlshrtV1_ReadAmountOfURLsAndUsersV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -244,6 +552,24 @@ func _UrlshrtV1_ReadAmountOfURLsAndUsersV1_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UrlshrtV1_EnforcePolicyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlshrtV1Server).EnforcePolicyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.UrlshrtV1/EnforcePolicyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlshrtV1Server).EnforcePolicyV1(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UrlshrtV1_DeleteUserURLsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteUserURLsRequestV1)
 	if err := dec(in); err != nil {
@@ -262,6 +588,114 @@ func _UrlshrtV1_DeleteUserURLsV1_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UrlshrtV1_ReadDeletionJobV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeletionJobRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlshrtV1Server).ReadDeletionJobV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.UrlshrtV1/ReadDeletionJobV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlshrtV1Server).ReadDeletionJobV1(ctx, req.(*ReadDeletionJobRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlshrtV1_RestoreUserURLsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserURLsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlshrtV1Server).RestoreUserURLsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.UrlshrtV1/RestoreUserURLsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlshrtV1Server).RestoreUserURLsV1(ctx, req.(*RestoreUserURLsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlshrtV1_ReadURLStatsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadURLStatsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlshrtV1Server).ReadURLStatsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.UrlshrtV1/ReadURLStatsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlshrtV1Server).ReadURLStatsV1(ctx, req.(*ReadURLStatsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlshrtV1_CreateAPIKeyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlshrtV1Server).CreateAPIKeyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.UrlshrtV1/CreateAPIKeyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlshrtV1Server).CreateAPIKeyV1(ctx, req.(*CreateAPIKeyRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlshrtV1_ReadAPIKeysV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlshrtV1Server).ReadAPIKeysV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.UrlshrtV1/ReadAPIKeysV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlshrtV1Server).ReadAPIKeysV1(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlshrtV1_RevokeAPIKeyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlshrtV1Server).RevokeAPIKeyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.UrlshrtV1/RevokeAPIKeyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlshrtV1Server).RevokeAPIKeyV1(ctx, req.(*RevokeAPIKeyRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UrlshrtV1_ServiceDesc is the grpc.ServiceDesc for UrlshrtV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +711,10 @@ var UrlshrtV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateShortenedV1",
 			Handler:    _UrlshrtV1_CreateShortenedV1_Handler,
 		},
+		{
+			MethodName: "UpdateShortenedV1",
+			Handler:    _UrlshrtV1_UpdateShortenedV1_Handler,
+		},
 		{
 			MethodName: "CreateShortenedFromBatchV1",
 			Handler:    _UrlshrtV1_CreateShortenedFromBatchV1_Handler,
@@ -285,15 +723,61 @@ var UrlshrtV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadUserURLsV1",
 			Handler:    _UrlshrtV1_ReadUserURLsV1_Handler,
 		},
+		{
+			MethodName: "AddTagsV1",
+			Handler:    _UrlshrtV1_AddTagsV1_Handler,
+		},
+		{
+			MethodName: "RemoveTagsV1",
+			Handler:    _UrlshrtV1_RemoveTagsV1_Handler,
+		},
+		{
+			MethodName: "ReadTagsV1",
+			Handler:    _UrlshrtV1_ReadTagsV1_Handler,
+		},
 		{
 			MethodName: "ReadAmountOfURLsAndUsersV1",
 			Handler:    _UrlshrtV1_ReadAmountOfURLsAndUsersV1_Handler,
 		},
+		{
+			MethodName: "EnforcePolicyV1",
+			Handler:    _UrlshrtV1_EnforcePolicyV1_Handler,
+		},
 		{
 			MethodName: "DeleteUserURLsV1",
 			Handler:    _UrlshrtV1_DeleteUserURLsV1_Handler,
 		},
+		{
+			MethodName: "ReadDeletionJobV1",
+			Handler:    _UrlshrtV1_ReadDeletionJobV1_Handler,
+		},
+		{
+			MethodName: "RestoreUserURLsV1",
+			Handler:    _UrlshrtV1_RestoreUserURLsV1_Handler,
+		},
+		{
+			MethodName: "ReadURLStatsV1",
+			Handler:    _UrlshrtV1_ReadURLStatsV1_Handler,
+		},
+		{
+			MethodName: "CreateAPIKeyV1",
+			Handler:    _UrlshrtV1_CreateAPIKeyV1_Handler,
+		},
+		{
+			MethodName: "ReadAPIKeysV1",
+			Handler:    _UrlshrtV1_ReadAPIKeysV1_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKeyV1",
+			Handler:    _UrlshrtV1_RevokeAPIKeyV1_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamUserURLsV1",
+			Handler:       _UrlshrtV1_StreamUserURLsV1_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "urlshrt.proto",
 }
@@ -0,0 +1,9 @@
+-- +goose Up
+BEGIN TRANSACTION;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_short_unique ON urlshrt (short);
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP INDEX IF EXISTS idx_short_unique;
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- +goose Up
+BEGIN TRANSACTION;
+ALTER TABLE urlshrt ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS not_before TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_expires_at ON urlshrt USING BTREE (expires_at) WHERE is_deleted = 0;
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP INDEX IF EXISTS idx_expires_at;
+ALTER TABLE urlshrt DROP COLUMN IF EXISTS expires_at, DROP COLUMN IF EXISTS not_before;
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- +goose Up
+BEGIN TRANSACTION;
+CREATE TABLE IF NOT EXISTS clicks(id BIGSERIAL primary key, short text NOT NULL, clicked_at TIMESTAMPTZ NOT NULL, referrer text NOT NULL DEFAULT '', user_agent text NOT NULL DEFAULT '', ip text NOT NULL DEFAULT '');
+CREATE INDEX IF NOT EXISTS idx_clicks_short ON clicks USING BTREE (short, clicked_at);
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP TABLE IF EXISTS clicks;
+COMMIT;
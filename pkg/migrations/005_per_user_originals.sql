@@ -0,0 +1,12 @@
+-- +goose Up
+-- original URLs are unique among URLs of a user only, URLs without owner are treated as URLs of the same user
+BEGIN TRANSACTION;
+ALTER TABLE urlshrt DROP CONSTRAINT IF EXISTS urlshrt_pkey;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_original_unique ON urlshrt (COALESCE(user_id, -1), original);
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP INDEX IF EXISTS idx_user_original_unique;
+ALTER TABLE urlshrt ADD PRIMARY KEY (original);
+COMMIT;
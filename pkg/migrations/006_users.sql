@@ -0,0 +1,13 @@
+-- +goose Up
+-- ids of users are allocated by the users table, ids below 1000 were given randomly before it existed and are never allocated
+BEGIN TRANSACTION;
+ALTER TABLE urlshrt ALTER COLUMN user_id TYPE BIGINT;
+CREATE TABLE IF NOT EXISTS users(id BIGSERIAL primary key, created_at TIMESTAMPTZ NOT NULL DEFAULT now());
+SELECT setval('users_id_seq', GREATEST(999, (SELECT COALESCE(MAX(user_id), 0) FROM urlshrt)));
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP TABLE IF EXISTS users;
+ALTER TABLE urlshrt ALTER COLUMN user_id TYPE INTEGER;
+COMMIT;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- only hashes of API keys are stored, so the keys themselves can't be read from database
+BEGIN TRANSACTION;
+CREATE TABLE IF NOT EXISTS api_keys(id text primary key, user_id BIGINT NOT NULL, name text NOT NULL DEFAULT '', prefix text NOT NULL, hash text NOT NULL UNIQUE, created_at TIMESTAMPTZ NOT NULL, revoked_at TIMESTAMPTZ);
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys USING BTREE (user_id, created_at);
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP TABLE IF EXISTS api_keys;
+COMMIT;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- URLs are kept here until they are deleted, so deletion is not lost if the service is restarted
+BEGIN TRANSACTION;
+CREATE TABLE IF NOT EXISTS deletion_outbox(id BIGSERIAL primary key, short text NOT NULL, user_id BIGINT NOT NULL, job_id text NOT NULL, created_at TIMESTAMPTZ NOT NULL DEFAULT now());
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP TABLE IF EXISTS deletion_outbox;
+COMMIT;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- time of deletion is saved, so deleted URLs may be restored during grace period and purged after it,
+-- URLs which were deleted before the column was added get time of the migration, so they may be restored during grace period too
+BEGIN TRANSACTION;
+ALTER TABLE urlshrt ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+UPDATE urlshrt SET deleted_at = now() WHERE is_deleted = 1 AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_deleted_at ON urlshrt USING BTREE (deleted_at) WHERE is_deleted = 1;
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP INDEX IF EXISTS idx_deleted_at;
+ALTER TABLE urlshrt DROP COLUMN IF EXISTS deleted_at;
+COMMIT;
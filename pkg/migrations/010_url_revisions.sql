@@ -0,0 +1,11 @@
+-- +goose Up
+-- every change of original URL is kept, so the owner can see what short URL pointed to before
+BEGIN TRANSACTION;
+CREATE TABLE IF NOT EXISTS url_revisions(id BIGSERIAL primary key, short text NOT NULL, revision INT NOT NULL, previous text NOT NULL, original text NOT NULL, changed_at TIMESTAMPTZ NOT NULL);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_url_revisions_short ON url_revisions USING BTREE (short, revision);
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP TABLE IF EXISTS url_revisions;
+COMMIT;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- URLs of a user are listed page by page in order of uuid and short URL, host of original URL is kept to filter URLs by domain
+BEGIN TRANSACTION;
+ALTER TABLE urlshrt ADD COLUMN IF NOT EXISTS host text GENERATED ALWAYS AS (lower(substring(original from '^[A-Za-z][A-Za-z0-9+.-]*://(?:[^/?#@]*@)?([^/?#:]*)'))) STORED;
+CREATE INDEX IF NOT EXISTS idx_user_uuid_short ON urlshrt USING BTREE (user_id, uuid, short);
+CREATE INDEX IF NOT EXISTS idx_user_host ON urlshrt USING BTREE (user_id, host);
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP INDEX IF EXISTS idx_user_host;
+DROP INDEX IF EXISTS idx_user_uuid_short;
+ALTER TABLE urlshrt DROP COLUMN IF EXISTS host;
+COMMIT;
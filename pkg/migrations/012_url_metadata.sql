@@ -0,0 +1,20 @@
+-- +goose Up
+-- URLs which were saved before the columns were added have no time of creation,
+-- tags are kept apart from URLs, so URLs may be found by their tags
+BEGIN TRANSACTION;
+ALTER TABLE urlshrt ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ;
+ALTER TABLE urlshrt ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+ALTER TABLE urlshrt ADD COLUMN IF NOT EXISTS title text NOT NULL DEFAULT '';
+ALTER TABLE urlshrt ADD COLUMN IF NOT EXISTS note text NOT NULL DEFAULT '';
+CREATE TABLE IF NOT EXISTS url_tags(short text NOT NULL, tag text NOT NULL, PRIMARY KEY (short, tag));
+CREATE INDEX IF NOT EXISTS idx_url_tags_tag ON url_tags USING BTREE (tag, short);
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP TABLE IF EXISTS url_tags;
+ALTER TABLE urlshrt DROP COLUMN IF EXISTS note;
+ALTER TABLE urlshrt DROP COLUMN IF EXISTS title;
+ALTER TABLE urlshrt DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE urlshrt DROP COLUMN IF EXISTS created_at;
+COMMIT;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- URLs whose original URLs match a rule of destinations which can't be shortened are disabled instead of being deleted,
+-- so their owners can't restore them
+BEGIN TRANSACTION;
+ALTER TABLE urlshrt ADD COLUMN IF NOT EXISTS is_disabled BOOLEAN NOT NULL DEFAULT FALSE;
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+ALTER TABLE urlshrt DROP COLUMN IF EXISTS is_disabled;
+COMMIT;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- URLs of a user are listed page by page in order of time of creation and short URL, uuid doesn't grow with time of creation,
+-- URLs which were saved before time of creation was kept are listed first
+BEGIN TRANSACTION;
+CREATE INDEX IF NOT EXISTS idx_user_created_short ON urlshrt USING BTREE (user_id, COALESCE(created_at, '0001-01-01 00:00:00+00'::timestamptz), short);
+DROP INDEX IF EXISTS idx_user_uuid_short;
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+CREATE INDEX IF NOT EXISTS idx_user_uuid_short ON urlshrt USING BTREE (user_id, uuid, short);
+DROP INDEX IF EXISTS idx_user_created_short;
+COMMIT;
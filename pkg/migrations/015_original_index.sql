@@ -0,0 +1,10 @@
+-- +goose Up
+-- original URLs which are deduplicated for all the users are looked up without user id
+BEGIN TRANSACTION;
+CREATE INDEX IF NOT EXISTS idx_original ON urlshrt USING BTREE (original, short);
+COMMIT;
+
+-- +goose Down
+BEGIN TRANSACTION;
+DROP INDEX IF EXISTS idx_original;
+COMMIT;